@@ -337,37 +337,7 @@ func (app *Application) FinalizeBlock(_ context.Context, req *types.FinalizeBloc
 			app.stagedTxs = append(app.stagedTxs, tx)
 		}
 
-		var key, value string
-		parts := bytes.Split(tx, []byte("="))
-		if len(parts) == 2 {
-			key, value = string(parts[0]), string(parts[1])
-		} else {
-			key, value = string(tx), string(tx)
-		}
-		respTxs[i] = &types.ExecTxResult{
-			Code: CodeTypeOK,
-			// With every transaction we can emit a series of events. To make it simple, we just emit the same events.
-			Events: []types.Event{
-				{
-					Type: "app",
-					Attributes: []types.EventAttribute{
-						{Key: "creator", Value: "Cosmoshi Netowoko", Index: true},
-						{Key: "key", Value: key, Index: true},
-						{Key: "index_key", Value: "index is working", Index: true},
-						{Key: "noindex_key", Value: "index is working", Index: false},
-					},
-				},
-				{
-					Type: "app",
-					Attributes: []types.EventAttribute{
-						{Key: "creator", Value: "Cosmoshi", Index: true},
-						{Key: "key", Value: value, Index: true},
-						{Key: "index_key", Value: "index is working", Index: true},
-						{Key: "noindex_key", Value: "index is working", Index: false},
-					},
-				},
-			},
-		}
+		respTxs[i] = execTxResult(tx)
 		app.state.Size++
 	}
 
@@ -471,9 +441,13 @@ func (app *Application) Commit(context.Context, *types.CommitRequest) (*types.Co
 }
 
 // Query returns an associated value or nil if missing.
-func (app *Application) Query(_ context.Context, reqQuery *types.QueryRequest) (*types.QueryResponse, error) {
+func (app *Application) Query(ctx context.Context, reqQuery *types.QueryRequest) (*types.QueryResponse, error) {
 	resQuery := &types.QueryResponse{}
 
+	if reqQuery.Path == types.SimulateTxQueryPath {
+		return app.simulateTx(ctx, reqQuery.Data)
+	}
+
 	if reqQuery.Path == "/val" {
 		key := []byte(ValidatorPrefix + string(reqQuery.Data))
 		value, err := app.state.db.Get(key)
@@ -522,6 +496,68 @@ func (app *Application) Query(_ context.Context, reqQuery *types.QueryRequest) (
 	return resQuery, nil
 }
 
+// simulateTx returns the result that tx would have if it was included in a
+// block, without modifying the state.
+func (app *Application) simulateTx(ctx context.Context, tx []byte) (*types.QueryResponse, error) {
+	resCheckTx, err := app.CheckTx(ctx, &types.CheckTxRequest{Tx: tx, Type: types.CHECK_TX_TYPE_CHECK})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &types.ExecTxResult{Code: resCheckTx.Code}
+	if resCheckTx.Code == CodeTypeOK {
+		// Txs are formatted by PrepareProposal before being executed.
+		result = execTxResult(bytes.ReplaceAll(tx, []byte(":"), []byte("=")))
+	}
+	result.GasWanted = resCheckTx.GasWanted
+	result.GasUsed = resCheckTx.GasWanted
+
+	value, err := result.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryResponse{
+		Code:   CodeTypeOK,
+		Value:  value,
+		Height: app.state.Height,
+	}, nil
+}
+
+// execTxResult returns the result of executing tx in FinalizeBlock.
+func execTxResult(tx []byte) *types.ExecTxResult {
+	var key, value string
+	parts := bytes.Split(tx, []byte("="))
+	if len(parts) == 2 {
+		key, value = string(parts[0]), string(parts[1])
+	} else {
+		key, value = string(tx), string(tx)
+	}
+	return &types.ExecTxResult{
+		Code: CodeTypeOK,
+		// With every transaction we can emit a series of events. To make it simple, we just emit the same events.
+		Events: []types.Event{
+			{
+				Type: "app",
+				Attributes: []types.EventAttribute{
+					{Key: "creator", Value: "Cosmoshi Netowoko", Index: true},
+					{Key: "key", Value: key, Index: true},
+					{Key: "index_key", Value: "index is working", Index: true},
+					{Key: "noindex_key", Value: "index is working", Index: false},
+				},
+			},
+			{
+				Type: "app",
+				Attributes: []types.EventAttribute{
+					{Key: "creator", Value: "Cosmoshi", Index: true},
+					{Key: "key", Value: value, Index: true},
+					{Key: "index_key", Value: "index is working", Index: true},
+					{Key: "noindex_key", Value: "index is working", Index: false},
+				},
+			},
+		},
+	}
+}
+
 func (app *Application) Close() error {
 	return app.state.db.Close()
 }
@@ -238,6 +238,37 @@ func TestCheckTx(t *testing.T) {
 	}
 }
 
+func TestSimulateTx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	kvstore := NewInMemoryApplication()
+
+	simulate := func(tx []byte) *types.ExecTxResult {
+		t.Helper()
+		resQuery, err := kvstore.Query(ctx, &types.QueryRequest{Path: types.SimulateTxQueryPath, Data: tx})
+		require.NoError(t, err)
+		require.Equal(t, CodeTypeOK, resQuery.Code)
+		result := &types.ExecTxResult{}
+		require.NoError(t, result.Unmarshal(resQuery.Value))
+		return result
+	}
+
+	result := simulate([]byte("space:jam"))
+	require.Equal(t, CodeTypeOK, result.Code)
+	require.Equal(t, int64(1), result.GasUsed)
+	require.Equal(t, execTxResult([]byte("space=jam")).Events, result.Events)
+
+	result = simulate([]byte("hello"))
+	require.Equal(t, CodeTypeInvalidTxFormat, result.Code)
+	require.Empty(t, result.Events)
+
+	// The state is not modified.
+	resQuery, err := kvstore.Query(ctx, &types.QueryRequest{Data: []byte("space")})
+	require.NoError(t, err)
+	require.Nil(t, resQuery.Value)
+	require.Zero(t, kvstore.state.Size)
+}
+
 func TestClientAssignLane(t *testing.T) {
 	val := RandVal()
 
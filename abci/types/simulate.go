@@ -0,0 +1,12 @@
+package types
+
+// SimulateTxQueryPath is the path of the Query used by CometBFT to simulate the
+// execution of a transaction, for instance to estimate the gas it uses, without
+// adding it to the mempool.
+//
+// The transaction is passed in QueryRequest.Data. An application that supports
+// simulation executes it against a throwaway copy of its latest committed
+// state, and returns in QueryResponse.Value the resulting ExecTxResult encoded
+// with Protobuf. Applications that don't support simulation should return a
+// non-zero QueryResponse.Code.
+const SimulateTxQueryPath = "/cometbft/simulate_tx"
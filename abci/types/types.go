@@ -98,6 +98,7 @@ type (
 	ExtendedVoteInfo   = v1.ExtendedVoteInfo
 	Event              = v1.Event
 	EventAttribute     = v1.EventAttribute
+	LanesUpdate        = v1.LanesUpdate
 	Misbehavior        = v1.Misbehavior
 	Snapshot           = v1.Snapshot
 	TxResult           = v1.TxResult
@@ -133,6 +134,7 @@ const (
 	MISBEHAVIOR_TYPE_UNKNOWN             MisbehaviorType = v1.MISBEHAVIOR_TYPE_UNKNOWN
 	MISBEHAVIOR_TYPE_DUPLICATE_VOTE      MisbehaviorType = v1.MISBEHAVIOR_TYPE_DUPLICATE_VOTE
 	MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK MisbehaviorType = v1.MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK
+	MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL  MisbehaviorType = v1.MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL
 )
 
 type ApplySnapshotChunkResult = v1.ApplySnapshotChunkResult
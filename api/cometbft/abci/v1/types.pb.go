@@ -231,18 +231,22 @@ const (
 	MISBEHAVIOR_TYPE_DUPLICATE_VOTE MisbehaviorType = 1
 	// Light client attack
 	MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK MisbehaviorType = 2
+	// Duplicate proposal
+	MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL MisbehaviorType = 3
 )
 
 var MisbehaviorType_name = map[int32]string{
 	0: "MISBEHAVIOR_TYPE_UNKNOWN",
 	1: "MISBEHAVIOR_TYPE_DUPLICATE_VOTE",
 	2: "MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK",
+	3: "MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL",
 }
 
 var MisbehaviorType_value = map[string]int32{
 	"MISBEHAVIOR_TYPE_UNKNOWN":             0,
 	"MISBEHAVIOR_TYPE_DUPLICATE_VOTE":      1,
 	"MISBEHAVIOR_TYPE_LIGHT_CLIENT_ATTACK": 2,
+	"MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL":  3,
 }
 
 func (x MisbehaviorType) String() string {
@@ -1634,7 +1638,6 @@ type Response struct {
 	// Sum of all possible messages.
 	//
 	// Types that are valid to be assigned to Value:
-	//
 	//	*Response_Exception
 	//	*Response_Echo
 	//	*Response_Flush
@@ -2317,6 +2320,19 @@ type CheckTxResponse struct {
 	Events    []Event `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
 	Codespace string  `protobuf:"bytes,8,opt,name=codespace,proto3" json:"codespace,omitempty"`
 	LaneId    string  `protobuf:"bytes,12,opt,name=lane_id,json=laneId,proto3" json:"lane_id,omitempty"`
+	// Priority of the transaction. Only used by the "priority" mempool, which
+	// reaps transactions with a higher priority first.
+	TxPriority int64 `protobuf:"varint,13,opt,name=tx_priority,json=txPriority,proto3" json:"tx_priority,omitempty"`
+	// Application-defined identifier of the account that issued the transaction.
+	// Together with tx_nonce, the "priority" mempool uses it to keep the
+	// transactions of a same sender in order.
+	TxSender string `protobuf:"bytes,14,opt,name=tx_sender,json=txSender,proto3" json:"tx_sender,omitempty"`
+	// Sequence number of the transaction among those with the same tx_sender.
+	TxNonce uint64 `protobuf:"varint,15,opt,name=tx_nonce,json=txNonce,proto3" json:"tx_nonce,omitempty"`
+	// Key (SHA-256 hash) of a transaction in the mempool that is superseded by
+	// this one, for instance because this transaction pays a higher fee. If set,
+	// the "flood" mempool replaces that transaction with this one.
+	ReplacedTxKey []byte `protobuf:"bytes,16,opt,name=replaced_tx_key,json=replacedTxKey,proto3" json:"replaced_tx_key,omitempty"`
 }
 
 func (m *CheckTxResponse) Reset()         { *m = CheckTxResponse{} }
@@ -2415,6 +2431,34 @@ func (m *CheckTxResponse) GetLaneId() string {
 	return ""
 }
 
+func (m *CheckTxResponse) GetTxPriority() int64 {
+	if m != nil {
+		return m.TxPriority
+	}
+	return 0
+}
+
+func (m *CheckTxResponse) GetTxSender() string {
+	if m != nil {
+		return m.TxSender
+	}
+	return ""
+}
+
+func (m *CheckTxResponse) GetTxNonce() uint64 {
+	if m != nil {
+		return m.TxNonce
+	}
+	return 0
+}
+
+func (m *CheckTxResponse) GetReplacedTxKey() []byte {
+	if m != nil {
+		return m.ReplacedTxKey
+	}
+	return nil
+}
+
 // CommitResponse indicates how much blocks should CometBFT retain.
 type CommitResponse struct {
 	RetainHeight int64 `protobuf:"varint,3,opt,name=retain_height,json=retainHeight,proto3" json:"retain_height,omitempty"`
@@ -2859,6 +2903,9 @@ type FinalizeBlockResponse struct {
 	// delay between the time when this block is committed and the next height is started.
 	// previously `timeout_commit` in config.toml
 	NextBlockDelay time.Duration `protobuf:"bytes,6,opt,name=next_block_delay,json=nextBlockDelay,proto3,stdduration" json:"next_block_delay"`
+	// new mempool lanes and their priorities, if they change. The lanes are
+	// replaced at the end of this height.
+	LanesUpdate *LanesUpdate `protobuf:"bytes,7,opt,name=lanes_update,json=lanesUpdate,proto3" json:"lanes_update,omitempty"`
 }
 
 func (m *FinalizeBlockResponse) Reset()         { *m = FinalizeBlockResponse{} }
@@ -2936,6 +2983,66 @@ func (m *FinalizeBlockResponse) GetNextBlockDelay() time.Duration {
 	return 0
 }
 
+func (m *FinalizeBlockResponse) GetLanesUpdate() *LanesUpdate {
+	if m != nil {
+		return m.LanesUpdate
+	}
+	return nil
+}
+
+// LanesUpdate replaces the set of mempool lanes defined in InfoResponse.
+type LanesUpdate struct {
+	LanePriorities map[string]uint32 `protobuf:"bytes,1,rep,name=lane_priorities,json=lanePriorities,proto3" json:"lane_priorities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	DefaultLane    string            `protobuf:"bytes,2,opt,name=default_lane,json=defaultLane,proto3" json:"default_lane,omitempty"`
+}
+
+func (m *LanesUpdate) Reset()         { *m = LanesUpdate{} }
+func (m *LanesUpdate) String() string { return proto.CompactTextString(m) }
+func (*LanesUpdate) ProtoMessage()    {}
+func (*LanesUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95dd8f7b670b96e3, []int{35}
+}
+func (m *LanesUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LanesUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LanesUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LanesUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LanesUpdate.Merge(m, src)
+}
+func (m *LanesUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *LanesUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_LanesUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LanesUpdate proto.InternalMessageInfo
+
+func (m *LanesUpdate) GetLanePriorities() map[string]uint32 {
+	if m != nil {
+		return m.LanePriorities
+	}
+	return nil
+}
+
+func (m *LanesUpdate) GetDefaultLane() string {
+	if m != nil {
+		return m.DefaultLane
+	}
+	return ""
+}
+
 // CommitInfo contains votes for the particular round.
 type CommitInfo struct {
 	Round int32      `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
@@ -2946,7 +3053,7 @@ func (m *CommitInfo) Reset()         { *m = CommitInfo{} }
 func (m *CommitInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo) ProtoMessage()    {}
 func (*CommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{35}
+	return fileDescriptor_95dd8f7b670b96e3, []int{36}
 }
 func (m *CommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3004,7 +3111,7 @@ func (m *ExtendedCommitInfo) Reset()         { *m = ExtendedCommitInfo{} }
 func (m *ExtendedCommitInfo) String() string { return proto.CompactTextString(m) }
 func (*ExtendedCommitInfo) ProtoMessage()    {}
 func (*ExtendedCommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{36}
+	return fileDescriptor_95dd8f7b670b96e3, []int{37}
 }
 func (m *ExtendedCommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3061,7 +3168,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{37}
+	return fileDescriptor_95dd8f7b670b96e3, []int{38}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3115,7 +3222,7 @@ func (m *EventAttribute) Reset()         { *m = EventAttribute{} }
 func (m *EventAttribute) String() string { return proto.CompactTextString(m) }
 func (*EventAttribute) ProtoMessage()    {}
 func (*EventAttribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{38}
+	return fileDescriptor_95dd8f7b670b96e3, []int{39}
 }
 func (m *EventAttribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3183,7 +3290,7 @@ func (m *ExecTxResult) Reset()         { *m = ExecTxResult{} }
 func (m *ExecTxResult) String() string { return proto.CompactTextString(m) }
 func (*ExecTxResult) ProtoMessage()    {}
 func (*ExecTxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{39}
+	return fileDescriptor_95dd8f7b670b96e3, []int{40}
 }
 func (m *ExecTxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3282,7 +3389,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{40}
+	return fileDescriptor_95dd8f7b670b96e3, []int{41}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3350,7 +3457,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{41}
+	return fileDescriptor_95dd8f7b670b96e3, []int{42}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3511,7 @@ func (m *ValidatorUpdate) Reset()         { *m = ValidatorUpdate{} }
 func (m *ValidatorUpdate) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdate) ProtoMessage()    {}
 func (*ValidatorUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{42}
+	return fileDescriptor_95dd8f7b670b96e3, []int{43}
 }
 func (m *ValidatorUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3464,7 +3571,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{43}
+	return fileDescriptor_95dd8f7b670b96e3, []int{44}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3523,7 +3630,7 @@ func (m *ExtendedVoteInfo) Reset()         { *m = ExtendedVoteInfo{} }
 func (m *ExtendedVoteInfo) String() string { return proto.CompactTextString(m) }
 func (*ExtendedVoteInfo) ProtoMessage()    {}
 func (*ExtendedVoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{44}
+	return fileDescriptor_95dd8f7b670b96e3, []int{45}
 }
 func (m *ExtendedVoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3599,7 +3706,7 @@ func (m *Misbehavior) Reset()         { *m = Misbehavior{} }
 func (m *Misbehavior) String() string { return proto.CompactTextString(m) }
 func (*Misbehavior) ProtoMessage()    {}
 func (*Misbehavior) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{45}
+	return fileDescriptor_95dd8f7b670b96e3, []int{46}
 }
 func (m *Misbehavior) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3676,7 +3783,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95dd8f7b670b96e3, []int{46}
+	return fileDescriptor_95dd8f7b670b96e3, []int{47}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3783,6 +3890,8 @@ func init() {
 	proto.RegisterType((*ExtendVoteResponse)(nil), "cometbft.abci.v1.ExtendVoteResponse")
 	proto.RegisterType((*VerifyVoteExtensionResponse)(nil), "cometbft.abci.v1.VerifyVoteExtensionResponse")
 	proto.RegisterType((*FinalizeBlockResponse)(nil), "cometbft.abci.v1.FinalizeBlockResponse")
+	proto.RegisterType((*LanesUpdate)(nil), "cometbft.abci.v1.LanesUpdate")
+	proto.RegisterMapType((map[string]uint32)(nil), "cometbft.abci.v1.LanesUpdate.LanePrioritiesEntry")
 	proto.RegisterType((*CommitInfo)(nil), "cometbft.abci.v1.CommitInfo")
 	proto.RegisterType((*ExtendedCommitInfo)(nil), "cometbft.abci.v1.ExtendedCommitInfo")
 	proto.RegisterType((*Event)(nil), "cometbft.abci.v1.Event")
@@ -3800,214 +3909,221 @@ func init() {
 func init() { proto.RegisterFile("cometbft/abci/v1/types.proto", fileDescriptor_95dd8f7b670b96e3) }
 
 var fileDescriptor_95dd8f7b670b96e3 = []byte{
-	// 3301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xf7, 0xf2, 0x4b, 0xe4, 0xe3, 0x87, 0x56, 0x23, 0xc9, 0xa6, 0x15, 0x47, 0x92, 0xd7, 0x71,
-	0xec, 0xd8, 0x89, 0xf4, 0xb7, 0x93, 0x7f, 0x3e, 0x9b, 0x04, 0x14, 0x4d, 0x45, 0x92, 0x65, 0x89,
-	0x59, 0xd2, 0x6a, 0xec, 0x7e, 0x6c, 0x56, 0xe4, 0x50, 0xdc, 0x98, 0xdc, 0xdd, 0xec, 0x0e, 0x15,
-	0xa9, 0x3d, 0xb5, 0x68, 0x8a, 0x22, 0xa7, 0x5c, 0x0a, 0x14, 0x45, 0x0b, 0x14, 0x28, 0x7a, 0xed,
-	0xa1, 0xf7, 0xde, 0x8a, 0x22, 0xa7, 0x36, 0xc7, 0x9e, 0xd2, 0x22, 0xb9, 0xf5, 0x1e, 0xa0, 0x40,
-	0x2f, 0xc5, 0x7c, 0xec, 0x17, 0xb9, 0x2b, 0xd9, 0x4e, 0x7a, 0x28, 0xda, 0x1b, 0x67, 0xe6, 0xf7,
-	0xde, 0xcc, 0xbe, 0x79, 0xf3, 0xde, 0x9b, 0xdf, 0x10, 0x2e, 0x74, 0xac, 0x21, 0x26, 0xfb, 0x3d,
-	0xb2, 0xaa, 0xef, 0x77, 0x8c, 0xd5, 0xc3, 0x1b, 0xab, 0xe4, 0xd8, 0xc6, 0xee, 0x8a, 0xed, 0x58,
-	0xc4, 0x42, 0xb2, 0x37, 0xba, 0x42, 0x47, 0x57, 0x0e, 0x6f, 0x2c, 0x2c, 0xfa, 0xf8, 0x8e, 0x73,
-	0x6c, 0x13, 0x8b, 0x4a, 0xd8, 0x8e, 0x65, 0xf5, 0xb8, 0x44, 0x68, 0x9c, 0xe9, 0x61, 0xc3, 0xba,
-	0xa3, 0x0f, 0x85, 0xc6, 0x85, 0x8b, 0x93, 0xe3, 0x87, 0xfa, 0xc0, 0xe8, 0xea, 0xc4, 0x72, 0x04,
-	0x64, 0xee, 0xc0, 0x3a, 0xb0, 0xd8, 0xcf, 0x55, 0xfa, 0x4b, 0xf4, 0x2e, 0x1d, 0x58, 0xd6, 0xc1,
-	0x00, 0xaf, 0xb2, 0xd6, 0xfe, 0xa8, 0xb7, 0x4a, 0x8c, 0x21, 0x76, 0x89, 0x3e, 0xb4, 0xbd, 0x99,
-	0xc7, 0x01, 0xdd, 0x91, 0xa3, 0x13, 0xc3, 0x32, 0xf9, 0xb8, 0xf2, 0xe7, 0x02, 0x4c, 0xa9, 0xf8,
-	0xfd, 0x11, 0x76, 0x09, 0x7a, 0x1e, 0x32, 0xb8, 0xd3, 0xb7, 0xaa, 0xd2, 0xb2, 0x74, 0xb5, 0x78,
-	0xf3, 0xc9, 0x95, 0xf1, 0xcf, 0x5c, 0x69, 0x74, 0xfa, 0x96, 0x00, 0x6f, 0x9c, 0x51, 0x19, 0x18,
-	0xbd, 0x08, 0xd9, 0xde, 0x60, 0xe4, 0xf6, 0xab, 0x29, 0x26, 0xb5, 0x38, 0x29, 0xb5, 0x4e, 0x87,
-	0x03, 0x31, 0x0e, 0xa7, 0x93, 0x19, 0x66, 0xcf, 0xaa, 0xa6, 0x93, 0x26, 0xdb, 0x34, 0x7b, 0xe1,
-	0xc9, 0x28, 0x18, 0xd5, 0x01, 0x0c, 0xd3, 0x20, 0x5a, 0xa7, 0xaf, 0x1b, 0x66, 0x35, 0xcb, 0x44,
-	0x95, 0x38, 0x51, 0x83, 0xd4, 0x29, 0x24, 0x90, 0x2f, 0x18, 0x5e, 0x1f, 0x5d, 0xf1, 0xfb, 0x23,
-	0xec, 0x1c, 0x57, 0x73, 0x49, 0x2b, 0x7e, 0x9b, 0x0e, 0x87, 0x56, 0xcc, 0xe0, 0xe8, 0x75, 0xc8,
-	0x77, 0xfa, 0xb8, 0xf3, 0x40, 0x23, 0x47, 0xd5, 0x3c, 0x13, 0x5d, 0x9e, 0x14, 0xad, 0x53, 0x44,
-	0xfb, 0x28, 0x10, 0x9e, 0xea, 0xf0, 0x1e, 0xf4, 0x0a, 0xe4, 0x3a, 0xd6, 0x70, 0x68, 0x90, 0x6a,
-	0x91, 0x09, 0x2f, 0xc5, 0x08, 0xb3, 0xf1, 0x40, 0x56, 0x08, 0xa0, 0x5d, 0xa8, 0x0c, 0x0c, 0x97,
-	0x68, 0xae, 0xa9, 0xdb, 0x6e, 0xdf, 0x22, 0x6e, 0xb5, 0xc4, 0x54, 0x3c, 0x3d, 0xa9, 0x62, 0xdb,
-	0x70, 0x49, 0xcb, 0x83, 0x05, 0x9a, 0xca, 0x83, 0x70, 0x3f, 0x55, 0x68, 0xf5, 0x7a, 0xd8, 0xf1,
-	0x35, 0x56, 0xcb, 0x49, 0x0a, 0x77, 0x29, 0xce, 0x93, 0x0c, 0x29, 0xb4, 0xc2, 0xfd, 0xe8, 0xdb,
-	0x30, 0x3b, 0xb0, 0xf4, 0xae, 0xaf, 0x4f, 0xeb, 0xf4, 0x47, 0xe6, 0x83, 0x6a, 0x85, 0x69, 0xbd,
-	0x16, 0xb3, 0x4c, 0x4b, 0xef, 0x7a, 0xc2, 0x75, 0x0a, 0x0d, 0x34, 0xcf, 0x0c, 0xc6, 0xc7, 0x90,
-	0x06, 0x73, 0xba, 0x6d, 0x0f, 0x8e, 0xc7, 0xd5, 0x4f, 0x33, 0xf5, 0xd7, 0x27, 0xd5, 0xd7, 0x28,
-	0x3a, 0x41, 0x3f, 0xd2, 0x27, 0x06, 0xd1, 0x5d, 0x90, 0x6d, 0x07, 0xdb, 0xba, 0x83, 0x35, 0xdb,
-	0xb1, 0x6c, 0xcb, 0xd5, 0x07, 0x55, 0x99, 0x29, 0xbf, 0x3a, 0xa9, 0xbc, 0xc9, 0x91, 0x4d, 0x01,
-	0x0c, 0x34, 0x4f, 0xdb, 0xd1, 0x11, 0xae, 0xd6, 0xea, 0x60, 0xd7, 0x0d, 0xd4, 0xce, 0x24, 0xab,
-	0x65, 0xc8, 0x58, 0xb5, 0x91, 0x11, 0xb4, 0x0e, 0x45, 0x7c, 0x44, 0xb0, 0xd9, 0xd5, 0x0e, 0x2d,
-	0x82, 0xab, 0x88, 0x69, 0xbc, 0x14, 0x73, 0x5c, 0x19, 0x68, 0xcf, 0x22, 0x38, 0x50, 0x06, 0xd8,
-	0xef, 0x44, 0xfb, 0x30, 0x7f, 0x88, 0x1d, 0xa3, 0x77, 0xcc, 0xf4, 0x68, 0x6c, 0xc4, 0x35, 0x2c,
-	0xb3, 0x3a, 0xcb, 0x34, 0x3e, 0x3b, 0xa9, 0x71, 0x8f, 0xc1, 0xa9, 0x70, 0xc3, 0x03, 0x07, 0xaa,
-	0x67, 0x0f, 0x27, 0x47, 0xa9, 0xa7, 0xf5, 0x0c, 0x53, 0x1f, 0x18, 0xdf, 0xc3, 0xda, 0xfe, 0xc0,
-	0xea, 0x3c, 0xa8, 0xce, 0x25, 0x79, 0xda, 0xba, 0xc0, 0xad, 0x51, 0x58, 0xc8, 0xd3, 0x7a, 0xe1,
-	0xfe, 0xb5, 0x29, 0xc8, 0x1e, 0xea, 0x83, 0x11, 0xde, 0xca, 0xe4, 0x33, 0x72, 0x76, 0x2b, 0x93,
-	0x9f, 0x92, 0xf3, 0x5b, 0x99, 0x7c, 0x41, 0x86, 0xad, 0x4c, 0x1e, 0xe4, 0xa2, 0x72, 0x05, 0x8a,
-	0xa1, 0x38, 0x85, 0xaa, 0x30, 0x35, 0xc4, 0xae, 0xab, 0x1f, 0x60, 0x16, 0xd7, 0x0a, 0xaa, 0xd7,
-	0x54, 0x2a, 0x50, 0x0a, 0x87, 0x26, 0xe5, 0x63, 0x09, 0x8a, 0xa1, 0xa0, 0x43, 0x25, 0x0f, 0xb1,
-	0xc3, 0x0c, 0x22, 0x24, 0x45, 0x13, 0x5d, 0x82, 0x32, 0xfb, 0x16, 0xcd, 0x1b, 0xa7, 0xb1, 0x2f,
-	0xa3, 0x96, 0x58, 0xe7, 0x9e, 0x00, 0x2d, 0x41, 0xd1, 0xbe, 0x69, 0xfb, 0x90, 0x34, 0x83, 0x80,
-	0x7d, 0xd3, 0xf6, 0x00, 0x17, 0xa1, 0x44, 0x3f, 0xdd, 0x47, 0x64, 0xd8, 0x24, 0x45, 0xda, 0x27,
-	0x20, 0xca, 0x9f, 0x52, 0x20, 0x8f, 0x07, 0x33, 0xf4, 0x32, 0x64, 0x68, 0x94, 0x17, 0x61, 0x7a,
-	0x61, 0x85, 0x47, 0xf8, 0x15, 0x2f, 0xc2, 0xaf, 0xb4, 0xbd, 0x14, 0xb0, 0x96, 0xff, 0xe4, 0xb3,
-	0xa5, 0x33, 0x1f, 0xff, 0x75, 0x49, 0x52, 0x99, 0x04, 0x3a, 0x4f, 0x23, 0x98, 0x6e, 0x98, 0x9a,
-	0xd1, 0x65, 0x4b, 0x2e, 0xd0, 0xe8, 0xa4, 0x1b, 0xe6, 0x66, 0x17, 0xdd, 0x01, 0xb9, 0x63, 0x99,
-	0x2e, 0x36, 0xdd, 0x91, 0xab, 0xf1, 0xdc, 0x24, 0x42, 0x73, 0x28, 0xbe, 0xf2, 0x24, 0xc8, 0x02,
-	0x95, 0x80, 0x36, 0x19, 0x52, 0x9d, 0xee, 0x44, 0x3b, 0xd0, 0x5b, 0x00, 0x7e, 0x02, 0x73, 0xab,
-	0x99, 0xe5, 0xf4, 0xd5, 0xe2, 0xcd, 0x8b, 0x31, 0xfe, 0xe4, 0x61, 0xee, 0xda, 0x5d, 0x9d, 0xe0,
-	0xb5, 0x0c, 0x5d, 0xb0, 0x1a, 0x12, 0x45, 0x4f, 0xc3, 0xb4, 0x6e, 0xdb, 0x9a, 0x4b, 0x74, 0x82,
-	0xb5, 0xfd, 0x63, 0x82, 0x5d, 0x16, 0xf6, 0x4b, 0x6a, 0x59, 0xb7, 0xed, 0x16, 0xed, 0x5d, 0xa3,
-	0x9d, 0xe8, 0x32, 0x54, 0x68, 0x84, 0x37, 0xf4, 0x81, 0xd6, 0xc7, 0xc6, 0x41, 0x9f, 0xb0, 0xe8,
-	0x9e, 0x56, 0xcb, 0xa2, 0x77, 0x83, 0x75, 0x2a, 0x5d, 0x28, 0x85, 0x83, 0x3b, 0x42, 0x90, 0xe9,
-	0xea, 0x44, 0x67, 0xb6, 0x2c, 0xa9, 0xec, 0x37, 0xed, 0xb3, 0x75, 0xd2, 0x17, 0x16, 0x62, 0xbf,
-	0xd1, 0x59, 0xc8, 0x09, 0xb5, 0x69, 0xa6, 0x56, 0xb4, 0xd0, 0x1c, 0x64, 0x6d, 0xc7, 0x3a, 0xc4,
-	0x6c, 0xf3, 0xf2, 0x2a, 0x6f, 0x28, 0xf7, 0xa0, 0x12, 0xcd, 0x03, 0xa8, 0x02, 0x29, 0x72, 0x24,
-	0x66, 0x49, 0x91, 0x23, 0x74, 0x03, 0x32, 0xd4, 0x98, 0x4c, 0x5b, 0x25, 0x2e, 0xfb, 0x09, 0xf9,
-	0xf6, 0xb1, 0x8d, 0x55, 0x06, 0xdd, 0xca, 0xe4, 0x53, 0x72, 0x5a, 0x99, 0x86, 0x72, 0x24, 0x4b,
-	0x28, 0x67, 0x61, 0x2e, 0x2e, 0xe6, 0x2b, 0x06, 0xcc, 0xc5, 0x85, 0x6e, 0xf4, 0x22, 0xe4, 0xfd,
-	0xa0, 0xef, 0x79, 0xd0, 0xc4, 0xec, 0xbe, 0x90, 0x8f, 0xa5, 0xbe, 0x43, 0x37, 0xa2, 0xaf, 0x8b,
-	0x54, 0x5f, 0x52, 0xa7, 0x74, 0xdb, 0xde, 0xd0, 0xdd, 0xbe, 0xf2, 0x2e, 0x54, 0x93, 0xe2, 0x79,
-	0xc8, 0x70, 0x12, 0x3b, 0x00, 0x9e, 0xe1, 0xce, 0x42, 0xae, 0x67, 0x39, 0x43, 0x9d, 0x30, 0x65,
-	0x65, 0x55, 0xb4, 0xa8, 0x41, 0x79, 0x6c, 0x4f, 0xb3, 0x6e, 0xde, 0x50, 0x34, 0x38, 0x9f, 0x18,
-	0xd2, 0xa9, 0x88, 0x61, 0x76, 0x31, 0x37, 0x6f, 0x59, 0xe5, 0x8d, 0x40, 0x11, 0x5f, 0x2c, 0x6f,
-	0xd0, 0x69, 0x5d, 0x6c, 0x76, 0xb1, 0xc3, 0xf4, 0x17, 0x54, 0xd1, 0x52, 0x7e, 0x9e, 0x86, 0xb3,
-	0xf1, 0x71, 0x1d, 0x2d, 0x43, 0x69, 0xa8, 0x1f, 0x69, 0xe4, 0x48, 0xb8, 0x9f, 0xc4, 0x1c, 0x00,
-	0x86, 0xfa, 0x51, 0xfb, 0x88, 0xfb, 0x9e, 0x0c, 0x69, 0x72, 0xe4, 0x56, 0x53, 0xcb, 0xe9, 0xab,
-	0x25, 0x95, 0xfe, 0x44, 0x7b, 0x30, 0x33, 0xb0, 0x3a, 0xfa, 0x40, 0x1b, 0xe8, 0x2e, 0xd1, 0x44,
-	0xda, 0xe7, 0xc7, 0xe9, 0xa9, 0xa4, 0x38, 0x8d, 0xbb, 0x7c, 0x63, 0x69, 0x08, 0x12, 0x07, 0x61,
-	0x9a, 0x29, 0xd9, 0xd6, 0x5d, 0xc2, 0x87, 0x50, 0x03, 0x8a, 0x43, 0xc3, 0xdd, 0xc7, 0x7d, 0xfd,
-	0xd0, 0xb0, 0x1c, 0x71, 0xae, 0x62, 0xbc, 0xe7, 0x4e, 0x00, 0x12, 0xaa, 0xc2, 0x72, 0xa1, 0x4d,
-	0xc9, 0x46, 0xbc, 0xd9, 0x8b, 0x2c, 0xb9, 0x47, 0x8e, 0x2c, 0xff, 0x07, 0x73, 0x26, 0x3e, 0x22,
-	0x5a, 0x70, 0x72, 0xb9, 0xa7, 0x4c, 0x31, 0xe3, 0x23, 0x3a, 0xe6, 0x9f, 0x75, 0x97, 0x3a, 0x0d,
-	0x7a, 0x86, 0xe5, 0x46, 0xdb, 0x72, 0xb1, 0xa3, 0xe9, 0xdd, 0xae, 0x83, 0x5d, 0x97, 0x55, 0x55,
-	0x25, 0x96, 0xef, 0x58, 0x7f, 0x8d, 0x77, 0x2b, 0x1f, 0xb1, 0xcd, 0x89, 0xcb, 0x8e, 0x9e, 0xe9,
-	0xa5, 0xc0, 0xf4, 0x6d, 0x98, 0x13, 0xf2, 0xdd, 0x88, 0xf5, 0x79, 0x79, 0x7a, 0x21, 0xa9, 0xe8,
-	0x0a, 0x59, 0x1d, 0x79, 0xf2, 0xc9, 0x86, 0x4f, 0x3f, 0xa6, 0xe1, 0x11, 0x64, 0x98, 0x59, 0x32,
-	0x3c, 0xdc, 0xd0, 0xdf, 0xff, 0x69, 0x9b, 0xf1, 0x61, 0x1a, 0x66, 0x26, 0x0a, 0x0b, 0xff, 0xc3,
-	0xa4, 0xd8, 0x0f, 0x4b, 0xc5, 0x7e, 0x58, 0xfa, 0x91, 0x3f, 0x4c, 0xec, 0x76, 0xe6, 0xf4, 0xdd,
-	0xce, 0x7e, 0x9d, 0xbb, 0x9d, 0x7b, 0xcc, 0xdd, 0xfe, 0xb7, 0xee, 0xc3, 0x2f, 0x24, 0x58, 0x48,
-	0x2e, 0xc7, 0x62, 0x37, 0xe4, 0x3a, 0xcc, 0xf8, 0x4b, 0xf1, 0xd5, 0xf3, 0xf0, 0x28, 0xfb, 0x03,
-	0x42, 0x7f, 0x62, 0xc6, 0xbb, 0x0c, 0x95, 0xb1, 0x6a, 0x91, 0x3b, 0x73, 0xf9, 0x30, 0xbc, 0x0c,
-	0xe5, 0x77, 0x69, 0x98, 0x8b, 0x2b, 0xe8, 0x62, 0x4e, 0xac, 0x0a, 0xb3, 0x5d, 0xdc, 0x31, 0xba,
-	0x8f, 0x7d, 0x60, 0x67, 0x84, 0xf8, 0xff, 0xce, 0xeb, 0xa4, 0x9f, 0xa0, 0x6b, 0x30, 0xe3, 0x1e,
-	0x9b, 0x1d, 0xc3, 0x3c, 0xd0, 0x88, 0xe5, 0xd5, 0x46, 0x05, 0xb6, 0xf2, 0x69, 0x31, 0xd0, 0xb6,
-	0x44, 0x75, 0xf4, 0x1b, 0x80, 0xbc, 0x8a, 0x5d, 0x9b, 0x16, 0x73, 0xa8, 0x0e, 0x05, 0x7c, 0xd4,
-	0xc1, 0x36, 0xf1, 0x0a, 0xe0, 0x84, 0x3b, 0x86, 0x80, 0x78, 0x72, 0xf4, 0xae, 0xed, 0xcb, 0xa1,
-	0x17, 0x04, 0xa5, 0x90, 0x48, 0x0e, 0xf0, 0x52, 0xdd, 0x17, 0xe5, 0x9c, 0xc2, 0x4b, 0x1e, 0xa7,
-	0x90, 0x4e, 0xba, 0x29, 0x8b, 0xc2, 0xdd, 0x97, 0x13, 0xa4, 0xc2, 0x0b, 0x82, 0x54, 0xc8, 0x24,
-	0x4d, 0xc7, 0xeb, 0xfb, 0x60, 0x3a, 0xc6, 0x2a, 0xdc, 0x8a, 0xb0, 0x0a, 0xb9, 0xa4, 0x4f, 0x0d,
-	0x15, 0xe2, 0xc1, 0xa7, 0x06, 0xb4, 0xc2, 0x4b, 0x1e, 0xad, 0x30, 0x95, 0xb4, 0x68, 0x51, 0x79,
-	0x06, 0x8b, 0xe6, 0xbc, 0xc2, 0x1b, 0x21, 0x5e, 0xa1, 0xc0, 0x64, 0x2f, 0x9e, 0xc0, 0x2b, 0xf8,
-	0xd2, 0x3e, 0xb1, 0xf0, 0xaa, 0x4f, 0x2c, 0x94, 0x12, 0x59, 0x09, 0x51, 0x32, 0xfa, 0xc2, 0x1e,
-	0xb3, 0xd0, 0x9c, 0x60, 0x16, 0x38, 0x11, 0x70, 0xe5, 0x54, 0x66, 0xc1, 0x57, 0x35, 0x46, 0x2d,
-	0x34, 0x27, 0xa8, 0x85, 0x4a, 0x92, 0xc6, 0xb1, 0xfa, 0x34, 0xd0, 0x18, 0xe5, 0x16, 0xbe, 0x13,
-	0xcf, 0x2d, 0x24, 0x5e, 0xfe, 0x63, 0x6a, 0x51, 0x5f, 0x75, 0x0c, 0xb9, 0xf0, 0x6e, 0x02, 0xb9,
-	0x20, 0x27, 0x5d, 0x82, 0xe3, 0x2a, 0x51, 0x7f, 0x82, 0x38, 0x76, 0x61, 0x2f, 0x86, 0x5d, 0xe0,
-	0x34, 0xc0, 0x33, 0x0f, 0xc1, 0x2e, 0xf8, 0xaa, 0x27, 0xe8, 0x85, 0xbd, 0x18, 0x7a, 0x01, 0x25,
-	0xeb, 0x1d, 0x2b, 0xa0, 0xc2, 0x7a, 0xa3, 0xfc, 0xc2, 0x5b, 0x51, 0x7e, 0x61, 0xf6, 0xe4, 0xba,
-	0x95, 0x97, 0x01, 0xbe, 0xb6, 0x30, 0xc1, 0xd0, 0x49, 0x22, 0x18, 0x38, 0x07, 0xf0, 0xdc, 0x43,
-	0x12, 0x0c, 0xbe, 0xee, 0x58, 0x86, 0xa1, 0x39, 0xc1, 0x30, 0xcc, 0x27, 0x39, 0xdc, 0x58, 0x42,
-	0x0a, 0x1c, 0x2e, 0x91, 0x62, 0xc8, 0xca, 0xb9, 0xad, 0x4c, 0x3e, 0x2f, 0x17, 0x38, 0xb9, 0xb0,
-	0x95, 0xc9, 0x17, 0xe5, 0x92, 0xf2, 0x0c, 0x2d, 0x81, 0xc6, 0xe2, 0x1e, 0xbd, 0x70, 0x60, 0xc7,
-	0xb1, 0x1c, 0x41, 0x16, 0xf0, 0x86, 0x72, 0x15, 0x4a, 0xe1, 0x10, 0x77, 0x02, 0x1d, 0x31, 0x0d,
-	0xe5, 0x48, 0x54, 0x53, 0xfe, 0x99, 0x82, 0x52, 0x38, 0x5e, 0x45, 0x2e, 0xab, 0x05, 0x71, 0x59,
-	0x0d, 0x91, 0x14, 0xa9, 0x28, 0x49, 0xb1, 0x04, 0x45, 0x7a, 0x61, 0x1b, 0xe3, 0x1f, 0x74, 0xdb,
-	0xe7, 0x1f, 0xae, 0xc1, 0x0c, 0xcb, 0xb7, 0x9c, 0xca, 0x10, 0x99, 0x21, 0xc3, 0x33, 0x03, 0x1d,
-	0x60, 0xc6, 0xe0, 0x99, 0x01, 0x3d, 0x07, 0xb3, 0x21, 0xac, 0x7f, 0x11, 0xe4, 0x57, 0x71, 0xd9,
-	0x47, 0xd7, 0xf8, 0x8d, 0x10, 0x7d, 0x0b, 0xa6, 0x07, 0xba, 0x49, 0xdd, 0xdd, 0xb0, 0x1c, 0x83,
-	0x18, 0xd8, 0x15, 0x45, 0xd4, 0xcd, 0x93, 0x43, 0xf2, 0xca, 0xb6, 0x6e, 0xe2, 0xa6, 0x2f, 0xd4,
-	0x30, 0x89, 0x73, 0xac, 0x56, 0x06, 0x91, 0x4e, 0x74, 0x11, 0x4a, 0x5d, 0xdc, 0xd3, 0x47, 0x03,
-	0xa2, 0xd1, 0x11, 0x16, 0x6f, 0x0b, 0x6a, 0x51, 0xf4, 0x51, 0x0d, 0x0b, 0x35, 0x98, 0x8d, 0xd1,
-	0x44, 0x6b, 0x8f, 0x07, 0xf8, 0x58, 0xd8, 0x8f, 0xfe, 0xa4, 0x9b, 0xc6, 0xb6, 0x5a, 0xdc, 0x42,
-	0x79, 0xe3, 0xd5, 0xd4, 0xcb, 0x92, 0xf2, 0x47, 0x09, 0x66, 0x26, 0x22, 0x7e, 0x2c, 0x4d, 0x22,
-	0x7d, 0x5d, 0x34, 0x49, 0xea, 0xf1, 0x69, 0x92, 0xf0, 0xed, 0x3c, 0x1d, 0xbd, 0x9d, 0xff, 0x43,
-	0x82, 0x72, 0x24, 0xf3, 0x50, 0x3f, 0xea, 0x58, 0x5d, 0x2c, 0xee, 0xcb, 0xec, 0x37, 0x35, 0xcd,
-	0xc0, 0x3a, 0x10, 0xb7, 0x62, 0xfa, 0x93, 0xa2, 0xfc, 0x5c, 0x5a, 0x10, 0x99, 0xd2, 0xbf, 0x6a,
-	0xf3, 0xd2, 0x47, 0x5c, 0xb5, 0x85, 0x59, 0x73, 0x6c, 0xde, 0xa8, 0x59, 0x79, 0x09, 0xc3, 0x1b,
-	0xe8, 0x15, 0x28, 0xb0, 0x47, 0x11, 0xcd, 0xb2, 0x5d, 0xc1, 0xa0, 0x87, 0xca, 0x3b, 0xfe, 0x72,
-	0x22, 0x42, 0x95, 0xd5, 0xdb, 0xb5, 0x5d, 0x35, 0x6f, 0x8b, 0x5f, 0xa1, 0xa2, 0xab, 0x10, 0x29,
-	0xba, 0x2e, 0x40, 0x81, 0x2e, 0xdf, 0xb5, 0xf5, 0x0e, 0xae, 0x02, 0x5b, 0x69, 0xd0, 0xa1, 0xfc,
-	0x21, 0x05, 0xd3, 0x63, 0x89, 0x33, 0xf6, 0xe3, 0xbd, 0x83, 0x95, 0x0a, 0xb1, 0x40, 0x0f, 0x67,
-	0x90, 0x45, 0x80, 0x03, 0xdd, 0xd5, 0x3e, 0xd0, 0x4d, 0x82, 0xbb, 0xc2, 0x2a, 0xa1, 0x1e, 0xb4,
-	0x00, 0x79, 0xda, 0x1a, 0xb9, 0xb8, 0x2b, 0x08, 0x29, 0xbf, 0x8d, 0x36, 0x21, 0x87, 0x0f, 0xb1,
-	0x49, 0xdc, 0xea, 0x14, 0xdb, 0xf8, 0x73, 0x31, 0x11, 0x96, 0x8e, 0xaf, 0x55, 0xe9, 0x76, 0xff,
-	0xfd, 0xb3, 0x25, 0x99, 0xc3, 0x9f, 0xb5, 0x86, 0x06, 0xc1, 0x43, 0x9b, 0x1c, 0xab, 0x42, 0x41,
-	0xd4, 0x0c, 0xf9, 0x31, 0x33, 0xa0, 0x73, 0x30, 0xc5, 0x4e, 0xa3, 0xd1, 0x65, 0x15, 0x42, 0x41,
-	0xcd, 0xd1, 0xe6, 0x66, 0x97, 0xd1, 0xa6, 0x25, 0x8f, 0x03, 0xa1, 0xd6, 0x66, 0xc7, 0xe5, 0x58,
-	0x2d, 0x0f, 0xf1, 0xd0, 0xb6, 0xac, 0x81, 0xc6, 0x63, 0x58, 0x0d, 0x2a, 0xd1, 0x02, 0x02, 0x5d,
-	0x82, 0xb2, 0x83, 0x89, 0x6e, 0x98, 0x5a, 0xe4, 0x8e, 0x50, 0xe2, 0x9d, 0x3c, 0x66, 0x6c, 0x65,
-	0xf2, 0x92, 0x9c, 0x12, 0xb4, 0xd5, 0xdb, 0x30, 0x1f, 0x5b, 0x3f, 0xa0, 0x97, 0xa1, 0x10, 0xd4,
-	0x1e, 0x12, 0xb3, 0xc3, 0x49, 0x7c, 0x54, 0x00, 0x56, 0xf6, 0x60, 0x3e, 0xb6, 0x80, 0x40, 0xaf,
-	0x43, 0xce, 0xc1, 0xee, 0x68, 0xc0, 0x29, 0xa7, 0xca, 0xcd, 0xcb, 0xa7, 0x57, 0x1e, 0xa3, 0x01,
-	0x51, 0x85, 0x90, 0x72, 0x03, 0xce, 0x27, 0x56, 0x10, 0x01, 0xab, 0x24, 0x85, 0x58, 0x25, 0xe5,
-	0xb7, 0x12, 0x2c, 0x24, 0x57, 0x05, 0x68, 0x6d, 0x6c, 0x41, 0xd7, 0x1e, 0xb2, 0xa6, 0x08, 0xad,
-	0x8a, 0x5e, 0xbb, 0x1c, 0xdc, 0xc3, 0xa4, 0xd3, 0xe7, 0xe5, 0x09, 0x8f, 0x16, 0x65, 0xb5, 0x2c,
-	0x7a, 0x99, 0x8c, 0xcb, 0x61, 0xef, 0xe1, 0x0e, 0xd1, 0xf8, 0xa6, 0xba, 0xec, 0xea, 0x53, 0xa0,
-	0x30, 0xda, 0xdb, 0xe2, 0x9d, 0xca, 0x75, 0x38, 0x97, 0x50, 0x67, 0x4c, 0xde, 0xcf, 0x94, 0xfb,
-	0x14, 0x1c, 0x5b, 0x3c, 0xa0, 0x37, 0x21, 0xe7, 0x12, 0x9d, 0x8c, 0x5c, 0xf1, 0x65, 0x57, 0x4e,
-	0xad, 0x3b, 0x5a, 0x0c, 0xae, 0x0a, 0x31, 0xe5, 0x35, 0x40, 0x93, 0x55, 0x44, 0xcc, 0x1d, 0x53,
-	0x8a, 0xbb, 0x63, 0xee, 0xc3, 0x13, 0x27, 0xd4, 0x0b, 0xa8, 0x3e, 0xb6, 0xb8, 0xeb, 0x0f, 0x55,
-	0x6e, 0x8c, 0x2d, 0xf0, 0xf7, 0x69, 0x98, 0x8f, 0x2d, 0x1b, 0x42, 0xc7, 0x57, 0xfa, 0xaa, 0xc7,
-	0xf7, 0x75, 0x00, 0x72, 0xa4, 0xf1, 0x9d, 0xf6, 0xd2, 0x40, 0xdc, 0x5d, 0xe9, 0x08, 0x77, 0x58,
-	0x24, 0xa3, 0x8e, 0x51, 0x20, 0xe2, 0x97, 0x8b, 0xda, 0xe1, 0x7b, 0xfd, 0x88, 0xa5, 0x08, 0x57,
-	0x5c, 0x79, 0x1f, 0x3a, 0x99, 0x04, 0x04, 0x00, 0xef, 0x76, 0xd1, 0x7d, 0x38, 0x37, 0x96, 0xea,
-	0x7c, 0xdd, 0x99, 0x87, 0xce, 0x78, 0xf3, 0xd1, 0x8c, 0xe7, 0xe9, 0x0e, 0xa7, 0xab, 0x6c, 0x24,
-	0x5d, 0xd1, 0x0c, 0xcb, 0x2e, 0xc3, 0xbc, 0xd2, 0xe8, 0xe2, 0x81, 0xee, 0x3d, 0xd4, 0x9e, 0x9f,
-	0xb8, 0x52, 0xdf, 0x12, 0x6f, 0xd9, 0xfc, 0x46, 0xfd, 0x33, 0x7a, 0xa3, 0xae, 0x50, 0x61, 0xb6,
-	0x51, 0xb7, 0xa8, 0xa8, 0x72, 0x1f, 0x20, 0xe0, 0x0b, 0xe8, 0xf1, 0x75, 0xac, 0x91, 0xd9, 0x65,
-	0x1e, 0x91, 0x55, 0x79, 0x03, 0xbd, 0x08, 0x59, 0xea, 0x58, 0x9e, 0xe5, 0x63, 0xe2, 0x0f, 0xf5,
-	0x90, 0x10, 0xe1, 0xc0, 0xe1, 0xca, 0x7b, 0x9e, 0xf3, 0x86, 0xa9, 0xdb, 0x84, 0x39, 0xde, 0x88,
-	0xce, 0xa1, 0x24, 0xb3, 0xc0, 0xf1, 0x73, 0x7d, 0x1f, 0xb2, 0xcc, 0x9b, 0x68, 0x16, 0x62, 0x2f,
-	0x07, 0xa2, 0x08, 0xa4, 0xbf, 0xd1, 0x77, 0x01, 0x74, 0x42, 0x1c, 0x63, 0x7f, 0x14, 0xcc, 0xb0,
-	0x9c, 0xe0, 0x8e, 0x35, 0x0f, 0xb8, 0x76, 0x41, 0xf8, 0xe5, 0x5c, 0x20, 0x1b, 0xf2, 0xcd, 0x90,
-	0x46, 0x65, 0x07, 0x2a, 0x51, 0xd9, 0xd3, 0x2a, 0xa9, 0x82, 0x97, 0xf2, 0xfd, 0x82, 0x21, 0xcd,
-	0xdf, 0x47, 0x58, 0x43, 0xf9, 0x41, 0x0a, 0x4a, 0x61, 0x67, 0xfe, 0x2f, 0x4c, 0xca, 0xca, 0x8f,
-	0x25, 0xc8, 0xfb, 0xdf, 0x1f, 0x7d, 0x25, 0x89, 0x3c, 0x2f, 0x71, 0xf3, 0xa5, 0xc2, 0x4f, 0x1b,
-	0xfc, 0x31, 0x29, 0xed, 0x3f, 0x26, 0x7d, 0xc3, 0xcf, 0x2f, 0x89, 0xbc, 0x47, 0xd8, 0xda, 0xc2,
-	0xb1, 0xbc, 0x7c, 0xf7, 0x1a, 0x14, 0xfc, 0x90, 0x40, 0xaf, 0x13, 0x1e, 0x9f, 0x24, 0x89, 0x73,
-	0x29, 0x78, 0xa4, 0x39, 0xc8, 0xda, 0xd6, 0x07, 0xe2, 0xe1, 0x24, 0xad, 0xf2, 0x86, 0xe2, 0xc2,
-	0xf4, 0x58, 0x3c, 0x09, 0x80, 0xa9, 0x10, 0x10, 0x29, 0x50, 0xb6, 0x47, 0xfb, 0xda, 0x03, 0x7c,
-	0x2c, 0x9e, 0x51, 0xf8, 0xf2, 0x8b, 0xf6, 0x68, 0xff, 0x36, 0x3e, 0xe6, 0xef, 0x28, 0xcb, 0x50,
-	0xf2, 0x30, 0xcc, 0xc5, 0xf9, 0x9e, 0x02, 0x87, 0xb4, 0xf9, 0x1b, 0x98, 0x24, 0xa7, 0x94, 0x9f,
-	0x4a, 0x90, 0xf7, 0x4e, 0x09, 0x7a, 0x13, 0x0a, 0x7e, 0xe8, 0x12, 0xa5, 0xf8, 0x13, 0x27, 0x04,
-	0x3d, 0xf1, 0xf1, 0x81, 0x0c, 0x5a, 0xf3, 0x1e, 0x73, 0x8d, 0xae, 0xd6, 0x1b, 0xe8, 0x07, 0xe2,
-	0x4d, 0x6e, 0x31, 0x26, 0xba, 0xb1, 0xb8, 0xb2, 0x79, 0x6b, 0x7d, 0xa0, 0x1f, 0xa8, 0x45, 0x26,
-	0xb4, 0xd9, 0xa5, 0x0d, 0x51, 0xe4, 0x7c, 0x29, 0x81, 0x3c, 0x7e, 0x8a, 0xbf, 0xfa, 0xfa, 0x26,
-	0x93, 0x61, 0x3a, 0x26, 0x19, 0xa2, 0x55, 0x98, 0xf5, 0x11, 0x9a, 0x6b, 0x1c, 0x98, 0x3a, 0x19,
-	0x39, 0x58, 0x30, 0x97, 0xc8, 0x1f, 0x6a, 0x79, 0x23, 0x93, 0xdf, 0x9d, 0x7d, 0xdc, 0xef, 0xfe,
-	0x30, 0x05, 0xc5, 0x10, 0x91, 0x8a, 0xfe, 0x3f, 0x14, 0xa2, 0x2a, 0x71, 0x29, 0x28, 0x04, 0x0e,
-	0x1e, 0x38, 0xa3, 0x96, 0x4a, 0x3d, 0x86, 0xa5, 0x92, 0x28, 0x6b, 0x8f, 0x99, 0xcd, 0x3c, 0x32,
-	0x33, 0xfb, 0x2c, 0x20, 0x62, 0x11, 0x7d, 0xa0, 0x1d, 0x5a, 0xc4, 0x30, 0x0f, 0x34, 0xee, 0xd8,
-	0x3c, 0xa2, 0xc8, 0x6c, 0x64, 0x8f, 0x0d, 0x34, 0xd9, 0x61, 0xf8, 0xa1, 0x04, 0x79, 0x9f, 0xb5,
-	0x7a, 0xd4, 0x87, 0xcf, 0xb3, 0x90, 0x13, 0x85, 0x1d, 0x7f, 0xf9, 0x14, 0xad, 0x58, 0x0a, 0x7a,
-	0x01, 0xf2, 0x43, 0x4c, 0x74, 0x16, 0x1e, 0x79, 0xfa, 0xf4, 0xdb, 0xd7, 0xf6, 0xa1, 0x18, 0x7a,
-	0x3b, 0x46, 0xe7, 0x61, 0xbe, 0xbe, 0xd1, 0xa8, 0xdf, 0xd6, 0xda, 0xef, 0x68, 0xed, 0x7b, 0xcd,
-	0x86, 0x76, 0x77, 0xe7, 0xf6, 0xce, 0xee, 0x37, 0x77, 0xe4, 0x33, 0x93, 0x43, 0x6a, 0x83, 0xb5,
-	0x65, 0x09, 0x9d, 0x83, 0xd9, 0xe8, 0x10, 0x1f, 0x48, 0x2d, 0x64, 0x7e, 0xf2, 0xeb, 0xc5, 0x33,
-	0xd7, 0xbe, 0x94, 0x60, 0x36, 0xa6, 0x84, 0x46, 0x17, 0xe1, 0xc9, 0xdd, 0xf5, 0xf5, 0x86, 0xaa,
-	0xb5, 0x76, 0x6a, 0xcd, 0xd6, 0xc6, 0x6e, 0x5b, 0x53, 0x1b, 0xad, 0xbb, 0xdb, 0xed, 0xd0, 0xa4,
-	0xcb, 0x70, 0x21, 0x1e, 0x52, 0xab, 0xd7, 0x1b, 0xcd, 0xb6, 0x2c, 0xa1, 0x25, 0x78, 0x22, 0x01,
-	0xb1, 0xb6, 0xab, 0xb6, 0xe5, 0x54, 0xb2, 0x0a, 0xb5, 0xb1, 0xd5, 0xa8, 0xb7, 0xe5, 0x34, 0xba,
-	0x02, 0x97, 0x4e, 0x42, 0x68, 0xeb, 0xbb, 0xea, 0x9d, 0x5a, 0x5b, 0xce, 0x9c, 0x0a, 0x6c, 0x35,
-	0x76, 0x6e, 0x35, 0x54, 0x39, 0x2b, 0xbe, 0xfb, 0x57, 0x29, 0xa8, 0x26, 0x55, 0xea, 0x54, 0x57,
-	0xad, 0xd9, 0xdc, 0xbe, 0x17, 0xe8, 0xaa, 0x6f, 0xdc, 0xdd, 0xb9, 0x3d, 0x69, 0x82, 0xa7, 0x41,
-	0x39, 0x09, 0xe8, 0x1b, 0xe2, 0x32, 0x5c, 0x3c, 0x11, 0x27, 0xcc, 0x71, 0x0a, 0x4c, 0x6d, 0xb4,
-	0xd5, 0x7b, 0x72, 0x1a, 0xad, 0xc0, 0xb5, 0x53, 0x61, 0xfe, 0x98, 0x9c, 0x41, 0xab, 0x70, 0xfd,
-	0x64, 0x3c, 0x37, 0x90, 0x27, 0xe0, 0x99, 0xe8, 0x23, 0x09, 0xe6, 0x63, 0x4b, 0x7e, 0x74, 0x09,
-	0x96, 0x9a, 0xea, 0x6e, 0xbd, 0xd1, 0x6a, 0x69, 0x4d, 0x75, 0xb7, 0xb9, 0xdb, 0xaa, 0x6d, 0x6b,
-	0xad, 0x76, 0xad, 0x7d, 0xb7, 0x15, 0xb2, 0x8d, 0x02, 0x8b, 0x49, 0x20, 0xdf, 0x2e, 0x27, 0x60,
-	0x84, 0x07, 0x78, 0x7e, 0xfa, 0x4b, 0x09, 0xce, 0x27, 0x96, 0xf8, 0xe8, 0x2a, 0x3c, 0xb5, 0xd7,
-	0x50, 0x37, 0xd7, 0xef, 0x69, 0x7b, 0xbb, 0xed, 0x86, 0xd6, 0x78, 0xa7, 0xdd, 0xd8, 0x69, 0x6d,
-	0xee, 0xee, 0x4c, 0xae, 0xea, 0x0a, 0x5c, 0x3a, 0x11, 0xe9, 0x2f, 0xed, 0x34, 0xe0, 0xd8, 0xfa,
-	0x7e, 0x24, 0xc1, 0xf4, 0x58, 0x2c, 0x44, 0x17, 0xa0, 0x7a, 0x67, 0xb3, 0xb5, 0xd6, 0xd8, 0xa8,
-	0xed, 0x6d, 0xee, 0xaa, 0xe3, 0x67, 0xf6, 0x12, 0x2c, 0x4d, 0x8c, 0xde, 0xba, 0xdb, 0xdc, 0xde,
-	0xac, 0xd7, 0xda, 0x0d, 0x36, 0xa9, 0x2c, 0xd1, 0x0f, 0x9b, 0x00, 0x6d, 0x6f, 0xbe, 0xb5, 0xd1,
-	0xd6, 0xea, 0xdb, 0x9b, 0x8d, 0x9d, 0xb6, 0x56, 0x6b, 0xb7, 0x6b, 0xc1, 0x71, 0x5e, 0xbb, 0xfd,
-	0xc9, 0xe7, 0x8b, 0xd2, 0xa7, 0x9f, 0x2f, 0x4a, 0x7f, 0xfb, 0x7c, 0x51, 0xfa, 0xf8, 0x8b, 0xc5,
-	0x33, 0x9f, 0x7e, 0xb1, 0x78, 0xe6, 0x2f, 0x5f, 0x2c, 0x9e, 0xb9, 0x7f, 0xe3, 0xc0, 0x20, 0xfd,
-	0xd1, 0x3e, 0x8d, 0xc2, 0xab, 0xc1, 0x5f, 0x5c, 0xfd, 0xff, 0xc6, 0xda, 0xc6, 0xea, 0xf8, 0x1f,
-	0x65, 0xf7, 0x73, 0x2c, 0xac, 0x3e, 0xff, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd0, 0x2b, 0x8f,
-	0x43, 0x43, 0x2b, 0x00, 0x00,
+	// 3411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xd9, 0xf7, 0x92, 0x14, 0x45, 0x3e, 0xfc, 0xd0, 0x6a, 0x24, 0xd9, 0xb4, 0xec, 0x48, 0xf2, 0x3a,
+	0x8e, 0x1d, 0x3b, 0x91, 0x5e, 0x3b, 0x6f, 0xf3, 0xd9, 0x24, 0xa5, 0x68, 0x2a, 0x92, 0x2c, 0x4b,
+	0xcc, 0x92, 0x56, 0x63, 0xf7, 0x63, 0xb3, 0x22, 0x87, 0xe2, 0xc6, 0x24, 0x77, 0xb3, 0x3b, 0x54,
+	0xc8, 0xf6, 0xd4, 0x02, 0x29, 0x8a, 0x9c, 0x72, 0x29, 0x50, 0x14, 0x2d, 0x50, 0xa0, 0xe8, 0xb1,
+	0x3d, 0xf4, 0x7f, 0x68, 0x91, 0x53, 0x93, 0x63, 0x4f, 0x69, 0x91, 0xdc, 0x7a, 0x0f, 0x50, 0xa0,
+	0x87, 0x16, 0xf3, 0xb1, 0x5f, 0xe4, 0xae, 0x64, 0x2b, 0xc9, 0xa1, 0x68, 0x6f, 0x9c, 0x99, 0xdf,
+	0xf3, 0xcc, 0xec, 0x33, 0x33, 0xcf, 0xc7, 0x6f, 0x08, 0x17, 0x9b, 0x66, 0x0f, 0x93, 0x83, 0x36,
+	0x59, 0xd3, 0x0f, 0x9a, 0xc6, 0xda, 0xd1, 0xcd, 0x35, 0x32, 0xb2, 0xb0, 0xb3, 0x6a, 0xd9, 0x26,
+	0x31, 0x91, 0xec, 0x8e, 0xae, 0xd2, 0xd1, 0xd5, 0xa3, 0x9b, 0x8b, 0x4b, 0x1e, 0xbe, 0x69, 0x8f,
+	0x2c, 0x62, 0x52, 0x09, 0xcb, 0x36, 0xcd, 0x36, 0x97, 0x08, 0x8c, 0x33, 0x3d, 0x6c, 0x58, 0xb7,
+	0xf5, 0x9e, 0xd0, 0xb8, 0x78, 0x69, 0x72, 0xfc, 0x48, 0xef, 0x1a, 0x2d, 0x9d, 0x98, 0xb6, 0x80,
+	0xcc, 0x1f, 0x9a, 0x87, 0x26, 0xfb, 0xb9, 0x46, 0x7f, 0x89, 0xde, 0xe5, 0x43, 0xd3, 0x3c, 0xec,
+	0xe2, 0x35, 0xd6, 0x3a, 0x18, 0xb4, 0xd7, 0x88, 0xd1, 0xc3, 0x0e, 0xd1, 0x7b, 0x96, 0x3b, 0xf3,
+	0x38, 0xa0, 0x35, 0xb0, 0x75, 0x62, 0x98, 0x7d, 0x3e, 0xae, 0x7c, 0x9c, 0x85, 0x69, 0x15, 0xbf,
+	0x3b, 0xc0, 0x0e, 0x41, 0xcf, 0x41, 0x0a, 0x37, 0x3b, 0x66, 0x49, 0x5a, 0x91, 0xae, 0xe5, 0x6e,
+	0x3d, 0xb1, 0x3a, 0xfe, 0x99, 0xab, 0xd5, 0x66, 0xc7, 0x14, 0xe0, 0xcd, 0x33, 0x2a, 0x03, 0xa3,
+	0xe7, 0x61, 0xaa, 0xdd, 0x1d, 0x38, 0x9d, 0x52, 0x82, 0x49, 0x2d, 0x4d, 0x4a, 0x6d, 0xd0, 0x61,
+	0x5f, 0x8c, 0xc3, 0xe9, 0x64, 0x46, 0xbf, 0x6d, 0x96, 0x92, 0x71, 0x93, 0x6d, 0xf5, 0xdb, 0xc1,
+	0xc9, 0x28, 0x18, 0x55, 0x00, 0x8c, 0xbe, 0x41, 0xb4, 0x66, 0x47, 0x37, 0xfa, 0xa5, 0x29, 0x26,
+	0xaa, 0x44, 0x89, 0x1a, 0xa4, 0x42, 0x21, 0xbe, 0x7c, 0xd6, 0x70, 0xfb, 0xe8, 0x8a, 0xdf, 0x1d,
+	0x60, 0x7b, 0x54, 0x4a, 0xc7, 0xad, 0xf8, 0x4d, 0x3a, 0x1c, 0x58, 0x31, 0x83, 0xa3, 0x57, 0x21,
+	0xd3, 0xec, 0xe0, 0xe6, 0x43, 0x8d, 0x0c, 0x4b, 0x19, 0x26, 0xba, 0x32, 0x29, 0x5a, 0xa1, 0x88,
+	0xc6, 0xd0, 0x17, 0x9e, 0x6e, 0xf2, 0x1e, 0xf4, 0x12, 0xa4, 0x9b, 0x66, 0xaf, 0x67, 0x90, 0x52,
+	0x8e, 0x09, 0x2f, 0x47, 0x08, 0xb3, 0x71, 0x5f, 0x56, 0x08, 0xa0, 0x3d, 0x28, 0x76, 0x0d, 0x87,
+	0x68, 0x4e, 0x5f, 0xb7, 0x9c, 0x8e, 0x49, 0x9c, 0x52, 0x9e, 0xa9, 0x78, 0x6a, 0x52, 0xc5, 0x8e,
+	0xe1, 0x90, 0xba, 0x0b, 0xf3, 0x35, 0x15, 0xba, 0xc1, 0x7e, 0xaa, 0xd0, 0x6c, 0xb7, 0xb1, 0xed,
+	0x69, 0x2c, 0x15, 0xe2, 0x14, 0xee, 0x51, 0x9c, 0x2b, 0x19, 0x50, 0x68, 0x06, 0xfb, 0xd1, 0x77,
+	0x61, 0xae, 0x6b, 0xea, 0x2d, 0x4f, 0x9f, 0xd6, 0xec, 0x0c, 0xfa, 0x0f, 0x4b, 0x45, 0xa6, 0xf5,
+	0x7a, 0xc4, 0x32, 0x4d, 0xbd, 0xe5, 0x0a, 0x57, 0x28, 0xd4, 0xd7, 0x3c, 0xdb, 0x1d, 0x1f, 0x43,
+	0x1a, 0xcc, 0xeb, 0x96, 0xd5, 0x1d, 0x8d, 0xab, 0x9f, 0x61, 0xea, 0x6f, 0x4c, 0xaa, 0x2f, 0x53,
+	0x74, 0x8c, 0x7e, 0xa4, 0x4f, 0x0c, 0xa2, 0x7b, 0x20, 0x5b, 0x36, 0xb6, 0x74, 0x1b, 0x6b, 0x96,
+	0x6d, 0x5a, 0xa6, 0xa3, 0x77, 0x4b, 0x32, 0x53, 0x7e, 0x6d, 0x52, 0x79, 0x8d, 0x23, 0x6b, 0x02,
+	0xe8, 0x6b, 0x9e, 0xb1, 0xc2, 0x23, 0x5c, 0xad, 0xd9, 0xc4, 0x8e, 0xe3, 0xab, 0x9d, 0x8d, 0x57,
+	0xcb, 0x90, 0x91, 0x6a, 0x43, 0x23, 0x68, 0x03, 0x72, 0x78, 0x48, 0x70, 0xbf, 0xa5, 0x1d, 0x99,
+	0x04, 0x97, 0x10, 0xd3, 0x78, 0x39, 0xe2, 0xba, 0x32, 0xd0, 0xbe, 0x49, 0xb0, 0xaf, 0x0c, 0xb0,
+	0xd7, 0x89, 0x0e, 0x60, 0xe1, 0x08, 0xdb, 0x46, 0x7b, 0xc4, 0xf4, 0x68, 0x6c, 0xc4, 0x31, 0xcc,
+	0x7e, 0x69, 0x8e, 0x69, 0x7c, 0x66, 0x52, 0xe3, 0x3e, 0x83, 0x53, 0xe1, 0xaa, 0x0b, 0xf6, 0x55,
+	0xcf, 0x1d, 0x4d, 0x8e, 0xd2, 0x93, 0xd6, 0x36, 0xfa, 0x7a, 0xd7, 0xf8, 0x01, 0xd6, 0x0e, 0xba,
+	0x66, 0xf3, 0x61, 0x69, 0x3e, 0xee, 0xa4, 0x6d, 0x08, 0xdc, 0x3a, 0x85, 0x05, 0x4e, 0x5a, 0x3b,
+	0xd8, 0xbf, 0x3e, 0x0d, 0x53, 0x47, 0x7a, 0x77, 0x80, 0xb7, 0x53, 0x99, 0x94, 0x3c, 0xb5, 0x9d,
+	0xca, 0x4c, 0xcb, 0x99, 0xed, 0x54, 0x26, 0x2b, 0xc3, 0x76, 0x2a, 0x03, 0x72, 0x4e, 0xb9, 0x0a,
+	0xb9, 0x80, 0x9f, 0x42, 0x25, 0x98, 0xee, 0x61, 0xc7, 0xd1, 0x0f, 0x31, 0xf3, 0x6b, 0x59, 0xd5,
+	0x6d, 0x2a, 0x45, 0xc8, 0x07, 0x5d, 0x93, 0xf2, 0xa1, 0x04, 0xb9, 0x80, 0xd3, 0xa1, 0x92, 0x47,
+	0xd8, 0x66, 0x06, 0x11, 0x92, 0xa2, 0x89, 0x2e, 0x43, 0x81, 0x7d, 0x8b, 0xe6, 0x8e, 0x53, 0xdf,
+	0x97, 0x52, 0xf3, 0xac, 0x73, 0x5f, 0x80, 0x96, 0x21, 0x67, 0xdd, 0xb2, 0x3c, 0x48, 0x92, 0x41,
+	0xc0, 0xba, 0x65, 0xb9, 0x80, 0x4b, 0x90, 0xa7, 0x9f, 0xee, 0x21, 0x52, 0x6c, 0x92, 0x1c, 0xed,
+	0x13, 0x10, 0xe5, 0xcf, 0x09, 0x90, 0xc7, 0x9d, 0x19, 0x7a, 0x11, 0x52, 0xd4, 0xcb, 0x0b, 0x37,
+	0xbd, 0xb8, 0xca, 0x3d, 0xfc, 0xaa, 0xeb, 0xe1, 0x57, 0x1b, 0x6e, 0x08, 0x58, 0xcf, 0x7c, 0xf4,
+	0xe9, 0xf2, 0x99, 0x0f, 0xff, 0xba, 0x2c, 0xa9, 0x4c, 0x02, 0x9d, 0xa7, 0x1e, 0x4c, 0x37, 0xfa,
+	0x9a, 0xd1, 0x62, 0x4b, 0xce, 0x52, 0xef, 0xa4, 0x1b, 0xfd, 0xad, 0x16, 0xba, 0x0b, 0x72, 0xd3,
+	0xec, 0x3b, 0xb8, 0xef, 0x0c, 0x1c, 0x8d, 0xc7, 0xa6, 0x52, 0x72, 0xdc, 0xbf, 0xf2, 0x20, 0xc8,
+	0x1c, 0x95, 0x80, 0xd6, 0x18, 0x52, 0x9d, 0x69, 0x86, 0x3b, 0xd0, 0x1b, 0x00, 0x5e, 0x00, 0x73,
+	0x4a, 0xa9, 0x95, 0xe4, 0xb5, 0xdc, 0xad, 0x4b, 0x11, 0xe7, 0xc9, 0xc5, 0xdc, 0xb3, 0x5a, 0x3a,
+	0xc1, 0xeb, 0x29, 0xba, 0x60, 0x35, 0x20, 0x8a, 0x9e, 0x82, 0x19, 0xdd, 0xb2, 0x34, 0x87, 0xe8,
+	0x04, 0x6b, 0x07, 0x23, 0x82, 0x1d, 0xe6, 0xf6, 0xf3, 0x6a, 0x41, 0xb7, 0xac, 0x3a, 0xed, 0x5d,
+	0xa7, 0x9d, 0xe8, 0x0a, 0x14, 0xa9, 0x87, 0x37, 0xf4, 0xae, 0xd6, 0xc1, 0xc6, 0x61, 0x87, 0x30,
+	0xef, 0x9e, 0x54, 0x0b, 0xa2, 0x77, 0x93, 0x75, 0x2a, 0x2d, 0xc8, 0x07, 0x9d, 0x3b, 0x42, 0x90,
+	0x6a, 0xe9, 0x44, 0x67, 0xb6, 0xcc, 0xab, 0xec, 0x37, 0xed, 0xb3, 0x74, 0xd2, 0x11, 0x16, 0x62,
+	0xbf, 0xd1, 0x59, 0x48, 0x0b, 0xb5, 0x49, 0xa6, 0x56, 0xb4, 0xd0, 0x3c, 0x4c, 0x59, 0xb6, 0x79,
+	0x84, 0xd9, 0xe6, 0x65, 0x54, 0xde, 0x50, 0xee, 0x43, 0x31, 0x1c, 0x07, 0x50, 0x11, 0x12, 0x64,
+	0x28, 0x66, 0x49, 0x90, 0x21, 0xba, 0x09, 0x29, 0x6a, 0x4c, 0xa6, 0xad, 0x18, 0x15, 0xfd, 0x84,
+	0x7c, 0x63, 0x64, 0x61, 0x95, 0x41, 0xb7, 0x53, 0x99, 0x84, 0x9c, 0x54, 0x66, 0xa0, 0x10, 0x8a,
+	0x12, 0xca, 0x59, 0x98, 0x8f, 0xf2, 0xf9, 0x8a, 0x01, 0xf3, 0x51, 0xae, 0x1b, 0x3d, 0x0f, 0x19,
+	0xcf, 0xe9, 0xbb, 0x27, 0x68, 0x62, 0x76, 0x4f, 0xc8, 0xc3, 0xd2, 0xb3, 0x43, 0x37, 0xa2, 0xa3,
+	0x8b, 0x50, 0x9f, 0x57, 0xa7, 0x75, 0xcb, 0xda, 0xd4, 0x9d, 0x8e, 0xf2, 0x36, 0x94, 0xe2, 0xfc,
+	0x79, 0xc0, 0x70, 0x12, 0xbb, 0x00, 0xae, 0xe1, 0xce, 0x42, 0xba, 0x6d, 0xda, 0x3d, 0x9d, 0x30,
+	0x65, 0x05, 0x55, 0xb4, 0xa8, 0x41, 0xb9, 0x6f, 0x4f, 0xb2, 0x6e, 0xde, 0x50, 0x34, 0x38, 0x1f,
+	0xeb, 0xd2, 0xa9, 0x88, 0xd1, 0x6f, 0x61, 0x6e, 0xde, 0x82, 0xca, 0x1b, 0xbe, 0x22, 0xbe, 0x58,
+	0xde, 0xa0, 0xd3, 0x3a, 0xb8, 0xdf, 0xc2, 0x36, 0xd3, 0x9f, 0x55, 0x45, 0x4b, 0xf9, 0x45, 0x12,
+	0xce, 0x46, 0xfb, 0x75, 0xb4, 0x02, 0xf9, 0x9e, 0x3e, 0xd4, 0xc8, 0x50, 0x1c, 0x3f, 0x89, 0x1d,
+	0x00, 0xe8, 0xe9, 0xc3, 0xc6, 0x90, 0x9f, 0x3d, 0x19, 0x92, 0x64, 0xe8, 0x94, 0x12, 0x2b, 0xc9,
+	0x6b, 0x79, 0x95, 0xfe, 0x44, 0xfb, 0x30, 0xdb, 0x35, 0x9b, 0x7a, 0x57, 0xeb, 0xea, 0x0e, 0xd1,
+	0x44, 0xd8, 0xe7, 0xd7, 0xe9, 0xc9, 0x38, 0x3f, 0x8d, 0x5b, 0x7c, 0x63, 0xa9, 0x0b, 0x12, 0x17,
+	0x61, 0x86, 0x29, 0xd9, 0xd1, 0x1d, 0xc2, 0x87, 0x50, 0x15, 0x72, 0x3d, 0xc3, 0x39, 0xc0, 0x1d,
+	0xfd, 0xc8, 0x30, 0x6d, 0x71, 0xaf, 0x22, 0x4e, 0xcf, 0x5d, 0x1f, 0x24, 0x54, 0x05, 0xe5, 0x02,
+	0x9b, 0x32, 0x15, 0x3a, 0xcd, 0xae, 0x67, 0x49, 0x3f, 0xb6, 0x67, 0xf9, 0x3f, 0x98, 0xef, 0xe3,
+	0x21, 0xd1, 0xfc, 0x9b, 0xcb, 0x4f, 0xca, 0x34, 0x33, 0x3e, 0xa2, 0x63, 0xde, 0x5d, 0x77, 0xe8,
+	0xa1, 0x41, 0x4f, 0xb3, 0xd8, 0x68, 0x99, 0x0e, 0xb6, 0x35, 0xbd, 0xd5, 0xb2, 0xb1, 0xe3, 0xb0,
+	0xac, 0x2a, 0xaf, 0xce, 0xb8, 0xfd, 0x65, 0xde, 0xad, 0x7c, 0xc0, 0x36, 0x27, 0x2a, 0x3a, 0xba,
+	0xa6, 0x97, 0x7c, 0xd3, 0x37, 0x60, 0x5e, 0xc8, 0xb7, 0x42, 0xd6, 0xe7, 0xe9, 0xe9, 0xc5, 0xb8,
+	0xa4, 0x2b, 0x60, 0x75, 0xe4, 0xca, 0xc7, 0x1b, 0x3e, 0x79, 0x4a, 0xc3, 0x23, 0x48, 0x31, 0xb3,
+	0xa4, 0xb8, 0xbb, 0xa1, 0xbf, 0xff, 0xd3, 0x36, 0xe3, 0xfd, 0x24, 0xcc, 0x4e, 0x24, 0x16, 0xde,
+	0x87, 0x49, 0x91, 0x1f, 0x96, 0x88, 0xfc, 0xb0, 0xe4, 0x63, 0x7f, 0x98, 0xd8, 0xed, 0xd4, 0xc9,
+	0xbb, 0x3d, 0xf5, 0x55, 0xee, 0x76, 0xfa, 0x94, 0xbb, 0xfd, 0xb5, 0xee, 0xc3, 0x2f, 0x25, 0x58,
+	0x8c, 0x4f, 0xc7, 0x22, 0x37, 0xe4, 0x06, 0xcc, 0x7a, 0x4b, 0xf1, 0xd4, 0x73, 0xf7, 0x28, 0x7b,
+	0x03, 0x42, 0x7f, 0x6c, 0xc4, 0xbb, 0x02, 0xc5, 0xb1, 0x6c, 0x91, 0x1f, 0xe6, 0xc2, 0x51, 0x70,
+	0x19, 0xca, 0x1f, 0x92, 0x30, 0x1f, 0x95, 0xd0, 0x45, 0xdc, 0x58, 0x15, 0xe6, 0x5a, 0xb8, 0x69,
+	0xb4, 0x4e, 0x7d, 0x61, 0x67, 0x85, 0xf8, 0xff, 0xee, 0xeb, 0xe4, 0x39, 0x41, 0xd7, 0x61, 0xd6,
+	0x19, 0xf5, 0x9b, 0x46, 0xff, 0x50, 0x23, 0xa6, 0x9b, 0x1b, 0x65, 0xd9, 0xca, 0x67, 0xc4, 0x40,
+	0xc3, 0x14, 0xd9, 0xd1, 0x6f, 0x01, 0x32, 0x2a, 0x76, 0x2c, 0x9a, 0xcc, 0xa1, 0x0a, 0x64, 0xf1,
+	0xb0, 0x89, 0x2d, 0xe2, 0x26, 0xc0, 0x31, 0x35, 0x86, 0x80, 0xb8, 0x72, 0xb4, 0xd6, 0xf6, 0xe4,
+	0xd0, 0xff, 0x0b, 0x4a, 0x21, 0x96, 0x1c, 0xe0, 0xa9, 0xba, 0x27, 0xca, 0xd0, 0xe8, 0x05, 0x97,
+	0x53, 0x48, 0xc6, 0x55, 0xca, 0x22, 0x71, 0xf7, 0xe4, 0x38, 0x9e, 0x4e, 0xc7, 0x48, 0x85, 0x54,
+	0xdc, 0x74, 0x3c, 0xbf, 0xf7, 0xa7, 0xa3, 0x68, 0x74, 0x3b, 0xc4, 0x2a, 0xa4, 0xe3, 0x3e, 0x35,
+	0x90, 0x88, 0xfb, 0x9f, 0xea, 0xd3, 0x0a, 0x2f, 0xb8, 0xb4, 0xc2, 0x74, 0xdc, 0xa2, 0x45, 0xe6,
+	0xe9, 0x2f, 0x9a, 0xe1, 0xd1, 0x6b, 0x01, 0x5e, 0x21, 0xbb, 0x22, 0x45, 0x67, 0xca, 0x5e, 0x3e,
+	0xe9, 0x49, 0x7b, 0xc4, 0xc2, 0xcb, 0x1e, 0xb1, 0x90, 0x8f, 0x65, 0x25, 0x44, 0xca, 0xe8, 0x09,
+	0x0b, 0x09, 0x54, 0x9b, 0x60, 0x16, 0x38, 0x11, 0x70, 0xf5, 0x44, 0x66, 0xc1, 0x53, 0x35, 0x46,
+	0x2d, 0xd4, 0x26, 0xa8, 0x85, 0x62, 0x9c, 0xc6, 0xb1, 0xfc, 0xd4, 0xd7, 0x18, 0xe6, 0x16, 0xbe,
+	0x17, 0xcd, 0x2d, 0xc4, 0x16, 0xff, 0x11, 0xb9, 0xa8, 0xa7, 0x3a, 0x82, 0x5c, 0x78, 0x3b, 0x86,
+	0x5c, 0x90, 0xe3, 0x8a, 0xe0, 0xa8, 0x4c, 0xd4, 0x9b, 0x20, 0x8a, 0x5d, 0xd8, 0x8f, 0x60, 0x17,
+	0x38, 0x0d, 0xf0, 0xf4, 0x23, 0xb0, 0x0b, 0x9e, 0xea, 0x09, 0x7a, 0x61, 0x3f, 0x82, 0x5e, 0x40,
+	0xf1, 0x7a, 0xc7, 0x12, 0xa8, 0xa0, 0xde, 0xd0, 0x10, 0x7a, 0x23, 0xcc, 0x2f, 0xcc, 0x1d, 0x9f,
+	0xb7, 0xf2, 0x34, 0xc0, 0xd3, 0x16, 0x24, 0x18, 0x9a, 0x71, 0x04, 0x03, 0xe7, 0x00, 0x9e, 0x7d,
+	0x44, 0x82, 0xc1, 0xd3, 0x1d, 0xc9, 0x30, 0xd4, 0x26, 0x18, 0x86, 0x85, 0xb8, 0x03, 0x37, 0x16,
+	0x90, 0xfc, 0x03, 0x17, 0x4b, 0x31, 0x4c, 0xc9, 0xe9, 0xed, 0x54, 0x26, 0x23, 0x67, 0x39, 0xb9,
+	0xb0, 0x9d, 0xca, 0xe4, 0xe4, 0xbc, 0xf2, 0x34, 0x4d, 0x81, 0xc6, 0xfc, 0x1e, 0x2d, 0x38, 0xb0,
+	0x6d, 0x9b, 0xb6, 0x20, 0x0b, 0x78, 0x43, 0xb9, 0x06, 0xf9, 0xa0, 0x8b, 0x3b, 0x86, 0x8e, 0x98,
+	0x81, 0x42, 0xc8, 0xab, 0x29, 0xff, 0x4c, 0x40, 0x3e, 0xe8, 0xaf, 0x42, 0xc5, 0x6a, 0x56, 0x14,
+	0xab, 0x01, 0x92, 0x22, 0x11, 0x26, 0x29, 0x96, 0x21, 0x47, 0x0b, 0xb6, 0x31, 0xfe, 0x41, 0xb7,
+	0x3c, 0xfe, 0xe1, 0x3a, 0xcc, 0xb2, 0x78, 0xcb, 0xa9, 0x0c, 0x11, 0x19, 0x52, 0x3c, 0x32, 0xd0,
+	0x01, 0x66, 0x0c, 0x1e, 0x19, 0xd0, 0xb3, 0x30, 0x17, 0xc0, 0x7a, 0x85, 0x20, 0x2f, 0xc5, 0x65,
+	0x0f, 0x5d, 0xe6, 0x15, 0x21, 0xfa, 0x0e, 0xcc, 0x74, 0xf5, 0x3e, 0x3d, 0xee, 0x86, 0x69, 0x1b,
+	0xc4, 0xc0, 0x8e, 0x48, 0xa2, 0x6e, 0x1d, 0xef, 0x92, 0x57, 0x77, 0xf4, 0x3e, 0xae, 0x79, 0x42,
+	0xd5, 0x3e, 0xb1, 0x47, 0x6a, 0xb1, 0x1b, 0xea, 0xa4, 0xbc, 0x49, 0x0b, 0xb7, 0xf5, 0x41, 0x97,
+	0x68, 0x74, 0x84, 0xf9, 0xdb, 0xac, 0x9a, 0x13, 0x7d, 0x54, 0xc3, 0x62, 0x19, 0xe6, 0x22, 0x34,
+	0xd1, 0xdc, 0xe3, 0x21, 0x1e, 0x09, 0xfb, 0xd1, 0x9f, 0x68, 0x5e, 0x6c, 0xb5, 0xa8, 0x42, 0x79,
+	0xe3, 0xe5, 0xc4, 0x8b, 0x92, 0xf2, 0x27, 0x09, 0x66, 0x27, 0x3c, 0x7e, 0x24, 0x4d, 0x22, 0x7d,
+	0x55, 0x34, 0x49, 0xe2, 0xf4, 0x34, 0x49, 0xb0, 0x3a, 0x4f, 0x86, 0xab, 0xf3, 0x7f, 0x48, 0x50,
+	0x08, 0x45, 0x1e, 0x7a, 0x8e, 0x9a, 0x66, 0x0b, 0x8b, 0x7a, 0x99, 0xfd, 0xa6, 0xa6, 0xe9, 0x9a,
+	0x87, 0xa2, 0x2a, 0xa6, 0x3f, 0x29, 0xca, 0x8b, 0xa5, 0x59, 0x11, 0x29, 0xbd, 0x52, 0x9b, 0xa7,
+	0x3e, 0xbc, 0xe1, 0x9a, 0x35, 0xcd, 0xe6, 0x0d, 0x9b, 0x95, 0xa7, 0x30, 0xbc, 0x81, 0x5e, 0x82,
+	0x2c, 0x7b, 0x14, 0xd1, 0x4c, 0xcb, 0x29, 0x65, 0xc6, 0xd3, 0x3b, 0xfe, 0x72, 0x22, 0x5c, 0x95,
+	0xd9, 0xde, 0xb3, 0x1c, 0x35, 0x63, 0x89, 0x5f, 0x81, 0xa4, 0x2b, 0x1b, 0x4a, 0xba, 0x2e, 0x42,
+	0x96, 0x2e, 0xdf, 0xb1, 0xf4, 0x26, 0x2e, 0x01, 0x5b, 0xa9, 0xdf, 0xa1, 0xfc, 0x31, 0x09, 0x33,
+	0x63, 0x81, 0x33, 0xf2, 0xe3, 0xdd, 0x8b, 0x95, 0x08, 0xb0, 0x40, 0x8f, 0x66, 0x90, 0x25, 0x80,
+	0x43, 0xdd, 0xd1, 0xde, 0xd3, 0xfb, 0x04, 0xb7, 0x84, 0x55, 0x02, 0x3d, 0x68, 0x11, 0x32, 0xb4,
+	0x35, 0x70, 0x70, 0x4b, 0x10, 0x52, 0x5e, 0x1b, 0x6d, 0x41, 0x1a, 0x1f, 0xe1, 0x3e, 0x71, 0x4a,
+	0xd3, 0x6c, 0xe3, 0xcf, 0x45, 0x78, 0x58, 0x3a, 0xbe, 0x5e, 0xa2, 0xdb, 0xfd, 0xf7, 0x4f, 0x97,
+	0x65, 0x0e, 0x7f, 0xc6, 0xec, 0x19, 0x04, 0xf7, 0x2c, 0x32, 0x52, 0x85, 0x82, 0xb0, 0x19, 0x32,
+	0x63, 0x66, 0x40, 0xe7, 0x60, 0x9a, 0xdd, 0x46, 0xa3, 0xc5, 0x32, 0x84, 0xac, 0x9a, 0xa6, 0xcd,
+	0xad, 0x16, 0x75, 0x11, 0x64, 0xe8, 0x5e, 0xd2, 0x11, 0x0b, 0xfd, 0x49, 0x15, 0xc8, 0x50, 0xdc,
+	0x9b, 0x11, 0xba, 0x00, 0x59, 0x32, 0xd4, 0x04, 0x63, 0x52, 0x64, 0xb2, 0x19, 0x32, 0xac, 0xb3,
+	0x36, 0x3d, 0x73, 0x64, 0xa8, 0xf5, 0xcd, 0x7e, 0x13, 0xb3, 0x60, 0x9c, 0x52, 0xa7, 0xc9, 0x70,
+	0x97, 0x36, 0x29, 0x6b, 0x67, 0x63, 0xab, 0xab, 0x37, 0x71, 0x8b, 0x12, 0x27, 0xf4, 0x74, 0xc8,
+	0xbc, 0x4a, 0x70, 0xbb, 0x1b, 0xc3, 0x3b, 0x78, 0xc4, 0x78, 0xdb, 0xbc, 0x4b, 0xc2, 0xd0, 0xed,
+	0xe6, 0xf3, 0xaa, 0x85, 0x1e, 0xee, 0x59, 0xa6, 0xd9, 0xd5, 0xb8, 0x13, 0x2d, 0x43, 0x31, 0x9c,
+	0xc1, 0x50, 0x06, 0xd6, 0xc6, 0x84, 0x52, 0x99, 0xa1, 0x22, 0x25, 0xcf, 0x3b, 0xb9, 0xd3, 0xda,
+	0x4e, 0x65, 0x24, 0x39, 0x21, 0x78, 0xb3, 0x37, 0x61, 0x21, 0x32, 0x81, 0x41, 0x2f, 0x42, 0xd6,
+	0x4f, 0x7e, 0xa4, 0x95, 0xe4, 0x09, 0x84, 0x98, 0x0f, 0x56, 0xf6, 0x61, 0x21, 0x32, 0x83, 0x41,
+	0xaf, 0x42, 0xda, 0xc6, 0xce, 0xa0, 0xcb, 0x39, 0xaf, 0xe2, 0xad, 0x2b, 0x27, 0xa7, 0x3e, 0x83,
+	0x2e, 0x51, 0x85, 0x90, 0x72, 0x13, 0xce, 0xc7, 0xa6, 0x30, 0x3e, 0xad, 0x25, 0x05, 0x68, 0x2d,
+	0xe5, 0xf7, 0x12, 0x2c, 0xc6, 0xa7, 0x25, 0x68, 0x7d, 0x6c, 0x41, 0xd7, 0x1f, 0x31, 0xa9, 0x09,
+	0xac, 0x8a, 0xd6, 0x7d, 0x36, 0x6e, 0x63, 0xd2, 0xec, 0xf0, 0xfc, 0x88, 0xbb, 0xab, 0x82, 0x5a,
+	0x10, 0xbd, 0x4c, 0xc6, 0xe1, 0xb0, 0x77, 0x70, 0x93, 0x88, 0x53, 0xe3, 0xb0, 0xda, 0x2b, 0xab,
+	0x16, 0x78, 0x2f, 0x3f, 0x3a, 0x8e, 0x72, 0x03, 0xce, 0xc5, 0x24, 0x3a, 0x93, 0x05, 0xa2, 0xf2,
+	0x80, 0x82, 0x23, 0xb3, 0x17, 0xf4, 0x3a, 0xa4, 0x1d, 0xa2, 0x93, 0x81, 0x23, 0xbe, 0xec, 0xea,
+	0x89, 0x89, 0x4f, 0x9d, 0xc1, 0x55, 0x21, 0xa6, 0xbc, 0x02, 0x68, 0x32, 0x8d, 0x89, 0x28, 0x72,
+	0xa5, 0xa8, 0x22, 0xf7, 0x00, 0x2e, 0x1c, 0x93, 0xb0, 0xa0, 0xca, 0xd8, 0xe2, 0x6e, 0x3c, 0x52,
+	0xbe, 0x33, 0xb6, 0xc0, 0x7f, 0x25, 0x61, 0x21, 0x32, 0x6f, 0x09, 0xf8, 0x0f, 0xe9, 0xcb, 0xfa,
+	0x8f, 0x57, 0x01, 0xc8, 0x50, 0xe3, 0x3b, 0xed, 0xc6, 0xa1, 0xa8, 0x62, 0x6d, 0x88, 0x9b, 0x8d,
+	0xa1, 0x38, 0x18, 0x59, 0x22, 0x7e, 0x51, 0x16, 0x26, 0x40, 0x2c, 0x0c, 0x58, 0x8c, 0x72, 0x4a,
+	0xc9, 0xc7, 0x8b, 0x66, 0xf2, 0x51, 0xb8, 0xdb, 0x41, 0x0f, 0xe0, 0xdc, 0x58, 0xac, 0xf5, 0x74,
+	0xa7, 0x1e, 0x39, 0xe4, 0x2e, 0x84, 0x43, 0xae, 0xab, 0x3b, 0x18, 0x2f, 0xa7, 0x42, 0xf1, 0x92,
+	0x86, 0x78, 0x56, 0x8d, 0xf3, 0x54, 0xa7, 0x85, 0xbb, 0xba, 0xfb, 0x52, 0x7c, 0x7e, 0xa2, 0xa6,
+	0xbf, 0x2d, 0x1e, 0xd3, 0x79, 0x49, 0xff, 0x73, 0x5a, 0xd2, 0x17, 0xa9, 0x30, 0xdb, 0xa8, 0xdb,
+	0x54, 0x14, 0x7d, 0x0b, 0xf2, 0xd4, 0xdb, 0x3a, 0x62, 0xed, 0xa2, 0x3a, 0x8c, 0xa0, 0x22, 0x68,
+	0xc2, 0xe2, 0xf0, 0xf5, 0xa9, 0xb9, 0xae, 0xdf, 0x50, 0x3e, 0x96, 0x20, 0x17, 0x18, 0x44, 0x0f,
+	0x26, 0x93, 0x2b, 0x7e, 0x00, 0x6e, 0x1e, 0xab, 0xf4, 0x54, 0xb9, 0x55, 0xe2, 0x6b, 0xc9, 0xad,
+	0x1e, 0x00, 0xf8, 0x24, 0x0e, 0xc5, 0xd9, 0xe6, 0xa0, 0xdf, 0x62, 0xb2, 0x53, 0x2a, 0x6f, 0xd0,
+	0x57, 0x7a, 0x7a, 0xd9, 0xdc, 0xd3, 0x18, 0xe1, 0x93, 0xe9, 0xad, 0x09, 0xb0, 0x40, 0x1c, 0xae,
+	0xbc, 0x03, 0x68, 0x92, 0x4f, 0x8f, 0x99, 0xe3, 0xb5, 0xf0, 0x1c, 0x4a, 0x3c, 0x35, 0x1f, 0x3d,
+	0xd7, 0x0f, 0x61, 0x8a, 0xdd, 0x30, 0x9a, 0x1a, 0xb0, 0xe7, 0x1c, 0x91, 0x99, 0xd3, 0xdf, 0xe8,
+	0xfb, 0x00, 0x3a, 0x21, 0xb6, 0x71, 0x30, 0xf0, 0x67, 0x58, 0x89, 0xb9, 0xa2, 0x65, 0x17, 0xb8,
+	0x7e, 0x51, 0xdc, 0xd5, 0x79, 0x5f, 0x36, 0x70, 0x5f, 0x03, 0x1a, 0x95, 0x5d, 0x28, 0x86, 0x65,
+	0x4f, 0xda, 0x82, 0xac, 0x9b, 0x87, 0x79, 0x59, 0x5c, 0x92, 0x3f, 0x5a, 0xb1, 0x86, 0xf2, 0xa3,
+	0x04, 0xe4, 0x83, 0x17, 0xfc, 0xbf, 0x30, 0x53, 0x52, 0x7e, 0x22, 0x41, 0xc6, 0xfb, 0xfe, 0xf0,
+	0xd3, 0x55, 0xe8, 0xcd, 0x8f, 0x9b, 0x2f, 0x11, 0x7c, 0x6f, 0xe2, 0x2f, 0x7c, 0x49, 0xef, 0x85,
+	0xef, 0x9b, 0x5e, 0xcc, 0x8d, 0x25, 0xa3, 0x82, 0xd6, 0x16, 0x07, 0xcb, 0xcd, 0x01, 0x5e, 0x81,
+	0xac, 0xe7, 0x26, 0x69, 0x8d, 0xe7, 0x92, 0x7c, 0x92, 0xf0, 0x55, 0xbc, 0x49, 0x97, 0x62, 0x99,
+	0xef, 0x89, 0xd7, 0xac, 0xa4, 0xca, 0x1b, 0x8a, 0x03, 0x33, 0x63, 0x3e, 0xd6, 0x07, 0x26, 0x02,
+	0x40, 0xa4, 0x40, 0xc1, 0x1a, 0x1c, 0xd0, 0xf4, 0x4c, 0xbc, 0x6d, 0xf1, 0xe5, 0xe7, 0xac, 0xc1,
+	0xc1, 0x1d, 0x3c, 0xe2, 0x8f, 0x5b, 0x2b, 0x90, 0x77, 0x31, 0xec, 0x88, 0xf3, 0x3d, 0x05, 0x0e,
+	0x69, 0xf0, 0x87, 0x49, 0x49, 0x4e, 0x28, 0x3f, 0x93, 0x20, 0xe3, 0xde, 0x12, 0xf4, 0x3a, 0x64,
+	0x3d, 0x77, 0x2e, 0xea, 0xa3, 0x0b, 0xc7, 0x04, 0x02, 0xf1, 0xf1, 0xbe, 0x0c, 0x5a, 0x77, 0x5f,
+	0xd8, 0x8d, 0x96, 0xd6, 0xee, 0xea, 0x87, 0xe2, 0xa1, 0x74, 0x29, 0xc2, 0xe3, 0x33, 0x5f, 0xbb,
+	0x75, 0x7b, 0xa3, 0xab, 0x1f, 0xaa, 0x39, 0x26, 0xb4, 0xd5, 0xa2, 0x0d, 0x91, 0xf8, 0x7d, 0x21,
+	0x81, 0x3c, 0x7e, 0x8b, 0xbf, 0xfc, 0xfa, 0x26, 0x13, 0x84, 0x64, 0x44, 0x82, 0x80, 0xd6, 0x60,
+	0xce, 0x43, 0x68, 0x8e, 0x71, 0xd8, 0xd7, 0xc9, 0xc0, 0xc6, 0x82, 0x4e, 0x46, 0xde, 0x50, 0xdd,
+	0x1d, 0x99, 0xfc, 0xee, 0xa9, 0xd3, 0x7e, 0xf7, 0xfb, 0x09, 0xc8, 0x05, 0xd8, 0x6d, 0xf4, 0x8d,
+	0x80, 0x8b, 0x2a, 0x46, 0x85, 0xe5, 0x00, 0xd8, 0x7f, 0x75, 0x0e, 0x5b, 0x2a, 0x71, 0x0a, 0x4b,
+	0xc5, 0xbd, 0x23, 0xb8, 0x74, 0x79, 0xea, 0xb1, 0xe9, 0xf2, 0x67, 0x00, 0x11, 0x93, 0xe8, 0x5d,
+	0x4a, 0x2a, 0x51, 0x5a, 0x9b, 0x1f, 0x6c, 0xee, 0x51, 0x64, 0x36, 0xb2, 0xcf, 0x06, 0x6a, 0xec,
+	0x32, 0xfc, 0x58, 0x82, 0x8c, 0x47, 0x25, 0x3e, 0xee, 0x6b, 0xf4, 0x59, 0x48, 0x8b, 0x64, 0x97,
+	0x3f, 0x47, 0x8b, 0x56, 0xe4, 0xbb, 0xc0, 0x22, 0x64, 0x7a, 0x98, 0xe8, 0xcc, 0x3d, 0xf2, 0x94,
+	0xc2, 0x6b, 0x5f, 0x3f, 0x80, 0x5c, 0xe0, 0x41, 0x1f, 0x9d, 0x87, 0x85, 0xca, 0x66, 0xb5, 0x72,
+	0x47, 0x6b, 0xbc, 0xa5, 0x35, 0xee, 0xd7, 0xaa, 0xda, 0xbd, 0xdd, 0x3b, 0xbb, 0x7b, 0xdf, 0xde,
+	0x95, 0xcf, 0x4c, 0x0e, 0xa9, 0x55, 0xd6, 0x96, 0x25, 0x74, 0x0e, 0xe6, 0xc2, 0x43, 0x7c, 0x20,
+	0xb1, 0x98, 0xfa, 0xe9, 0x6f, 0x96, 0xce, 0x5c, 0xff, 0x42, 0x82, 0xb9, 0x88, 0xb2, 0x02, 0x5d,
+	0x82, 0x27, 0xf6, 0x36, 0x36, 0xaa, 0xaa, 0x56, 0xdf, 0x2d, 0xd7, 0xea, 0x9b, 0x7b, 0x0d, 0x4d,
+	0xad, 0xd6, 0xef, 0xed, 0x34, 0x02, 0x93, 0xae, 0xc0, 0xc5, 0x68, 0x48, 0xb9, 0x52, 0xa9, 0xd6,
+	0x1a, 0xb2, 0x84, 0x96, 0xe1, 0x42, 0x0c, 0x62, 0x7d, 0x4f, 0x6d, 0xc8, 0x89, 0x78, 0x15, 0x6a,
+	0x75, 0xbb, 0x5a, 0x69, 0xc8, 0x49, 0x74, 0x15, 0x2e, 0x1f, 0x87, 0xd0, 0x36, 0xf6, 0xd4, 0xbb,
+	0xe5, 0x86, 0x9c, 0x3a, 0x11, 0x58, 0xaf, 0xee, 0xde, 0xae, 0xaa, 0xf2, 0x94, 0xf8, 0xee, 0x5f,
+	0x27, 0xa0, 0x14, 0x57, 0xbd, 0x50, 0x5d, 0xe5, 0x5a, 0x6d, 0xe7, 0xbe, 0xaf, 0xab, 0xb2, 0x79,
+	0x6f, 0xf7, 0xce, 0xa4, 0x09, 0x9e, 0x02, 0xe5, 0x38, 0xa0, 0x67, 0x88, 0x2b, 0x70, 0xe9, 0x58,
+	0x9c, 0x30, 0xc7, 0x09, 0x30, 0xb5, 0xda, 0x50, 0xef, 0xcb, 0x49, 0xb4, 0x0a, 0xd7, 0x4f, 0x84,
+	0x79, 0x63, 0x72, 0x0a, 0xad, 0xc1, 0x8d, 0xe3, 0xf1, 0xdc, 0x40, 0xae, 0x80, 0x6b, 0xa2, 0x0f,
+	0x24, 0x58, 0x88, 0x2c, 0x83, 0xd0, 0x65, 0x58, 0xae, 0xa9, 0x7b, 0x95, 0x6a, 0xbd, 0xae, 0xd5,
+	0xd4, 0xbd, 0xda, 0x5e, 0xbd, 0xbc, 0xa3, 0xd5, 0x1b, 0xe5, 0xc6, 0xbd, 0x7a, 0xc0, 0x36, 0x0a,
+	0x2c, 0xc5, 0x81, 0x3c, 0xbb, 0x1c, 0x83, 0x11, 0x27, 0xc0, 0x3d, 0xa7, 0xbf, 0x92, 0xe0, 0x7c,
+	0x6c, 0xd9, 0x83, 0xae, 0xc1, 0x93, 0xfb, 0x55, 0x75, 0x6b, 0xe3, 0xbe, 0xb6, 0xbf, 0xd7, 0xa8,
+	0x6a, 0xd5, 0xb7, 0x1a, 0xd5, 0xdd, 0xfa, 0xd6, 0xde, 0xee, 0xe4, 0xaa, 0xae, 0xc2, 0xe5, 0x63,
+	0x91, 0xde, 0xd2, 0x4e, 0x02, 0x8e, 0xad, 0xef, 0x77, 0x12, 0xcc, 0x8c, 0xf9, 0x42, 0x74, 0x11,
+	0x4a, 0x77, 0xb7, 0xea, 0xeb, 0xd5, 0xcd, 0xf2, 0xfe, 0xd6, 0x9e, 0x3a, 0x7e, 0x67, 0x2f, 0xc3,
+	0xf2, 0xc4, 0xe8, 0xed, 0x7b, 0xb5, 0x9d, 0xad, 0x4a, 0xb9, 0x51, 0x65, 0x93, 0xca, 0x12, 0xfd,
+	0xb0, 0x09, 0xd0, 0xce, 0xd6, 0x1b, 0x9b, 0x0d, 0xad, 0xb2, 0xb3, 0x55, 0xdd, 0x6d, 0x68, 0xe5,
+	0x46, 0xa3, 0x4c, 0xaf, 0x33, 0x5d, 0xef, 0x31, 0xea, 0x5c, 0xeb, 0xca, 0x49, 0xbe, 0xde, 0xf5,
+	0x3b, 0x1f, 0x7d, 0xb6, 0x24, 0x7d, 0xf2, 0xd9, 0x92, 0xf4, 0xb7, 0xcf, 0x96, 0xa4, 0x0f, 0x3f,
+	0x5f, 0x3a, 0xf3, 0xc9, 0xe7, 0x4b, 0x67, 0xfe, 0xf2, 0xf9, 0xd2, 0x99, 0x07, 0x37, 0x0f, 0x0d,
+	0xd2, 0x19, 0x1c, 0x50, 0x77, 0xbd, 0xe6, 0xff, 0x41, 0xd9, 0xfd, 0xa1, 0x5b, 0xc6, 0xda, 0xf8,
+	0xdf, 0x9c, 0x0f, 0xd2, 0xcc, 0xff, 0x3e, 0xf7, 0xef, 0x01, 0x00, 0xa4, 0xd3, 0x7e, 0x81, 0x01,
+	0x2d, 0x00, 0x00,
 }
 
 func (m *Request) Marshal() (dAtA []byte, err error) {
@@ -5954,6 +6070,32 @@ func (m *CheckTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReplacedTxKey) > 0 {
+		i -= len(m.ReplacedTxKey)
+		copy(dAtA[i:], m.ReplacedTxKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ReplacedTxKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.TxNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxNonce))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.TxSender) > 0 {
+		i -= len(m.TxSender)
+		copy(dAtA[i:], m.TxSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TxSender)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.TxPriority != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxPriority))
+		i--
+		dAtA[i] = 0x68
+	}
 	if len(m.LaneId) > 0 {
 		i -= len(m.LaneId)
 		copy(dAtA[i:], m.LaneId)
@@ -6337,12 +6479,24 @@ func (m *FinalizeBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n49, err49 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NextBlockDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NextBlockDelay):])
-	if err49 != nil {
-		return 0, err49
+	if m.LanesUpdate != nil {
+		{
+			size, err := m.LanesUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	n50, err50 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NextBlockDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NextBlockDelay):])
+	if err50 != nil {
+		return 0, err50
 	}
-	i -= n49
-	i = encodeVarintTypes(dAtA, i, uint64(n49))
+	i -= n50
+	i = encodeVarintTypes(dAtA, i, uint64(n50))
 	i--
 	dAtA[i] = 0x32
 	if len(m.AppHash) > 0 {
@@ -6409,6 +6563,53 @@ func (m *FinalizeBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LanesUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LanesUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LanesUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DefaultLane) > 0 {
+		i -= len(m.DefaultLane)
+		copy(dAtA[i:], m.DefaultLane)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DefaultLane)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LanePriorities) > 0 {
+		for k := range m.LanePriorities {
+			v := m.LanePriorities[k]
+			baseI := i
+			i = encodeVarintTypes(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTypes(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTypes(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommitInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6906,12 +7107,12 @@ func (m *Misbehavior) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n54, err54 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err54 != nil {
-		return 0, err54
+	n55, err55 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err55 != nil {
+		return 0, err55
 	}
-	i -= n54
-	i = encodeVarintTypes(dAtA, i, uint64(n54))
+	i -= n55
+	i = encodeVarintTypes(dAtA, i, uint64(n55))
 	i--
 	dAtA[i] = 0x22
 	if m.Height != 0 {
@@ -7975,6 +8176,20 @@ func (m *CheckTxResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.TxPriority != 0 {
+		n += 1 + sovTypes(uint64(m.TxPriority))
+	}
+	l = len(m.TxSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TxNonce != 0 {
+		n += 1 + sovTypes(uint64(m.TxNonce))
+	}
+	l = len(m.ReplacedTxKey)
+	if l > 0 {
+		n += 2 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -8141,6 +8356,31 @@ func (m *FinalizeBlockResponse) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NextBlockDelay)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.LanesUpdate != nil {
+		l = m.LanesUpdate.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *LanesUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LanePriorities) > 0 {
+		for k, v := range m.LanePriorities {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTypes(uint64(len(k))) + 1 + sovTypes(uint64(v))
+			n += mapEntrySize + 1 + sovTypes(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.DefaultLane)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -13578,6 +13818,110 @@ func (m *CheckTxResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.LaneId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+			}
+			m.TxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPriority |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxNonce", wireType)
+			}
+			m.TxNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedTxKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacedTxKey = append(m.ReplacedTxKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReplacedTxKey == nil {
+				m.ReplacedTxKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14620,6 +14964,237 @@ func (m *FinalizeBlockResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LanesUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LanesUpdate == nil {
+				m.LanesUpdate = &LanesUpdate{}
+			}
+			if err := m.LanesUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LanesUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LanesUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LanesUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LanePriorities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LanePriorities == nil {
+				m.LanePriorities = make(map[string]uint32)
+			}
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTypes(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTypes
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.LanePriorities[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultLane", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultLane = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
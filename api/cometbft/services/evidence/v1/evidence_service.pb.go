@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/evidence/v1/evidence_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("cometbft/services/evidence/v1/evidence_service.proto", fileDescriptor_aaba75961d656d22)
+}
+
+var fileDescriptor_aaba75961d656d22 = []byte{
+	// 250 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x49, 0xce, 0xcf, 0x4d,
+	0x2d, 0x49, 0x4a, 0x2b, 0xd1, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x4f, 0x2d,
+	0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x84, 0xb3, 0xe3, 0xa1, 0xb2, 0x7a, 0x05,
+	0x45, 0xf9, 0x25, 0xf9, 0x42, 0xb2, 0x30, 0x5d, 0x7a, 0x30, 0x5d, 0x7a, 0x30, 0x95, 0x7a, 0x65,
+	0x86, 0x52, 0x3a, 0xc4, 0x19, 0x0a, 0x31, 0xcc, 0xe8, 0x14, 0x33, 0x17, 0xbf, 0x2b, 0x54, 0x28,
+	0x18, 0xa2, 0x5e, 0xa8, 0x93, 0x91, 0x4b, 0xc8, 0x3d, 0xb5, 0x24, 0x20, 0x35, 0x2f, 0x25, 0x33,
+	0x2f, 0x1d, 0x26, 0x2b, 0x64, 0xa1, 0x87, 0xd7, 0x62, 0x3d, 0x4c, 0x2d, 0x41, 0xa9, 0x85, 0xa5,
+	0xa9, 0xc5, 0x25, 0x52, 0x96, 0x64, 0xe8, 0x2c, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0xea, 0x67,
+	0xe4, 0x12, 0x71, 0x4f, 0x2d, 0x71, 0xce, 0xcf, 0xcd, 0xcd, 0x2c, 0x29, 0x49, 0x4d, 0x81, 0xbb,
+	0xc6, 0x8a, 0xb0, 0x99, 0x18, 0x9a, 0x60, 0xee, 0xb1, 0x26, 0x4b, 0x2f, 0xd4, 0x45, 0x33, 0x18,
+	0xb9, 0xc4, 0x83, 0x53, 0x13, 0x8b, 0x92, 0x33, 0x30, 0x1d, 0x65, 0x4b, 0xc0, 0x60, 0x1c, 0xfa,
+	0x60, 0xee, 0xb2, 0x23, 0x57, 0x3b, 0xc4, 0x69, 0x4e, 0x11, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
+	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
+	0x78, 0x2c, 0xc7, 0x10, 0x65, 0x97, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0x04, 0x32, 0x5f, 0x1f, 0x9e,
+	0x3e, 0xe0, 0x8c, 0xc4, 0x82, 0x4c, 0x7d, 0xbc, 0xa9, 0x26, 0x89, 0x0d, 0x9c, 0x5a, 0x8c, 0x01,
+	0x03, 0x00, 0xcf, 0xa8, 0x35, 0x1d, 0xb2, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EvidenceServiceClient is the client API for EvidenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EvidenceServiceClient interface {
+	// GetPendingEvidence returns the evidence that has been verified but not
+	// yet committed in a block.
+	GetPendingEvidence(ctx context.Context, in *GetPendingEvidenceRequest, opts ...grpc.CallOption) (*GetPendingEvidenceResponse, error)
+	// GetCommittedEvidence returns whether the evidence with the given hash has
+	// been committed, and if so, at which height.
+	GetCommittedEvidence(ctx context.Context, in *GetCommittedEvidenceRequest, opts ...grpc.CallOption) (*GetCommittedEvidenceResponse, error)
+	// SearchCommittedEvidence returns the committed evidence of misbehavior of
+	// a validator, ordered by the height of the misbehavior.
+	SearchCommittedEvidence(ctx context.Context, in *SearchCommittedEvidenceRequest, opts ...grpc.CallOption) (*SearchCommittedEvidenceResponse, error)
+}
+
+type evidenceServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewEvidenceServiceClient(cc grpc1.ClientConn) EvidenceServiceClient {
+	return &evidenceServiceClient{cc}
+}
+
+func (c *evidenceServiceClient) GetPendingEvidence(ctx context.Context, in *GetPendingEvidenceRequest, opts ...grpc.CallOption) (*GetPendingEvidenceResponse, error) {
+	out := new(GetPendingEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.evidence.v1.EvidenceService/GetPendingEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evidenceServiceClient) GetCommittedEvidence(ctx context.Context, in *GetCommittedEvidenceRequest, opts ...grpc.CallOption) (*GetCommittedEvidenceResponse, error) {
+	out := new(GetCommittedEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.evidence.v1.EvidenceService/GetCommittedEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *evidenceServiceClient) SearchCommittedEvidence(ctx context.Context, in *SearchCommittedEvidenceRequest, opts ...grpc.CallOption) (*SearchCommittedEvidenceResponse, error) {
+	out := new(SearchCommittedEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.evidence.v1.EvidenceService/SearchCommittedEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EvidenceServiceServer is the server API for EvidenceService service.
+type EvidenceServiceServer interface {
+	// GetPendingEvidence returns the evidence that has been verified but not
+	// yet committed in a block.
+	GetPendingEvidence(context.Context, *GetPendingEvidenceRequest) (*GetPendingEvidenceResponse, error)
+	// GetCommittedEvidence returns whether the evidence with the given hash has
+	// been committed, and if so, at which height.
+	GetCommittedEvidence(context.Context, *GetCommittedEvidenceRequest) (*GetCommittedEvidenceResponse, error)
+	// SearchCommittedEvidence returns the committed evidence of misbehavior of
+	// a validator, ordered by the height of the misbehavior.
+	SearchCommittedEvidence(context.Context, *SearchCommittedEvidenceRequest) (*SearchCommittedEvidenceResponse, error)
+}
+
+// UnimplementedEvidenceServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedEvidenceServiceServer struct {
+}
+
+func (*UnimplementedEvidenceServiceServer) GetPendingEvidence(ctx context.Context, req *GetPendingEvidenceRequest) (*GetPendingEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingEvidence not implemented")
+}
+func (*UnimplementedEvidenceServiceServer) GetCommittedEvidence(ctx context.Context, req *GetCommittedEvidenceRequest) (*GetCommittedEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommittedEvidence not implemented")
+}
+func (*UnimplementedEvidenceServiceServer) SearchCommittedEvidence(ctx context.Context, req *SearchCommittedEvidenceRequest) (*SearchCommittedEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCommittedEvidence not implemented")
+}
+
+func RegisterEvidenceServiceServer(s grpc1.Server, srv EvidenceServiceServer) {
+	s.RegisterService(&_EvidenceService_serviceDesc, srv)
+}
+
+func _EvidenceService_GetPendingEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvidenceServiceServer).GetPendingEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.evidence.v1.EvidenceService/GetPendingEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvidenceServiceServer).GetPendingEvidence(ctx, req.(*GetPendingEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvidenceService_GetCommittedEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommittedEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvidenceServiceServer).GetCommittedEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.evidence.v1.EvidenceService/GetCommittedEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvidenceServiceServer).GetCommittedEvidence(ctx, req.(*GetCommittedEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EvidenceService_SearchCommittedEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCommittedEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EvidenceServiceServer).SearchCommittedEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.evidence.v1.EvidenceService/SearchCommittedEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EvidenceServiceServer).SearchCommittedEvidence(ctx, req.(*SearchCommittedEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var EvidenceService_serviceDesc = _EvidenceService_serviceDesc
+var _EvidenceService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.services.evidence.v1.EvidenceService",
+	HandlerType: (*EvidenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPendingEvidence",
+			Handler:    _EvidenceService_GetPendingEvidence_Handler,
+		},
+		{
+			MethodName: "GetCommittedEvidence",
+			Handler:    _EvidenceService_GetCommittedEvidence_Handler,
+		},
+		{
+			MethodName: "SearchCommittedEvidence",
+			Handler:    _EvidenceService_SearchCommittedEvidence_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cometbft/services/evidence/v1/evidence_service.proto",
+}
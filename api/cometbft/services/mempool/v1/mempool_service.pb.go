@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cometbft/services/mempool/v1/mempool_service.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("cometbft/services/mempool/v1/mempool_service.proto", fileDescriptor_f8560b1ab7181466)
+}
+
+var fileDescriptor_f8560b1ab7181466 = []byte{
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xbb, 0x4e, 0xc3, 0x30,
+	0x14, 0x86, 0xeb, 0xa5, 0x48, 0x06, 0x71, 0xf1, 0x58, 0xa1, 0xcc, 0x08, 0x41, 0x2e, 0x85, 0xb2,
+	0xb1, 0x74, 0xe9, 0x52, 0x18, 0x48, 0x11, 0x12, 0x0b, 0x72, 0xd2, 0x53, 0xb0, 0x54, 0xc7, 0xc1,
+	0x76, 0x4a, 0x85, 0x18, 0xe0, 0x0d, 0x78, 0x2c, 0xc6, 0x8e, 0xb0, 0xa1, 0xf6, 0x45, 0x50, 0x89,
+	0x5d, 0x0a, 0x43, 0x9a, 0x2c, 0x6c, 0x96, 0xfb, 0xfd, 0xff, 0x77, 0xdc, 0xe8, 0xe0, 0x66, 0x2c,
+	0x38, 0xe8, 0x68, 0xa0, 0x3d, 0x05, 0x72, 0xc4, 0x62, 0x50, 0x1e, 0x07, 0x9e, 0x0a, 0x31, 0xf4,
+	0x46, 0x81, 0x3d, 0xde, 0x98, 0xdf, 0xdc, 0x54, 0x0a, 0x2d, 0xc8, 0xae, 0xcd, 0xb8, 0x36, 0xe3,
+	0x1a, 0xd0, 0x1d, 0x05, 0x8d, 0xfd, 0x32, 0x8d, 0x79, 0x53, 0xf3, 0xa3, 0x8e, 0x37, 0xcf, 0xf2,
+	0x9b, 0x30, 0x87, 0x89, 0xc4, 0xeb, 0x6d, 0x29, 0x68, 0x3f, 0xa6, 0x4a, 0xf7, 0xc6, 0xc4, 0x77,
+	0x8b, 0x64, 0xee, 0x12, 0x7a, 0x01, 0xf7, 0x19, 0x28, 0xdd, 0x08, 0x2a, 0x24, 0x54, 0x2a, 0x12,
+	0x05, 0xe4, 0x09, 0xef, 0x2c, 0x5d, 0x87, 0x5a, 0x02, 0xe5, 0xff, 0x62, 0xde, 0x43, 0x3e, 0x22,
+	0x02, 0xe3, 0x90, 0xf1, 0x6c, 0x48, 0x35, 0xf4, 0xc6, 0xc4, 0x2b, 0x2e, 0xf9, 0x21, 0xad, 0xd5,
+	0x2f, 0x1f, 0x30, 0xcf, 0x7d, 0x41, 0x78, 0xbb, 0x03, 0xfa, 0x32, 0x89, 0x45, 0x32, 0x60, 0x92,
+	0x43, 0xbf, 0x37, 0x26, 0xad, 0xe2, 0x9a, 0xbf, 0xbc, 0xb5, 0x9f, 0x54, 0x8d, 0x99, 0x19, 0x06,
+	0x78, 0xad, 0xcb, 0xe6, 0x7f, 0x85, 0x22, 0x07, 0xc5, 0x15, 0x06, 0xb3, 0xc2, 0xc3, 0x92, 0xb4,
+	0xf1, 0x64, 0x78, 0xa3, 0x03, 0xba, 0x4b, 0x13, 0x08, 0xd9, 0x23, 0x28, 0x12, 0xac, 0x9c, 0x77,
+	0xc1, 0x5a, 0x63, 0xb3, 0x4a, 0xc4, 0x68, 0x9f, 0x11, 0xde, 0x0a, 0xb3, 0x48, 0xc5, 0x92, 0x45,
+	0x70, 0x0e, 0x0f, 0xf3, 0x77, 0x1e, 0xaf, 0xf8, 0x50, 0xbf, 0x71, 0x6b, 0x6f, 0x55, 0x4c, 0xe5,
+	0x03, 0xf8, 0xa8, 0x7d, 0xf5, 0x36, 0x75, 0xd0, 0x64, 0xea, 0xa0, 0xcf, 0xa9, 0x83, 0x5e, 0x67,
+	0x4e, 0x6d, 0x32, 0x73, 0x6a, 0xef, 0x33, 0xa7, 0x76, 0x7d, 0x7a, 0xcb, 0xf4, 0x5d, 0x16, 0xcd,
+	0x8b, 0xbd, 0xc5, 0xb2, 0x2e, 0x0e, 0x34, 0x65, 0x5e, 0xd1, 0x0a, 0x47, 0xf5, 0xef, 0xdd, 0x3d,
+	0xfa, 0x1a, 0x00, 0x7f, 0x0c, 0x27, 0xd4, 0x3b, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MempoolServiceClient is the client API for MempoolService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MempoolServiceClient interface {
+	// BroadcastTx checks a transaction with CheckTx and, if it is valid, adds
+	// it to the mempool. It returns once the transaction has been checked.
+	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
+	// BroadcastTxStream submits each transaction received on the stream without
+	// waiting for the result of previous ones. The result of checking each
+	// transaction is sent back once available, not necessarily in the order
+	// the transactions were received; callers can match them by hash.
+	BroadcastTxStream(ctx context.Context, opts ...grpc.CallOption) (MempoolService_BroadcastTxStreamClient, error)
+	// SimulateTx executes a transaction against a throwaway copy of the
+	// application's state and returns the result, for instance to estimate the
+	// gas it uses. The transaction is neither added to the mempool nor gossiped.
+	SimulateTx(ctx context.Context, in *SimulateTxRequest, opts ...grpc.CallOption) (*SimulateTxResponse, error)
+	// GetUnconfirmedTx returns a transaction in the mempool by its hash.
+	GetUnconfirmedTx(ctx context.Context, in *GetUnconfirmedTxRequest, opts ...grpc.CallOption) (*GetUnconfirmedTxResponse, error)
+	// ListTxs returns a page of the transactions in a lane, in the order they
+	// are reaped.
+	ListTxs(ctx context.Context, in *ListTxsRequest, opts ...grpc.CallOption) (*ListTxsResponse, error)
+	// GetLaneSizes returns the number of transactions and bytes in each lane.
+	GetLaneSizes(ctx context.Context, in *GetLaneSizesRequest, opts ...grpc.CallOption) (*GetLaneSizesResponse, error)
+	// SubscribeNewTxs returns a stream of the transactions added to the
+	// mempool. The stream is terminated by the server if the caller does not
+	// keep up with the rate of new transactions, or if an error occurs.
+	SubscribeNewTxs(ctx context.Context, in *SubscribeNewTxsRequest, opts ...grpc.CallOption) (MempoolService_SubscribeNewTxsClient, error)
+}
+
+type mempoolServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMempoolServiceClient(cc grpc1.ClientConn) MempoolServiceClient {
+	return &mempoolServiceClient{cc}
+}
+
+func (c *mempoolServiceClient) BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error) {
+	out := new(BroadcastTxResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.mempool.v1.MempoolService/BroadcastTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) BroadcastTxStream(ctx context.Context, opts ...grpc.CallOption) (MempoolService_BroadcastTxStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MempoolService_serviceDesc.Streams[0], "/cometbft.services.mempool.v1.MempoolService/BroadcastTxStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mempoolServiceBroadcastTxStreamClient{stream}
+	return x, nil
+}
+
+type MempoolService_BroadcastTxStreamClient interface {
+	Send(*BroadcastTxRequest) error
+	Recv() (*BroadcastTxResponse, error)
+	grpc.ClientStream
+}
+
+type mempoolServiceBroadcastTxStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *mempoolServiceBroadcastTxStreamClient) Send(m *BroadcastTxRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *mempoolServiceBroadcastTxStreamClient) Recv() (*BroadcastTxResponse, error) {
+	m := new(BroadcastTxResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mempoolServiceClient) SimulateTx(ctx context.Context, in *SimulateTxRequest, opts ...grpc.CallOption) (*SimulateTxResponse, error) {
+	out := new(SimulateTxResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.mempool.v1.MempoolService/SimulateTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) GetUnconfirmedTx(ctx context.Context, in *GetUnconfirmedTxRequest, opts ...grpc.CallOption) (*GetUnconfirmedTxResponse, error) {
+	out := new(GetUnconfirmedTxResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.mempool.v1.MempoolService/GetUnconfirmedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) ListTxs(ctx context.Context, in *ListTxsRequest, opts ...grpc.CallOption) (*ListTxsResponse, error) {
+	out := new(ListTxsResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.mempool.v1.MempoolService/ListTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) GetLaneSizes(ctx context.Context, in *GetLaneSizesRequest, opts ...grpc.CallOption) (*GetLaneSizesResponse, error) {
+	out := new(GetLaneSizesResponse)
+	err := c.cc.Invoke(ctx, "/cometbft.services.mempool.v1.MempoolService/GetLaneSizes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mempoolServiceClient) SubscribeNewTxs(ctx context.Context, in *SubscribeNewTxsRequest, opts ...grpc.CallOption) (MempoolService_SubscribeNewTxsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_MempoolService_serviceDesc.Streams[1], "/cometbft.services.mempool.v1.MempoolService/SubscribeNewTxs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mempoolServiceSubscribeNewTxsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MempoolService_SubscribeNewTxsClient interface {
+	Recv() (*SubscribeNewTxsResponse, error)
+	grpc.ClientStream
+}
+
+type mempoolServiceSubscribeNewTxsClient struct {
+	grpc.ClientStream
+}
+
+func (x *mempoolServiceSubscribeNewTxsClient) Recv() (*SubscribeNewTxsResponse, error) {
+	m := new(SubscribeNewTxsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MempoolServiceServer is the server API for MempoolService service.
+type MempoolServiceServer interface {
+	// BroadcastTx checks a transaction with CheckTx and, if it is valid, adds
+	// it to the mempool. It returns once the transaction has been checked.
+	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
+	// BroadcastTxStream submits each transaction received on the stream without
+	// waiting for the result of previous ones. The result of checking each
+	// transaction is sent back once available, not necessarily in the order
+	// the transactions were received; callers can match them by hash.
+	BroadcastTxStream(MempoolService_BroadcastTxStreamServer) error
+	// SimulateTx executes a transaction against a throwaway copy of the
+	// application's state and returns the result, for instance to estimate the
+	// gas it uses. The transaction is neither added to the mempool nor gossiped.
+	SimulateTx(context.Context, *SimulateTxRequest) (*SimulateTxResponse, error)
+	// GetUnconfirmedTx returns a transaction in the mempool by its hash.
+	GetUnconfirmedTx(context.Context, *GetUnconfirmedTxRequest) (*GetUnconfirmedTxResponse, error)
+	// ListTxs returns a page of the transactions in a lane, in the order they
+	// are reaped.
+	ListTxs(context.Context, *ListTxsRequest) (*ListTxsResponse, error)
+	// GetLaneSizes returns the number of transactions and bytes in each lane.
+	GetLaneSizes(context.Context, *GetLaneSizesRequest) (*GetLaneSizesResponse, error)
+	// SubscribeNewTxs returns a stream of the transactions added to the
+	// mempool. The stream is terminated by the server if the caller does not
+	// keep up with the rate of new transactions, or if an error occurs.
+	SubscribeNewTxs(*SubscribeNewTxsRequest, MempoolService_SubscribeNewTxsServer) error
+}
+
+// UnimplementedMempoolServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMempoolServiceServer struct {
+}
+
+func (*UnimplementedMempoolServiceServer) BroadcastTx(ctx context.Context, req *BroadcastTxRequest) (*BroadcastTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastTx not implemented")
+}
+func (*UnimplementedMempoolServiceServer) BroadcastTxStream(srv MempoolService_BroadcastTxStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BroadcastTxStream not implemented")
+}
+func (*UnimplementedMempoolServiceServer) SimulateTx(ctx context.Context, req *SimulateTxRequest) (*SimulateTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTx not implemented")
+}
+func (*UnimplementedMempoolServiceServer) GetUnconfirmedTx(ctx context.Context, req *GetUnconfirmedTxRequest) (*GetUnconfirmedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnconfirmedTx not implemented")
+}
+func (*UnimplementedMempoolServiceServer) ListTxs(ctx context.Context, req *ListTxsRequest) (*ListTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTxs not implemented")
+}
+func (*UnimplementedMempoolServiceServer) GetLaneSizes(ctx context.Context, req *GetLaneSizesRequest) (*GetLaneSizesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLaneSizes not implemented")
+}
+func (*UnimplementedMempoolServiceServer) SubscribeNewTxs(req *SubscribeNewTxsRequest, srv MempoolService_SubscribeNewTxsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeNewTxs not implemented")
+}
+
+func RegisterMempoolServiceServer(s grpc1.Server, srv MempoolServiceServer) {
+	s.RegisterService(&_MempoolService_serviceDesc, srv)
+}
+
+func _MempoolService_BroadcastTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).BroadcastTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.mempool.v1.MempoolService/BroadcastTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).BroadcastTx(ctx, req.(*BroadcastTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_BroadcastTxStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MempoolServiceServer).BroadcastTxStream(&mempoolServiceBroadcastTxStreamServer{stream})
+}
+
+type MempoolService_BroadcastTxStreamServer interface {
+	Send(*BroadcastTxResponse) error
+	Recv() (*BroadcastTxRequest, error)
+	grpc.ServerStream
+}
+
+type mempoolServiceBroadcastTxStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *mempoolServiceBroadcastTxStreamServer) Send(m *BroadcastTxResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *mempoolServiceBroadcastTxStreamServer) Recv() (*BroadcastTxRequest, error) {
+	m := new(BroadcastTxRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MempoolService_SimulateTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).SimulateTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.mempool.v1.MempoolService/SimulateTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).SimulateTx(ctx, req.(*SimulateTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_GetUnconfirmedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnconfirmedTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).GetUnconfirmedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.mempool.v1.MempoolService/GetUnconfirmedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).GetUnconfirmedTx(ctx, req.(*GetUnconfirmedTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_ListTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).ListTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.mempool.v1.MempoolService/ListTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).ListTxs(ctx, req.(*ListTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_GetLaneSizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLaneSizesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MempoolServiceServer).GetLaneSizes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cometbft.services.mempool.v1.MempoolService/GetLaneSizes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MempoolServiceServer).GetLaneSizes(ctx, req.(*GetLaneSizesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MempoolService_SubscribeNewTxs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeNewTxsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MempoolServiceServer).SubscribeNewTxs(m, &mempoolServiceSubscribeNewTxsServer{stream})
+}
+
+type MempoolService_SubscribeNewTxsServer interface {
+	Send(*SubscribeNewTxsResponse) error
+	grpc.ServerStream
+}
+
+type mempoolServiceSubscribeNewTxsServer struct {
+	grpc.ServerStream
+}
+
+func (x *mempoolServiceSubscribeNewTxsServer) Send(m *SubscribeNewTxsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var MempoolService_serviceDesc = _MempoolService_serviceDesc
+var _MempoolService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cometbft.services.mempool.v1.MempoolService",
+	HandlerType: (*MempoolServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BroadcastTx",
+			Handler:    _MempoolService_BroadcastTx_Handler,
+		},
+		{
+			MethodName: "SimulateTx",
+			Handler:    _MempoolService_SimulateTx_Handler,
+		},
+		{
+			MethodName: "GetUnconfirmedTx",
+			Handler:    _MempoolService_GetUnconfirmedTx_Handler,
+		},
+		{
+			MethodName: "ListTxs",
+			Handler:    _MempoolService_ListTxs_Handler,
+		},
+		{
+			MethodName: "GetLaneSizes",
+			Handler:    _MempoolService_GetLaneSizes_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BroadcastTxStream",
+			Handler:       _MempoolService_BroadcastTxStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeNewTxs",
+			Handler:       _MempoolService_SubscribeNewTxs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cometbft/services/mempool/v1/mempool_service.proto",
+}
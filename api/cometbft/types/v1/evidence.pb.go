@@ -34,6 +34,7 @@ type Evidence struct {
 	// Types that are valid to be assigned to Sum:
 	//	*Evidence_DuplicateVoteEvidence
 	//	*Evidence_LightClientAttackEvidence
+	//	*Evidence_DuplicateProposalEvidence
 	Sum isEvidence_Sum `protobuf_oneof:"sum"`
 }
 
@@ -82,9 +83,13 @@ type Evidence_DuplicateVoteEvidence struct {
 type Evidence_LightClientAttackEvidence struct {
 	LightClientAttackEvidence *LightClientAttackEvidence `protobuf:"bytes,2,opt,name=light_client_attack_evidence,json=lightClientAttackEvidence,proto3,oneof" json:"light_client_attack_evidence,omitempty"`
 }
+type Evidence_DuplicateProposalEvidence struct {
+	DuplicateProposalEvidence *DuplicateProposalEvidence `protobuf:"bytes,3,opt,name=duplicate_proposal_evidence,json=duplicateProposalEvidence,proto3,oneof" json:"duplicate_proposal_evidence,omitempty"`
+}
 
 func (*Evidence_DuplicateVoteEvidence) isEvidence_Sum()     {}
 func (*Evidence_LightClientAttackEvidence) isEvidence_Sum() {}
+func (*Evidence_DuplicateProposalEvidence) isEvidence_Sum() {}
 
 func (m *Evidence) GetSum() isEvidence_Sum {
 	if m != nil {
@@ -107,11 +112,19 @@ func (m *Evidence) GetLightClientAttackEvidence() *LightClientAttackEvidence {
 	return nil
 }
 
+func (m *Evidence) GetDuplicateProposalEvidence() *DuplicateProposalEvidence {
+	if x, ok := m.GetSum().(*Evidence_DuplicateProposalEvidence); ok {
+		return x.DuplicateProposalEvidence
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Evidence) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Evidence_DuplicateVoteEvidence)(nil),
 		(*Evidence_LightClientAttackEvidence)(nil),
+		(*Evidence_DuplicateProposalEvidence)(nil),
 	}
 }
 
@@ -269,6 +282,91 @@ func (m *LightClientAttackEvidence) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+// DuplicateProposalEvidence contains evidence of a proposer signing two conflicting proposals.
+type DuplicateProposalEvidence struct {
+	ProposalA        *Proposal `protobuf:"bytes,1,opt,name=proposal_a,json=proposalA,proto3" json:"proposal_a,omitempty"`
+	ProposalB        *Proposal `protobuf:"bytes,2,opt,name=proposal_b,json=proposalB,proto3" json:"proposal_b,omitempty"`
+	ValidatorAddress []byte    `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	TotalVotingPower int64     `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	ValidatorPower   int64     `protobuf:"varint,5,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+	Timestamp        time.Time `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *DuplicateProposalEvidence) Reset()         { *m = DuplicateProposalEvidence{} }
+func (m *DuplicateProposalEvidence) String() string { return proto.CompactTextString(m) }
+func (*DuplicateProposalEvidence) ProtoMessage()    {}
+func (*DuplicateProposalEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c96acf1a4e66b9a, []int{3}
+}
+func (m *DuplicateProposalEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicateProposalEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicateProposalEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DuplicateProposalEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateProposalEvidence.Merge(m, src)
+}
+func (m *DuplicateProposalEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicateProposalEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateProposalEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateProposalEvidence proto.InternalMessageInfo
+
+func (m *DuplicateProposalEvidence) GetProposalA() *Proposal {
+	if m != nil {
+		return m.ProposalA
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetProposalB() *Proposal {
+	if m != nil {
+		return m.ProposalB
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *DuplicateProposalEvidence) GetTotalVotingPower() int64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *DuplicateProposalEvidence) GetValidatorPower() int64 {
+	if m != nil {
+		return m.ValidatorPower
+	}
+	return 0
+}
+
+func (m *DuplicateProposalEvidence) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
 // EvidenceList is a list of evidence.
 type EvidenceList struct {
 	Evidence []Evidence `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence"`
@@ -278,7 +376,7 @@ func (m *EvidenceList) Reset()         { *m = EvidenceList{} }
 func (m *EvidenceList) String() string { return proto.CompactTextString(m) }
 func (*EvidenceList) ProtoMessage()    {}
 func (*EvidenceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c96acf1a4e66b9a, []int{3}
+	return fileDescriptor_4c96acf1a4e66b9a, []int{4}
 }
 func (m *EvidenceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,47 +416,53 @@ func init() {
 	proto.RegisterType((*Evidence)(nil), "cometbft.types.v1.Evidence")
 	proto.RegisterType((*DuplicateVoteEvidence)(nil), "cometbft.types.v1.DuplicateVoteEvidence")
 	proto.RegisterType((*LightClientAttackEvidence)(nil), "cometbft.types.v1.LightClientAttackEvidence")
+	proto.RegisterType((*DuplicateProposalEvidence)(nil), "cometbft.types.v1.DuplicateProposalEvidence")
 	proto.RegisterType((*EvidenceList)(nil), "cometbft.types.v1.EvidenceList")
 }
 
 func init() { proto.RegisterFile("cometbft/types/v1/evidence.proto", fileDescriptor_4c96acf1a4e66b9a) }
 
 var fileDescriptor_4c96acf1a4e66b9a = []byte{
-	// 533 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xe3, 0x38, 0xa9, 0xc2, 0xb6, 0x40, 0xbb, 0xb4, 0x6a, 0x1a, 0x5a, 0x27, 0x84, 0x03,
-	0x39, 0x54, 0xb6, 0x1a, 0xce, 0x1c, 0x6a, 0x40, 0xaa, 0x50, 0x10, 0xc8, 0x42, 0x3d, 0x70, 0xb1,
-	0xd6, 0xce, 0xc6, 0x59, 0xd5, 0xf6, 0x5a, 0xf1, 0x24, 0xa8, 0x3c, 0x45, 0xc5, 0x53, 0xf5, 0x46,
-	0x8f, 0x9c, 0x00, 0x25, 0x12, 0xcf, 0x81, 0x76, 0x6d, 0x6f, 0x23, 0xc5, 0xe6, 0xc4, 0x6d, 0x33,
-	0xf3, 0xfd, 0x3b, 0x33, 0xff, 0xc4, 0x8b, 0x7a, 0x3e, 0x8f, 0x28, 0x78, 0x13, 0xb0, 0xe0, 0x3a,
-	0xa1, 0xa9, 0xb5, 0x38, 0xb3, 0xe8, 0x82, 0x8d, 0x69, 0xec, 0x53, 0x33, 0x99, 0x71, 0xe0, 0x78,
-	0xaf, 0x20, 0x4c, 0x49, 0x98, 0x8b, 0xb3, 0xce, 0xc9, 0xa6, 0x28, 0xcb, 0x49, 0x45, 0xe7, 0xd9,
-	0x66, 0x7a, 0x41, 0x42, 0x36, 0x26, 0xc0, 0x67, 0x39, 0xb2, 0x1f, 0xf0, 0x80, 0xcb, 0xa3, 0x25,
-	0x4e, 0x79, 0xb4, 0x1b, 0x70, 0x1e, 0x84, 0xd4, 0x92, 0xbf, 0xbc, 0xf9, 0xc4, 0x02, 0x16, 0xd1,
-	0x14, 0x48, 0x94, 0x64, 0x40, 0xff, 0x8f, 0x86, 0x5a, 0x6f, 0xf3, 0xf6, 0xb0, 0x87, 0x0e, 0xc7,
-	0xf3, 0x24, 0x64, 0x3e, 0x01, 0xea, 0x2e, 0x38, 0x50, 0xb7, 0xe8, 0xbc, 0xad, 0xf5, 0xb4, 0xc1,
-	0xf6, 0x70, 0x60, 0x6e, 0xb4, 0x6e, 0xbe, 0x29, 0x14, 0x97, 0x1c, 0x68, 0x71, 0xd5, 0x45, 0xcd,
-	0x39, 0x18, 0x97, 0x25, 0x30, 0x47, 0xc7, 0x21, 0x0b, 0xa6, 0xe0, 0xfa, 0x21, 0xa3, 0x31, 0xb8,
-	0x04, 0x80, 0xf8, 0x57, 0xf7, 0x85, 0xea, 0xb2, 0xd0, 0x69, 0x49, 0xa1, 0x91, 0x90, 0xbd, 0x96,
-	0xaa, 0x73, 0x29, 0x5a, 0x2b, 0x76, 0x14, 0x56, 0x25, 0xed, 0x26, 0xd2, 0xd3, 0x79, 0xd4, 0xff,
-	0x56, 0x47, 0x07, 0xa5, 0xad, 0x62, 0x13, 0x6d, 0xc9, 0x59, 0x49, 0x3e, 0xe4, 0x61, 0x49, 0x6d,
-	0x21, 0x70, 0x9a, 0x02, 0x3b, 0x57, 0xbc, 0x97, 0xf7, 0xfa, 0x6f, 0xde, 0xc6, 0xa7, 0x08, 0x03,
-	0x07, 0x12, 0x0a, 0x47, 0x59, 0x1c, 0xb8, 0x09, 0xff, 0x42, 0x67, 0x6d, 0xbd, 0xa7, 0x0d, 0x74,
-	0x67, 0x57, 0x66, 0x2e, 0x65, 0xe2, 0xa3, 0x88, 0xe3, 0x17, 0xe8, 0xb1, 0x5a, 0x6d, 0x8e, 0x36,
-	0x24, 0xfa, 0x48, 0x85, 0x33, 0xd0, 0x46, 0x0f, 0xd4, 0x32, 0xdb, 0x4d, 0xd9, 0x49, 0xc7, 0xcc,
-	0xd6, 0x6d, 0x16, 0xeb, 0x36, 0x3f, 0x15, 0x84, 0xdd, 0xba, 0xfd, 0xd9, 0xad, 0xdd, 0xfc, 0xea,
-	0x6a, 0xce, 0xbd, 0xac, 0xff, 0xbd, 0x8e, 0x8e, 0x2a, 0x6d, 0xc5, 0xef, 0xd0, 0x9e, 0xcf, 0xe3,
-	0x49, 0xc8, 0x7c, 0xd9, 0xb7, 0x17, 0x72, 0xff, 0x2a, 0xf7, 0xe8, 0xa4, 0x6a, 0x3f, 0xb6, 0x80,
-	0x9c, 0xdd, 0x35, 0x9d, 0x8c, 0xe0, 0xe7, 0xe8, 0xa1, 0xcf, 0xa3, 0x88, 0xc7, 0xee, 0x94, 0x0a,
-	0x4e, 0x7a, 0xa7, 0x3b, 0x3b, 0x59, 0xf0, 0x42, 0xc6, 0xf0, 0x07, 0xb4, 0xef, 0x5d, 0x7f, 0x25,
-	0x31, 0xb0, 0x98, 0xba, 0x6a, 0xdc, 0xb4, 0xad, 0xf7, 0xf4, 0xc1, 0xf6, 0xf0, 0xb8, 0xcc, 0xe7,
-	0x02, 0x72, 0x9e, 0x28, 0xa5, 0x8a, 0xa5, 0x15, 0xd6, 0x37, 0x2a, 0xac, 0xff, 0x1f, 0x8e, 0xbe,
-	0x47, 0x3b, 0x85, 0x7f, 0x23, 0x96, 0x02, 0x7e, 0x85, 0x5a, 0x6b, 0xdf, 0x90, 0x18, 0xe3, 0x69,
-	0xc9, 0x18, 0xea, 0xcf, 0xda, 0x10, 0x77, 0x3a, 0x4a, 0x62, 0x8f, 0x6e, 0x97, 0x86, 0x76, 0xb7,
-	0x34, 0xb4, 0xdf, 0x4b, 0x43, 0xbb, 0x59, 0x19, 0xb5, 0xbb, 0x95, 0x51, 0xfb, 0xb1, 0x32, 0x6a,
-	0x9f, 0x87, 0x01, 0x83, 0xe9, 0xdc, 0x13, 0x97, 0x59, 0xea, 0x75, 0x50, 0x07, 0x92, 0x30, 0x6b,
-	0xe3, 0xcd, 0xf0, 0xb6, 0xe4, 0x14, 0x2f, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x50, 0xcc, 0xfa,
-	0x44, 0xa3, 0x04, 0x00, 0x00,
+	// 618 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xed, 0xa6, 0x6a, 0xa7, 0xfd, 0x3e, 0xda, 0xa1, 0x55, 0xd3, 0x3f, 0x27, 0x84, 0x05,
+	0x91, 0xa8, 0x6c, 0x35, 0xec, 0x90, 0x58, 0xc4, 0x80, 0x54, 0xa1, 0x20, 0x2a, 0x0b, 0x75, 0xc1,
+	0xc6, 0x1a, 0xdb, 0x13, 0x67, 0x54, 0xdb, 0x63, 0xc5, 0x93, 0xa0, 0xf2, 0x14, 0x15, 0x4f, 0xd5,
+	0x1d, 0x5d, 0x21, 0x24, 0x24, 0x40, 0xc9, 0x8b, 0x20, 0x8f, 0x3d, 0x93, 0xa0, 0xd8, 0xa2, 0x20,
+	0x76, 0x93, 0x7b, 0xcf, 0x99, 0x73, 0xef, 0xf1, 0xd1, 0x04, 0xb4, 0x3c, 0x1a, 0x61, 0xe6, 0x0e,
+	0x98, 0xc9, 0xae, 0x12, 0x9c, 0x9a, 0x93, 0x53, 0x13, 0x4f, 0x88, 0x8f, 0x63, 0x0f, 0x1b, 0xc9,
+	0x88, 0x32, 0x0a, 0xb7, 0x05, 0xc2, 0xe0, 0x08, 0x63, 0x72, 0x7a, 0x70, 0xbc, 0x4c, 0xca, 0x7b,
+	0x9c, 0x71, 0xf0, 0x60, 0xb9, 0x3d, 0x41, 0x21, 0xf1, 0x11, 0xa3, 0xa3, 0x02, 0xb2, 0x13, 0xd0,
+	0x80, 0xf2, 0xa3, 0x99, 0x9d, 0x8a, 0x6a, 0x33, 0xa0, 0x34, 0x08, 0xb1, 0xc9, 0x7f, 0xb9, 0xe3,
+	0x81, 0xc9, 0x48, 0x84, 0x53, 0x86, 0xa2, 0x24, 0x07, 0xb4, 0x3f, 0xab, 0x60, 0xed, 0x65, 0x31,
+	0x1e, 0x74, 0xc1, 0x9e, 0x3f, 0x4e, 0x42, 0xe2, 0x21, 0x86, 0x9d, 0x09, 0x65, 0xd8, 0x11, 0x93,
+	0x37, 0x94, 0x96, 0xd2, 0xd9, 0xe8, 0x76, 0x8c, 0xa5, 0xd1, 0x8d, 0x17, 0x82, 0x71, 0x41, 0x19,
+	0x16, 0x57, 0x9d, 0xd5, 0xec, 0x5d, 0xbf, 0xac, 0x01, 0x29, 0x38, 0x0a, 0x49, 0x30, 0x64, 0x8e,
+	0x17, 0x12, 0x1c, 0x33, 0x07, 0x31, 0x86, 0xbc, 0xcb, 0xb9, 0x90, 0xca, 0x85, 0x4e, 0x4a, 0x84,
+	0xfa, 0x19, 0xed, 0x39, 0x67, 0xf5, 0x38, 0x69, 0x41, 0x6c, 0x3f, 0xac, 0x6a, 0xc2, 0x18, 0x1c,
+	0xce, 0x97, 0x4a, 0x46, 0x34, 0xa1, 0x29, 0x0a, 0xe7, 0x7a, 0x5a, 0xa5, 0x9e, 0x5c, 0xec, 0xbc,
+	0x20, 0x2d, 0xea, 0xf9, 0x55, 0x4d, 0xab, 0x0e, 0xb4, 0x74, 0x1c, 0xb5, 0x3f, 0xaa, 0x60, 0xb7,
+	0xd4, 0x1a, 0x68, 0x80, 0x55, 0xee, 0x2d, 0x2a, 0x4c, 0xdd, 0x2b, 0xd1, 0xce, 0x08, 0x76, 0x3d,
+	0x83, 0xf5, 0x24, 0xde, 0x6d, 0xa8, 0x77, 0xc0, 0x5b, 0xf0, 0x04, 0x40, 0x46, 0x19, 0x0a, 0xb3,
+	0x2f, 0x48, 0xe2, 0xc0, 0x49, 0xe8, 0x7b, 0x3c, 0xe2, 0x7b, 0x6a, 0xf6, 0x16, 0xef, 0x5c, 0xf0,
+	0xc6, 0x79, 0x56, 0x87, 0x8f, 0xc0, 0x3d, 0x19, 0xa5, 0x02, 0xba, 0xc2, 0xa1, 0xff, 0xcb, 0x72,
+	0x0e, 0xb4, 0xc0, 0xba, 0x0c, 0x4f, 0xa3, 0xce, 0x27, 0x39, 0x30, 0xf2, 0x78, 0x19, 0x22, 0x5e,
+	0xc6, 0x5b, 0x81, 0xb0, 0xd6, 0x6e, 0xbe, 0x35, 0x6b, 0xd7, 0xdf, 0x9b, 0x8a, 0x3d, 0xa7, 0xb5,
+	0x3f, 0xa9, 0x60, 0xbf, 0xf2, 0x33, 0xc2, 0x57, 0x60, 0xdb, 0xa3, 0xf1, 0x20, 0x24, 0x1e, 0x9f,
+	0xdb, 0x0d, 0xa9, 0x77, 0x59, 0x78, 0x74, 0x5c, 0x95, 0x07, 0x2b, 0x03, 0xd9, 0x5b, 0x0b, 0x3c,
+	0x5e, 0x81, 0x0f, 0xc1, 0x7f, 0x1e, 0x8d, 0x22, 0x1a, 0x3b, 0x43, 0x9c, 0xe1, 0xb8, 0x77, 0x9a,
+	0xbd, 0x99, 0x17, 0xcf, 0x78, 0x0d, 0xbe, 0x01, 0x3b, 0xee, 0xd5, 0x07, 0x14, 0x33, 0x12, 0x63,
+	0x47, 0xae, 0x9b, 0x36, 0xb4, 0x96, 0xd6, 0xd9, 0xe8, 0x1e, 0x95, 0xf9, 0x2c, 0x40, 0xf6, 0x7d,
+	0xc9, 0x94, 0xb5, 0xb4, 0xc2, 0xfa, 0x95, 0x0a, 0xeb, 0xff, 0x85, 0xa3, 0x5f, 0x55, 0xb0, 0x5f,
+	0x19, 0x54, 0xf8, 0x14, 0x00, 0x99, 0x78, 0x11, 0xb7, 0xc3, 0x92, 0xb5, 0x04, 0xd1, 0x5e, 0x17,
+	0xf0, 0xde, 0x2f, 0x5c, 0x11, 0xbd, 0xbb, 0x71, 0x2d, 0xf8, 0x18, 0x6c, 0xcf, 0x43, 0x85, 0x7c,
+	0x7f, 0x84, 0xd3, 0x94, 0x27, 0x70, 0xd3, 0xde, 0x92, 0x8d, 0x5e, 0x5e, 0xff, 0x43, 0xd3, 0x4a,
+	0xf2, 0x5a, 0xff, 0x7d, 0x5e, 0x57, 0xff, 0xce, 0xdd, 0xd7, 0x60, 0x53, 0x78, 0xd9, 0x27, 0x29,
+	0x83, 0xcf, 0xc0, 0xda, 0xc2, 0x8b, 0xa8, 0x55, 0x38, 0x22, 0x9f, 0x82, 0x95, 0xec, 0x4e, 0x5b,
+	0x52, 0xac, 0xfe, 0xcd, 0x54, 0x57, 0x6e, 0xa7, 0xba, 0xf2, 0x63, 0xaa, 0x2b, 0xd7, 0x33, 0xbd,
+	0x76, 0x3b, 0xd3, 0x6b, 0x5f, 0x66, 0x7a, 0xed, 0x5d, 0x37, 0x20, 0x6c, 0x38, 0x76, 0xb3, 0xcb,
+	0x4c, 0xf9, 0xd6, 0xcb, 0x03, 0x4a, 0x88, 0xb9, 0xf4, 0x0f, 0xe0, 0xae, 0xf2, 0x2d, 0x9e, 0xfc,
+	0x1c, 0x00, 0xa8, 0x7c, 0xee, 0x75, 0x71, 0x06, 0x00, 0x00,
 }
 
 func (m *Evidence) Marshal() (dAtA []byte, err error) {
@@ -435,6 +539,27 @@ func (m *Evidence_LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Evidence_DuplicateProposalEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Evidence_DuplicateProposalEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DuplicateProposalEvidence != nil {
+		{
+			size, err := m.DuplicateProposalEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DuplicateVoteEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -455,12 +580,12 @@ func (m *DuplicateVoteEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintEvidence(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintEvidence(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x2a
 	if m.ValidatorPower != 0 {
@@ -520,12 +645,12 @@ func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintEvidence(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintEvidence(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x2a
 	if m.TotalVotingPower != 0 {
@@ -567,6 +692,78 @@ func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *DuplicateProposalEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicateProposalEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DuplicateProposalEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintEvidence(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x32
+	if m.ValidatorPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.ValidatorPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalB != nil {
+		{
+			size, err := m.ProposalB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalA != nil {
+		{
+			size, err := m.ProposalA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EvidenceList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -651,6 +848,18 @@ func (m *Evidence_LightClientAttackEvidence) Size() (n int) {
 	}
 	return n
 }
+func (m *Evidence_DuplicateProposalEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DuplicateProposalEvidence != nil {
+		l = m.DuplicateProposalEvidence.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
 func (m *DuplicateVoteEvidence) Size() (n int) {
 	if m == nil {
 		return 0
@@ -703,6 +912,35 @@ func (m *LightClientAttackEvidence) Size() (n int) {
 	return n
 }
 
+func (m *DuplicateProposalEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalA != nil {
+		l = m.ProposalA.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.ProposalB != nil {
+		l = m.ProposalB.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalVotingPower))
+	}
+	if m.ValidatorPower != 0 {
+		n += 1 + sovEvidence(uint64(m.ValidatorPower))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func (m *EvidenceList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -823,6 +1061,41 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &Evidence_LightClientAttackEvidence{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateProposalEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DuplicateProposalEvidence{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_DuplicateProposalEvidence{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvidence(dAtA[iNdEx:])
@@ -1228,6 +1501,233 @@ func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DuplicateProposalEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuplicateProposalEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuplicateProposalEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalA == nil {
+				m.ProposalA = &Proposal{}
+			}
+			if err := m.ProposalA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProposalB == nil {
+				m.ProposalB = &Proposal{}
+			}
+			if err := m.ProposalB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPower", wireType)
+			}
+			m.ValidatorPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EvidenceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
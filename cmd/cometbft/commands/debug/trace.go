@@ -0,0 +1,190 @@
+package debug
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cs "github.com/cometbft/cometbft/internal/consensus"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+)
+
+var (
+	traceHeight    int64
+	traceEndHeight int64
+	traceFormat    string
+	traceOutput    string
+
+	flagTraceHeight    = "height"
+	flagTraceEndHeight = "end-height"
+	flagTraceFormat    = "format"
+	flagTraceOutput    = "output"
+)
+
+var traceCSVHeader = []string{
+	"height", "round", "type", "step", "timeout_ms", "peer_id", "vote_type",
+	"validator_address", "validator_index", "block_hash", "time", "error",
+}
+
+var consensusTraceCmd = &cobra.Command{
+	Use:   "consensus-trace",
+	Short: "Export the consensus round trace of a CometBFT process",
+	Long: `Export the step transitions, timeouts, proposals and votes recorded by the
+consensus state of a CometBFT process, as JSON lines or CSV, e.g. to graph the
+latency of each validator. The events are fetched from the /consensus_trace RPC
+endpoint, so the process must run with consensus.round_trace_heights set.`,
+	Example: `
+	cometbft debug consensus-trace
+	cometbft debug consensus-trace --height 100 --end-height 110 --format csv --output trace.csv
+	`,
+	Args: cobra.NoArgs,
+	RunE: consensusTraceCmdHandler,
+}
+
+func init() {
+	consensusTraceCmd.Flags().Int64Var(
+		&traceHeight,
+		flagTraceHeight,
+		0,
+		"the height to export (default: the current height)",
+	)
+	consensusTraceCmd.Flags().Int64Var(
+		&traceEndHeight,
+		flagTraceEndHeight,
+		0,
+		"export all the heights from --height up to this one",
+	)
+	consensusTraceCmd.Flags().StringVar(
+		&traceFormat,
+		flagTraceFormat,
+		"json",
+		"the output format (json or csv)",
+	)
+	consensusTraceCmd.Flags().StringVar(
+		&traceOutput,
+		flagTraceOutput,
+		"",
+		"the file to write the trace to (default: stdout)",
+	)
+}
+
+func consensusTraceCmdHandler(_ *cobra.Command, _ []string) error {
+	if traceFormat != "json" && traceFormat != "csv" {
+		return fmt.Errorf("unknown format %q (must be 'json' or 'csv')", traceFormat)
+	}
+	if traceEndHeight != 0 && traceEndHeight < traceHeight {
+		return errors.New("end height must be greater than or equal to height")
+	}
+
+	rpc, err := rpchttp.New(nodeRPCAddr)
+	if err != nil {
+		return fmt.Errorf("failed to create new http client: %w", err)
+	}
+
+	var out io.Writer = os.Stdout
+	if traceOutput != "" {
+		f, err := os.Create(traceOutput)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	w := newTraceWriter(out, traceFormat)
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	height, endHeight := traceHeight, traceEndHeight
+	for {
+		var heightPtr *int64
+		if height > 0 {
+			heightPtr = &height
+		}
+		res, err := rpc.ConsensusTrace(context.Background(), heightPtr)
+		if err != nil {
+			return fmt.Errorf("failed to get consensus trace: %w", err)
+		}
+
+		var events []cs.RoundTraceEvent
+		if err := cmtjson.Unmarshal(res.Events, &events); err != nil {
+			return fmt.Errorf("failed to unmarshal consensus trace of height %d: %w", res.Height, err)
+		}
+		for _, ev := range events {
+			if err := w.write(ev); err != nil {
+				return err
+			}
+		}
+
+		height = res.Height + 1
+		if height > endHeight {
+			break
+		}
+	}
+	return w.flush()
+}
+
+// traceWriter writes RoundTraceEvents as JSON lines or CSV.
+type traceWriter struct {
+	format string
+	bw     *bufio.Writer
+	cw     *csv.Writer
+}
+
+func newTraceWriter(w io.Writer, format string) *traceWriter {
+	bw := bufio.NewWriter(w)
+	return &traceWriter{format: format, bw: bw, cw: csv.NewWriter(bw)}
+}
+
+func (w *traceWriter) writeHeader() error {
+	if w.format != "csv" {
+		return nil
+	}
+	return w.cw.Write(traceCSVHeader)
+}
+
+func (w *traceWriter) write(ev cs.RoundTraceEvent) error {
+	if w.format == "csv" {
+		return w.cw.Write([]string{
+			strconv.FormatInt(ev.Height, 10),
+			strconv.FormatInt(int64(ev.Round), 10),
+			ev.Type,
+			ev.Step,
+			strconv.FormatInt(ev.Timeout.Milliseconds(), 10),
+			string(ev.PeerID),
+			ev.VoteType,
+			ev.ValidatorAddress,
+			strconv.FormatInt(int64(ev.ValidatorIndex), 10),
+			ev.BlockHash,
+			ev.Time.Format(time.RFC3339Nano),
+			ev.Error,
+		})
+	}
+
+	bz, err := cmtjson.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	if _, err := w.bw.Write(bz); err != nil {
+		return err
+	}
+	return w.bw.WriteByte('\n')
+}
+
+func (w *traceWriter) flush() error {
+	w.cw.Flush()
+	if err := w.cw.Error(); err != nil {
+		return err
+	}
+	return w.bw.Flush()
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/internal/blocksync"
+)
+
+var (
+	exportFromHeight    int64
+	exportToHeight      int64
+	exportBlocksPerFile int64
+)
+
+// ExportBlocksCmd exports the blocks of the block store to archive files,
+// from which other nodes can block sync.
+var ExportBlocksCmd = &cobra.Command{
+	Use:     "export-blocks [dir]",
+	Aliases: []string{"export_blocks"},
+	Short:   "Export blocks to compressed archive files for block sync",
+	Long: `
+Export blocks and their extended commits from the block store to compressed
+archive files in the given directory, each one holding a range of heights.
+Another node can block sync from these files, by setting its
+blocksync.archive_dir, before fetching the remaining blocks from its peers.
+The blocks are verified as if they were received from peers.
+
+The default from-height is 0, meaning the export starts from the base of the
+block store; the default to-height is 0, meaning the export ends at the latest
+height of the block store. The node must be stopped.
+`,
+	Example: `
+	cometbft export-blocks /path/to/archive
+	cometbft export-blocks /path/to/archive --from-height 1000 --to-height 2000
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		bs, ss, err := loadStateAndBlockStore(config)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = bs.Close()
+			_ = ss.Close()
+		}()
+
+		state, err := ss.Load()
+		if err != nil {
+			return err
+		}
+
+		from, to := exportFromHeight, exportToHeight
+		if from == 0 {
+			from = bs.Base()
+		}
+		if to == 0 {
+			to = bs.Height()
+		}
+		paths, err := blocksync.ExportBlocks(bs, state, args[0], from, to, exportBlocksPerFile)
+		for _, path := range paths {
+			fmt.Println(path)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Exported blocks %d to %d to %d files\n", from, to, len(paths))
+		return nil
+	},
+}
+
+func init() {
+	ExportBlocksCmd.Flags().Int64Var(&exportFromHeight, "from-height", 0, "first height to export")
+	ExportBlocksCmd.Flags().Int64Var(&exportToHeight, "to-height", 0, "last height to export")
+	ExportBlocksCmd.Flags().Int64Var(&exportBlocksPerFile, "blocks-per-file", 10000, "maximum number of blocks per archive file")
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -21,6 +22,7 @@ import (
 	lproxy "github.com/cometbft/cometbft/light/proxy"
 	lrpc "github.com/cometbft/cometbft/light/rpc"
 	dbs "github.com/cometbft/cometbft/light/store/db"
+	grpcserver "github.com/cometbft/cometbft/rpc/grpc/server"
 	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
 )
 
@@ -39,6 +41,10 @@ need a primary RPC address, a trusted hash and height and witness RPC addresses
 (if not using sequential verification). To restart the node, thereafter
 only the chainID is required.
 
+With --grpc-laddr, the proxy also serves the gRPC BlockService and
+BlockResultsService, with the data verified the same way. The state of the
+light client is available at /light_status.
+
 When /abci_query is called, the Merkle key path format is:
 
 	/{store name}/{key}
@@ -54,6 +60,7 @@ for applications built w/ Cosmos SDK).
 
 var (
 	listenAddr         string
+	grpcListenAddr     string
 	primaryAddr        string
 	witnessAddrsJoined string
 	chainID            string
@@ -75,6 +82,8 @@ var (
 func init() {
 	LightCmd.Flags().StringVar(&listenAddr, "laddr", "tcp://localhost:8888",
 		"serve the proxy on the given address")
+	LightCmd.Flags().StringVar(&grpcListenAddr, "grpc-laddr", "",
+		"serve the verified gRPC block and block results services on the given address (disabled if empty)")
 	LightCmd.Flags().StringVarP(&primaryAddr, "primary", "p", "",
 		"connect to a CometBFT node at this address")
 	LightCmd.Flags().StringVarP(&witnessAddrsJoined, "witnesses", "w", "",
@@ -219,9 +228,25 @@ func runProxy(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	var grpcListener net.Listener
+	if grpcListenAddr != "" {
+		grpcListener, err = grpcserver.Listen(grpcListenAddr)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", grpcListenAddr, err)
+		}
+		go func() {
+			if err := p.ServeGRPC(grpcListener); err != nil {
+				logger.Error("proxy ServeGRPC", "err", err)
+			}
+		}()
+	}
+
 	// Stop upon receiving SIGTERM or CTRL-C.
 	cmtos.TrapSignal(logger, func() {
 		p.Listener.Close()
+		if grpcListener != nil {
+			grpcListener.Close()
+		}
 	})
 
 	logger.Info("Starting proxy...", "laddr", listenAddr)
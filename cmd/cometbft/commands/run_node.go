@@ -42,6 +42,8 @@ func AddNodeFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64("consensus.double_sign_check_height", config.Consensus.DoubleSignCheckHeight,
 		"how many blocks to look back to check existence of the node's "+
 			"consensus votes before joining consensus")
+	cmd.Flags().String("blocksync.archive_dir", config.BlockSync.ArchivePath,
+		"directory of block archives (see export-blocks) to block sync from before switching to peers")
 
 	// abci flags
 	cmd.Flags().String(
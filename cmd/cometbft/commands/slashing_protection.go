@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/types"
+)
+
+var slashingProtectionFile string
+
+// SlashingProtectionCmd groups the commands to export and import the signing
+// history of the local private validator.
+var SlashingProtectionCmd = &cobra.Command{
+	Use:     "slashing-protection",
+	Aliases: []string{"slashing_protection"},
+	Short:   "Export and import the slashing protection database",
+	Long: `
+Export and import the signing history recorded in the slashing protection
+database (see priv_validator_slashing_protection), in a JSON interchange format.
+When moving a validator to a new machine, export the history on the old one
+after stopping it, and import it on the new one before starting it.
+The node must be stopped.
+`,
+}
+
+var slashingProtectionExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the signing history of the private validator",
+	Example: `
+	cometbft slashing-protection export --file history.json
+	`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		spDB, address, err := openSlashingProtectionDB()
+		if err != nil {
+			return err
+		}
+		defer spDB.Close()
+
+		bz, err := spDB.ExportJSON(address)
+		if err != nil {
+			return fmt.Errorf("failed to export signing history: %w", err)
+		}
+		if slashingProtectionFile == "" {
+			fmt.Println(string(bz))
+			return nil
+		}
+		return os.WriteFile(slashingProtectionFile, bz, 0o600)
+	},
+}
+
+var slashingProtectionImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import a signing history exported from another machine",
+	Long: `
+Import a signing history exported from another machine. The history is merged
+with the recorded one. Nothing is imported if it belongs to another validator,
+or if it conflicts with the recorded history.
+`,
+	Example: `
+	cometbft slashing-protection import --file history.json
+	`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		bz, err := os.ReadFile(slashingProtectionFile)
+		if err != nil {
+			return err
+		}
+
+		spDB, address, err := openSlashingProtectionDB()
+		if err != nil {
+			return err
+		}
+		defer spDB.Close()
+
+		if err := spDB.ImportJSON(bz, address); err != nil {
+			return fmt.Errorf("failed to import signing history: %w", err)
+		}
+		fmt.Printf("Imported signing history from %s\n", slashingProtectionFile)
+		return nil
+	},
+}
+
+func init() {
+	slashingProtectionExportCmd.Flags().StringVar(&slashingProtectionFile, "file", "", "file to write the history to (default: stdout)")
+	slashingProtectionImportCmd.Flags().StringVar(&slashingProtectionFile, "file", "", "file to read the history from")
+	_ = slashingProtectionImportCmd.MarkFlagRequired("file")
+
+	SlashingProtectionCmd.AddCommand(slashingProtectionExportCmd, slashingProtectionImportCmd)
+}
+
+// openSlashingProtectionDB opens the slashing protection database in the
+// node's home directory, and returns it along with the address of the
+// private validator.
+func openSlashingProtectionDB() (*privval.SlashingProtectionDB, types.Address, error) {
+	keyFilePath := config.PrivValidatorKeyFile()
+	if !cmtos.FileExists(keyFilePath) {
+		return nil, nil, fmt.Errorf("private validator file %s does not exist", keyFilePath)
+	}
+	pv := privval.LoadFilePVEmptyState(keyFilePath, config.PrivValidatorStateFile())
+
+	db, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "slashing_protection", Config: config})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open slashing protection database: %w", err)
+	}
+	return privval.NewSlashingProtectionDB(db), pv.GetAddress(), nil
+}
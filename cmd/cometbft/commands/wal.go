@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	cs "github.com/cometbft/cometbft/internal/consensus"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+)
+
+var (
+	walFile      string
+	walHeight    int64
+	walRound     int32
+	walMsgTypes  []string
+	walEndHeight int64
+)
+
+// WALCmd groups the commands to inspect and repair the consensus WAL.
+var WALCmd = &cobra.Command{
+	Use:   "wal",
+	Short: "Inspect and repair the consensus write-ahead log (WAL)",
+	Long: `
+Commands to inspect and repair the consensus write-ahead log (WAL), for example
+after a validator crashed in the middle of a round. The node must be stopped.
+By default, the WAL in the node's home directory is used.
+`,
+}
+
+var walDumpCmd = &cobra.Command{
+	Use:   "dump",
+	Short: "Print the messages in the WAL as JSON lines",
+	Long: `
+Print the messages in the WAL as JSON lines, in the order in which they were
+written. Messages can be filtered by height, round and type. The types are
+round_state, proposal, block_part, vote, msg_info, timeout and end_height.
+`,
+	Example: `
+	cometbft wal dump
+	cometbft wal dump --height 10
+	cometbft wal dump --height 10 --round 1 --type proposal,vote
+	`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		return dumpWAL(walFilePath())
+	},
+}
+
+var walVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Check the integrity of the messages in the WAL",
+	Long: `
+Check the checksum and the encoding of all the messages in the WAL, and report
+the file and offset of the corrupted ones. A file can't be decoded after a
+corrupted message, so at most one is reported per file.
+`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		numMsgs, corrupted, err := cs.VerifyWAL(walFilePath())
+		if err != nil {
+			return err
+		}
+		for _, c := range corrupted {
+			fmt.Printf("%s: offset %d: %v\n", c.Path, c.Offset, c.Err)
+		}
+		fmt.Printf("Found %d valid messages\n", numMsgs)
+		if len(corrupted) > 0 {
+			return fmt.Errorf("found %d corrupted messages", len(corrupted))
+		}
+		return nil
+	},
+}
+
+var walTruncateCmd = &cobra.Command{
+	Use:   "truncate",
+	Short: "Remove the messages after the end of a height from the WAL",
+	Long: `
+Remove all the messages written after the end of the given height from the
+WAL, so that the node starts the next height from scratch when it restarts.
+Nothing is removed if the end of the height can't be found, or if the WAL is
+corrupted before it.
+
+The votes signed by a validator are also recorded in its private validator
+state, which still prevents it from signing conflicting votes after restarting.
+`,
+	Example: `
+	cometbft wal truncate --height 10
+	`,
+	RunE: func(_ *cobra.Command, _ []string) error {
+		removed, err := cs.TruncateWAL(walFilePath(), walEndHeight)
+		if err != nil {
+			return fmt.Errorf("failed to truncate WAL: %w", err)
+		}
+		fmt.Printf("Truncated WAL after height %d, removed %d bytes\n", walEndHeight, removed)
+		return nil
+	},
+}
+
+func init() {
+	WALCmd.PersistentFlags().StringVar(&walFile, "wal-file", "", "path to the WAL file (default: the WAL in the node's home directory)")
+
+	walDumpCmd.Flags().Int64Var(&walHeight, "height", -1, "only print the messages of this height")
+	walDumpCmd.Flags().Int32Var(&walRound, "round", -1, "only print the messages of this round")
+	walDumpCmd.Flags().StringSliceVar(&walMsgTypes, "type", nil, "only print the messages of these types")
+
+	walTruncateCmd.Flags().Int64Var(&walEndHeight, "height", 0, "height after which to remove the messages")
+	_ = walTruncateCmd.MarkFlagRequired("height")
+
+	WALCmd.AddCommand(walDumpCmd, walVerifyCmd, walTruncateCmd)
+}
+
+func walFilePath() string {
+	if walFile != "" {
+		return walFile
+	}
+	return config.Consensus.WalFile()
+}
+
+func dumpWAL(path string) error {
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	return cs.WalkWAL(path, func(rec cs.WALRecord) error {
+		if len(walMsgTypes) > 0 && !slices.Contains(walMsgTypes, cs.WALMessageType(rec.Msg.Msg)) {
+			return nil
+		}
+		height, round := cs.WALMessageHeightRound(rec.Msg.Msg)
+		if (walHeight >= 0 && height != walHeight) || (walRound >= 0 && round != walRound) {
+			return nil
+		}
+
+		bz, err := cmtjson.Marshal(rec.Msg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal message: %w", err)
+		}
+		if _, err := out.Write(bz); err != nil {
+			return err
+		}
+		return out.WriteByte('\n')
+	})
+}
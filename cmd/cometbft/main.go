@@ -29,6 +29,9 @@ func main() {
 		cmd.RollbackStateCmd,
 		cmd.CompactGoLevelDBCmd,
 		cmd.InspectCmd,
+		cmd.WALCmd,
+		cmd.SlashingProtectionCmd,
+		cmd.ExportBlocksCmd,
 		debug.DebugCmd,
 		config.Command(),
 		cli.NewCompletionCmd(rootCmd, true),
@@ -49,8 +49,15 @@ const (
 	v1 = "v1"
 	v2 = "v2"
 
-	MempoolTypeFlood = "flood"
-	MempoolTypeNop   = "nop"
+	MempoolTypeFlood    = "flood"
+	MempoolTypeNop      = "nop"
+	MempoolTypePriority = "priority"
+
+	MempoolGossipProtocolPush = "push"
+	MempoolGossipProtocolPull = "pull"
+
+	P2PTransportTCP  = "tcp"
+	P2PTransportQUIC = "quic"
 )
 
 // NOTE: Most of the structs & relevant comments + the
@@ -147,6 +154,7 @@ func (cfg *Config) SetRoot(root string) *Config {
 	cfg.RPC.RootDir = root
 	cfg.P2P.RootDir = root
 	cfg.Mempool.RootDir = root
+	cfg.BlockSync.RootDir = root
 	cfg.Consensus.RootDir = root
 	return cfg
 }
@@ -267,10 +275,20 @@ type BaseConfig struct {
 	// Path to the JSON file containing the last sign state of a validator
 	PrivValidatorState string `mapstructure:"priv_validator_state_file"`
 
+	// If true, every vote and proposal signed by the local private validator
+	// is recorded in the slashing protection database and checked against it
+	PrivValidatorSlashingProtection bool `mapstructure:"priv_validator_slashing_protection"`
+
 	// TCP or UNIX socket address for CometBFT to listen on for
-	// connections from an external PrivValidator process
+	// connections from an external PrivValidator process. A comma-separated
+	// list of addresses enables the threshold signer
 	PrivValidatorListenAddr string `mapstructure:"priv_validator_laddr"`
 
+	// Number of external PrivValidator processes that must return the same
+	// signature when several addresses are set in PrivValidatorListenAddr.
+	// 0 means a majority of them
+	PrivValidatorThreshold int `mapstructure:"priv_validator_threshold"`
+
 	// A JSON file containing the private key to use for p2p authenticated encryption
 	NodeKey string `mapstructure:"node_key_file"`
 
@@ -350,6 +368,10 @@ func (cfg BaseConfig) ValidateBasic() error {
 		return errors.New("unknown log_format (must be 'plain' or 'json')")
 	}
 
+	if cfg.PrivValidatorThreshold < 0 {
+		return cmterrors.ErrNegativeField{Field: "priv_validator_threshold"}
+	}
+
 	return cfg.validateProxyApp()
 }
 
@@ -628,6 +650,14 @@ type GRPCConfig struct {
 	// If no height is provided, the block results of the latest height are returned
 	BlockResultsService *GRPCBlockResultsServiceConfig `mapstructure:"block_results_service"`
 
+	// The gRPC mempool service allows submitting txs, querying the txs in the
+	// mempool and streaming new txs
+	MempoolService *GRPCMempoolServiceConfig `mapstructure:"mempool_service"`
+
+	// The gRPC evidence service allows querying the pending evidence and the
+	// committed evidence
+	EvidenceService *GRPCEvidenceServiceConfig `mapstructure:"evidence_service"`
+
 	// The "privileged" section provides configuration for the gRPC server
 	// dedicated to privileged clients.
 	Privileged *GRPCPrivilegedConfig `mapstructure:"privileged"`
@@ -639,6 +669,8 @@ func DefaultGRPCConfig() *GRPCConfig {
 		VersionService:      DefaultGRPCVersionServiceConfig(),
 		BlockService:        DefaultGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      DefaultGRPCMempoolServiceConfig(),
+		EvidenceService:     DefaultGRPCEvidenceServiceConfig(),
 		Privileged:          DefaultGRPCPrivilegedConfig(),
 	}
 }
@@ -649,6 +681,8 @@ func TestGRPCConfig() *GRPCConfig {
 		VersionService:      TestGRPCVersionServiceConfig(),
 		BlockService:        TestGRPCBlockServiceConfig(),
 		BlockResultsService: DefaultGRPCBlockResultsServiceConfig(),
+		MempoolService:      TestGRPCMempoolServiceConfig(),
+		EvidenceService:     TestGRPCEvidenceServiceConfig(),
 		Privileged:          TestGRPCPrivilegedConfig(),
 	}
 }
@@ -708,6 +742,38 @@ func TestGRPCBlockServiceConfig() *GRPCBlockServiceConfig {
 	}
 }
 
+type GRPCMempoolServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled: false,
+	}
+}
+
+func TestGRPCMempoolServiceConfig() *GRPCMempoolServiceConfig {
+	return &GRPCMempoolServiceConfig{
+		Enabled: true,
+	}
+}
+
+type GRPCEvidenceServiceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+}
+
+func DefaultGRPCEvidenceServiceConfig() *GRPCEvidenceServiceConfig {
+	return &GRPCEvidenceServiceConfig{
+		Enabled: true,
+	}
+}
+
+func TestGRPCEvidenceServiceConfig() *GRPCEvidenceServiceConfig {
+	return &GRPCEvidenceServiceConfig{
+		Enabled: true,
+	}
+}
+
 // -----------------------------------------------------------------------------
 // GRPCPrivilegedConfig
 
@@ -766,6 +832,11 @@ type P2PConfig struct { //nolint: maligned
 	// Address to advertise to peers for them to dial
 	ExternalAddress string `mapstructure:"external_address"`
 
+	// Transport to connect to peers with: "tcp" or "quic". With "quic", the
+	// node also accepts TCP connections on the same port, and falls back to
+	// TCP for the peers that don't accept QUIC connections.
+	Transport string `mapstructure:"transport"`
+
 	// Comma separated list of seed nodes to connect to
 	// We only use these if we can’t connect to peers in the addrbook
 	Seeds string `mapstructure:"seeds"`
@@ -837,6 +908,7 @@ func DefaultP2PConfig() *P2PConfig {
 	return &P2PConfig{
 		ListenAddress:                "tcp://0.0.0.0:26656",
 		ExternalAddress:              "",
+		Transport:                    P2PTransportTCP,
 		AddrBook:                     defaultAddrBookPath,
 		AddrBookStrict:               true,
 		MaxNumInboundPeers:           40,
@@ -873,6 +945,12 @@ func (cfg *P2PConfig) AddrBookFile() string {
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *P2PConfig) ValidateBasic() error {
+	switch cfg.Transport {
+	case P2PTransportTCP, P2PTransportQUIC:
+	case "": // allow empty string to be backwards compatible
+	default:
+		return fmt.Errorf("unknown p2p transport: %q", cfg.Transport)
+	}
 	if cfg.MaxNumInboundPeers < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_num_inbound_peers"}
 	}
@@ -930,9 +1008,12 @@ type MempoolConfig struct {
 	// The type of mempool for this node to use.
 	//
 	//  Possible types:
-	//  - "flood" : concurrent linked list mempool with flooding gossip protocol
+	//  - "flood"    : concurrent linked list mempool with flooding gossip protocol
 	//  (default)
-	//  - "nop"   : nop-mempool (short for no operation; the ABCI app is
+	//  - "priority" : mempool ordering txs by the priority returned by the app
+	//  in CheckTx, keeping txs of the same sender ordered by nonce; uses the
+	//  same flooding gossip protocol as "flood"
+	//  - "nop"      : nop-mempool (short for no operation; the ABCI app is
 	//  responsible for storing, disseminating and proposing txs).
 	//  "create_empty_blocks=false" is not supported.
 	Type string `mapstructure:"type"`
@@ -957,6 +1038,17 @@ type MempoolConfig struct {
 	// block. In other words, if Broadcast is disabled, only the peer you send
 	// the tx to will see it until it is included in a block.
 	Broadcast bool `mapstructure:"broadcast"`
+	// ExperimentalGossipProtocol defines how transactions are relayed to peers:
+	//  - "push" (default): full transactions are sent to every peer.
+	//  - "pull": only the keys of new transactions are announced to peers,
+	//    which then request the transactions they don't have. It is only used
+	//    with peers that also support it; for other peers, "push" is used.
+	ExperimentalGossipProtocol string `mapstructure:"experimental_gossip_protocol"`
+	// WalPath (default: "") is the directory of the mempool journal, where the
+	// txs added to and removed from the mempool are recorded, so that the txs
+	// left in the mempool are restored when the node restarts. If empty, the
+	// journal is disabled. Only supported by the "flood" mempool.
+	WalPath string `mapstructure:"wal_dir"`
 	// Maximum number of transactions in the mempool
 	Size int `mapstructure:"size"`
 	// Maximum size in bytes of a single transaction accepted into the mempool.
@@ -972,6 +1064,26 @@ type MempoolConfig struct {
 	// Set to true if it's not possible for any invalid transaction to become
 	// valid again in the future.
 	KeepInvalidTxsInCache bool `mapstructure:"keep-invalid-txs-in-cache"`
+	// TTLDuration, if non-zero, defines the maximum amount of time a transaction
+	// can stay in the mempool. Expired transactions are removed when a new block
+	// is committed.
+	TTLDuration time.Duration `mapstructure:"ttl_duration"`
+	// TTLNumBlocks, if non-zero, defines the maximum number of blocks a
+	// transaction can stay in the mempool. If both TTLDuration and TTLNumBlocks
+	// are set, a transaction is removed as soon as any of them is exceeded.
+	TTLNumBlocks int64 `mapstructure:"ttl_num_blocks"`
+	// MaxTxsPerPeer and MaxTxsBytesPerPeer, if non-zero, define the maximum
+	// number of transactions, and their total size in bytes, that a single
+	// peer can contribute to the mempool. A peer that repeatedly exceeds its
+	// quota is disconnected. Only supported by the "flood" mempool.
+	MaxTxsPerPeer      int   `mapstructure:"max_txs_per_peer"`
+	MaxTxsBytesPerPeer int64 `mapstructure:"max_txs_bytes_per_peer"`
+	// MaxTxsPerSender and MaxTxsBytesPerSender, if non-zero, define the
+	// maximum number of transactions, and their total size in bytes, with the
+	// same sender, as returned by the application in CheckTx. Only supported
+	// by the "flood" mempool.
+	MaxTxsPerSender      int   `mapstructure:"max_txs_per_sender"`
+	MaxTxsBytesPerSender int64 `mapstructure:"max_txs_bytes_per_sender"`
 	// Experimental parameters to limit gossiping txs to up to the specified number of peers.
 	// We use two independent upper values for persistent and non-persistent peers.
 	// Unconditional peers are not affected by this feature.
@@ -991,6 +1103,12 @@ type MempoolConfig struct {
 	// Note: Enabling this feature may introduce potential delays in transaction processing due to blocking behavior.
 	// Use this feature with caution and consider the impact on transaction processing performance.
 	ExperimentalPublishEventPendingTx bool `mapstructure:"experimental_publish_event_pending_tx"`
+
+	// ExperimentalPublishEventDroppedTx enables publishing `TxRejected`, `TxEvicted` and `TxRecheckFailed` events
+	// when a transaction is not added to, or is removed from, the mempool without being committed.
+	// Note: These events are published while processing CheckTx responses, so a flood of invalid transactions
+	// results in as many events. Use this feature with caution and consider the impact on transaction processing performance.
+	ExperimentalPublishEventDroppedTx bool `mapstructure:"experimental_publish_event_dropped_tx"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the CometBFT mempool.
@@ -1008,6 +1126,7 @@ func DefaultMempoolConfig() *MempoolConfig {
 		CacheSize:   10000,
 		ExperimentalMaxGossipConnectionsToNonPersistentPeers: 0,
 		ExperimentalMaxGossipConnectionsToPersistentPeers:    0,
+		ExperimentalGossipProtocol:                           MempoolGossipProtocolPush,
 	}
 }
 
@@ -1018,15 +1137,44 @@ func TestMempoolConfig() *MempoolConfig {
 	return cfg
 }
 
+// WalDir returns the full path to the mempool journal directory.
+func (cfg *MempoolConfig) WalDir() string {
+	return rootify(cfg.WalPath, cfg.RootDir)
+}
+
+// WalEnabled returns true iff the mempool journal is enabled.
+func (cfg *MempoolConfig) WalEnabled() bool {
+	return cfg.WalPath != ""
+}
+
+// QuotasEnabled returns true iff any of the per-peer or per-sender quotas is
+// set.
+func (cfg *MempoolConfig) QuotasEnabled() bool {
+	return cfg.MaxTxsPerPeer > 0 || cfg.MaxTxsBytesPerPeer > 0 ||
+		cfg.MaxTxsPerSender > 0 || cfg.MaxTxsBytesPerSender > 0
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg *MempoolConfig) ValidateBasic() error {
 	switch cfg.Type {
-	case MempoolTypeFlood, MempoolTypeNop:
+	case MempoolTypeFlood, MempoolTypePriority, MempoolTypeNop:
 	case "": // allow empty string to be backwards compatible
 	default:
 		return fmt.Errorf("unknown mempool type: %q", cfg.Type)
 	}
+	switch cfg.ExperimentalGossipProtocol {
+	case MempoolGossipProtocolPush, MempoolGossipProtocolPull:
+	case "": // allow empty string to be backwards compatible
+	default:
+		return fmt.Errorf("unknown mempool gossip protocol: %q", cfg.ExperimentalGossipProtocol)
+	}
+	if cfg.WalEnabled() && cfg.Type != MempoolTypeFlood && cfg.Type != "" {
+		return fmt.Errorf("wal_dir is not supported by the %q mempool", cfg.Type)
+	}
+	if cfg.QuotasEnabled() && cfg.Type != MempoolTypeFlood && cfg.Type != "" {
+		return fmt.Errorf("per-peer and per-sender quotas are not supported by the %q mempool", cfg.Type)
+	}
 	if cfg.Size < 0 {
 		return cmterrors.ErrNegativeField{Field: "size"}
 	}
@@ -1039,6 +1187,24 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.MaxTxBytes < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_tx_bytes"}
 	}
+	if cfg.TTLDuration < 0 {
+		return cmterrors.ErrNegativeField{Field: "ttl_duration"}
+	}
+	if cfg.TTLNumBlocks < 0 {
+		return cmterrors.ErrNegativeField{Field: "ttl_num_blocks"}
+	}
+	if cfg.MaxTxsPerPeer < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_txs_per_peer"}
+	}
+	if cfg.MaxTxsBytesPerPeer < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_txs_bytes_per_peer"}
+	}
+	if cfg.MaxTxsPerSender < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_txs_per_sender"}
+	}
+	if cfg.MaxTxsBytesPerSender < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_txs_bytes_per_sender"}
+	}
 	if cfg.ExperimentalMaxGossipConnectionsToPersistentPeers < 0 {
 		return cmterrors.ErrNegativeField{Field: "experimental_max_gossip_connections_to_persistent_peers"}
 	}
@@ -1070,6 +1236,7 @@ type StateSyncConfig struct {
 	Enable              bool          `mapstructure:"enable"`
 	TempDir             string        `mapstructure:"temp_dir"`
 	RPCServers          []string      `mapstructure:"rpc_servers"`
+	ProviderPeers       []string      `mapstructure:"provider_peers"`
 	TrustPeriod         time.Duration `mapstructure:"trust_period"`
 	TrustHeight         int64         `mapstructure:"trust_height"`
 	TrustHash           string        `mapstructure:"trust_hash"`
@@ -1105,17 +1272,31 @@ func TestStateSyncConfig() *StateSyncConfig {
 // ValidateBasic performs basic validation.
 func (cfg *StateSyncConfig) ValidateBasic() error {
 	if cfg.Enable {
-		if len(cfg.RPCServers) == 0 {
-			return cmterrors.ErrRequiredField{Field: "rpc_servers"}
-		}
+		// The light client fetches light blocks either from peers or from RPC
+		// servers, so rpc_servers are only required without provider_peers.
+		if len(cfg.ProviderPeers) > 0 {
+			if len(cfg.ProviderPeers) < 2 {
+				return ErrNotEnoughProviderPeers
+			}
 
-		if len(cfg.RPCServers) < 2 {
-			return ErrNotEnoughRPCServers
-		}
+			for _, peer := range cfg.ProviderPeers {
+				if len(peer) == 0 {
+					return ErrEmptyProviderPeerEntry
+				}
+			}
+		} else {
+			if len(cfg.RPCServers) == 0 {
+				return cmterrors.ErrRequiredField{Field: "rpc_servers"}
+			}
 
-		for _, server := range cfg.RPCServers {
-			if len(server) == 0 {
-				return ErrEmptyRPCServerEntry
+			if len(cfg.RPCServers) < 2 {
+				return ErrNotEnoughRPCServers
+			}
+
+			for _, server := range cfg.RPCServers {
+				if len(server) == 0 {
+					return ErrEmptyRPCServerEntry
+				}
 			}
 		}
 
@@ -1155,10 +1336,14 @@ func (cfg *StateSyncConfig) ValidateBasic() error {
 // PossibleMisconfigurations returns a list of possible conflicting entries that
 // may lead to unexpected behavior.
 func (cfg *StateSyncConfig) PossibleMisconfigurations() []string {
+	res := []string{}
 	if !cfg.Enable && len(cfg.RPCServers) != 0 {
-		return []string{"rpc_servers specified but enable = false"}
+		res = append(res, "rpc_servers specified but enable = false")
 	}
-	return []string{}
+	if !cfg.Enable && len(cfg.ProviderPeers) != 0 {
+		res = append(res, "provider_peers specified but enable = false")
+	}
+	return res
 }
 
 // -----------------------------------------------------------------------------
@@ -1166,7 +1351,13 @@ func (cfg *StateSyncConfig) PossibleMisconfigurations() []string {
 
 // BlockSyncConfig (formerly known as FastSync) defines the configuration for the CometBFT block sync service.
 type BlockSyncConfig struct {
+	RootDir string `mapstructure:"home"`
 	Version string `mapstructure:"version"`
+
+	// Directory of block archives, as written by the export-blocks command, to
+	// sync blocks from before fetching the remaining ones from peers. Blocks
+	// are verified as if they were received from peers. Empty disables it.
+	ArchivePath string `mapstructure:"archive_dir"`
 }
 
 // DefaultBlockSyncConfig returns a default configuration for the block sync service.
@@ -1181,6 +1372,15 @@ func TestBlockSyncConfig() *BlockSyncConfig {
 	return DefaultBlockSyncConfig()
 }
 
+// ArchiveDir returns the full path to the directory of block archives, or
+// an empty string if syncing from archives is disabled.
+func (cfg *BlockSyncConfig) ArchiveDir() string {
+	if cfg.ArchivePath == "" {
+		return ""
+	}
+	return rootify(cfg.ArchivePath, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation.
 func (cfg *BlockSyncConfig) ValidateBasic() error {
 	switch cfg.Version {
@@ -1214,6 +1414,16 @@ type ConsensusConfig struct {
 	// Deprecated: use `next_block_delay` in the ABCI application's `FinalizeBlockResponse`.
 	TimeoutCommit time.Duration `mapstructure:"timeout_commit"`
 
+	// Adapt timeout_propose and timeout_vote to the proposal and +2/3 quorum
+	// latencies derived from the commits of the last adaptive_timeouts_window
+	// heights, within the bounds below
+	AdaptiveTimeouts       bool          `mapstructure:"adaptive_timeouts"`
+	AdaptiveTimeoutsWindow int           `mapstructure:"adaptive_timeouts_window"`
+	TimeoutProposeMin      time.Duration `mapstructure:"timeout_propose_min"`
+	TimeoutProposeMax      time.Duration `mapstructure:"timeout_propose_max"`
+	TimeoutVoteMin         time.Duration `mapstructure:"timeout_vote_min"`
+	TimeoutVoteMax         time.Duration `mapstructure:"timeout_vote_max"`
+
 	// EmptyBlocks mode and possible interval between empty blocks
 	CreateEmptyBlocks         bool          `mapstructure:"create_empty_blocks"`
 	CreateEmptyBlocksInterval time.Duration `mapstructure:"create_empty_blocks_interval"`
@@ -1224,6 +1434,11 @@ type ConsensusConfig struct {
 	PeerGossipIntraloopSleepDuration time.Duration `mapstructure:"peer_gossip_intraloop_sleep_duration"` // upper bound on randomly selected values
 
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
+
+	// Number of most recent heights for which step transitions, timeouts,
+	// proposals and votes are recorded, for the /consensus_trace RPC
+	// endpoint. 0 disables the recorder.
+	RoundTraceHeights int `mapstructure:"round_trace_heights"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service.
@@ -1235,6 +1450,12 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		TimeoutVote:                      1000 * time.Millisecond,
 		TimeoutVoteDelta:                 500 * time.Millisecond,
 		TimeoutCommit:                    0 * time.Millisecond,
+		AdaptiveTimeouts:                 false,
+		AdaptiveTimeoutsWindow:           100,
+		TimeoutProposeMin:                1000 * time.Millisecond,
+		TimeoutProposeMax:                10000 * time.Millisecond,
+		TimeoutVoteMin:                   200 * time.Millisecond,
+		TimeoutVoteMax:                   5000 * time.Millisecond,
 		CreateEmptyBlocks:                true,
 		CreateEmptyBlocksInterval:        0 * time.Second,
 		PeerGossipSleepDuration:          100 * time.Millisecond,
@@ -1252,6 +1473,10 @@ func TestConsensusConfig() *ConsensusConfig {
 	cfg.TimeoutVote = 10 * time.Millisecond
 	cfg.TimeoutVoteDelta = 1 * time.Millisecond
 	cfg.TimeoutCommit = 0
+	cfg.TimeoutProposeMin = 10 * time.Millisecond
+	cfg.TimeoutProposeMax = 100 * time.Millisecond
+	cfg.TimeoutVoteMin = 5 * time.Millisecond
+	cfg.TimeoutVoteMax = 50 * time.Millisecond
 	cfg.PeerGossipSleepDuration = 5 * time.Millisecond
 	cfg.PeerQueryMaj23SleepDuration = 250 * time.Millisecond
 	cfg.DoubleSignCheckHeight = int64(0)
@@ -1321,6 +1546,24 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.TimeoutCommit < 0 {
 		return cmterrors.ErrNegativeField{Field: "timeout_commit"}
 	}
+	if cfg.AdaptiveTimeoutsWindow < 0 {
+		return cmterrors.ErrNegativeField{Field: "adaptive_timeouts_window"}
+	}
+	if cfg.AdaptiveTimeouts && cfg.AdaptiveTimeoutsWindow == 0 {
+		return errors.New("adaptive_timeouts_window must be > 0 when adaptive_timeouts is enabled")
+	}
+	if cfg.TimeoutProposeMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_propose_min"}
+	}
+	if cfg.TimeoutProposeMax < cfg.TimeoutProposeMin {
+		return errors.New("timeout_propose_max must be >= timeout_propose_min")
+	}
+	if cfg.TimeoutVoteMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_vote_min"}
+	}
+	if cfg.TimeoutVoteMax < cfg.TimeoutVoteMin {
+		return errors.New("timeout_vote_max must be >= timeout_vote_min")
+	}
 	if cfg.CreateEmptyBlocksInterval < 0 {
 		return cmterrors.ErrNegativeField{Field: "create_empty_blocks_interval"}
 	}
@@ -1333,6 +1576,9 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.DoubleSignCheckHeight < 0 {
 		return cmterrors.ErrNegativeField{Field: "double_sign_check_height"}
 	}
+	if cfg.RoundTraceHeights < 0 {
+		return cmterrors.ErrNegativeField{Field: "round_trace_heights"}
+	}
 	return nil
 }
 
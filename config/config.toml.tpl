@@ -61,10 +61,24 @@ priv_validator_key_file = "{{ js .BaseConfig.PrivValidatorKey }}"
 # Path to the JSON file containing the last sign state of a validator
 priv_validator_state_file = "{{ js .BaseConfig.PrivValidatorState }}"
 
+# If true, every vote and proposal signed by the local private validator is
+# recorded in the slashing protection database (slashing_protection.db in
+# db_dir) and checked against it before signing. Unlike the last sign state,
+# the signing history can be exported and imported with the
+# "cometbft slashing-protection" commands when moving a validator.
+priv_validator_slashing_protection = {{ .BaseConfig.PrivValidatorSlashingProtection }}
+
 # TCP or UNIX socket address for CometBFT to listen on for
-# connections from an external PrivValidator process
+# connections from an external PrivValidator process.
+# With a comma-separated list of addresses, every request is sent to all
+# the external processes, and a signature is only used once
+# priv_validator_threshold of them returned the same one.
 priv_validator_laddr = "{{ .BaseConfig.PrivValidatorListenAddr }}"
 
+# Number of external PrivValidator processes that must agree on a signature
+# when several addresses are set in priv_validator_laddr. 0 means a majority.
+priv_validator_threshold = {{ .BaseConfig.PrivValidatorThreshold }}
+
 # Path to the JSON file containing the private key to use for node authentication in the p2p protocol
 node_key_file = "{{ js .BaseConfig.NodeKey }}"
 
@@ -221,6 +235,17 @@ enabled = {{ .GRPC.BlockService.Enabled }}
 [grpc.block_results_service]
 enabled = {{ .GRPC.BlockResultsService.Enabled }}
 
+# The gRPC mempool service allows submitting transactions, querying the
+# transactions in the mempool and the size of its lanes, and streaming the new
+# transactions added to the mempool.
+[grpc.mempool_service]
+enabled = {{ .GRPC.MempoolService.Enabled }}
+
+# The gRPC evidence service returns the pending evidence, whether evidence has
+# been committed, and the committed evidence of misbehavior of a validator.
+[grpc.evidence_service]
+enabled = {{ .GRPC.EvidenceService.Enabled }}
+
 #
 # Configuration for privileged gRPC endpoints, which should **never** be exposed
 # to the public internet.
@@ -255,6 +280,15 @@ laddr = "{{ .P2P.ListenAddress }}"
 # address. IP and port are required. Example: 159.89.10.97:26656
 external_address = "{{ .P2P.ExternalAddress }}"
 
+# Transport to connect to peers with:
+#   1) "tcp" (default) - multiplexes all the channels over a single TCP
+#   connection.
+#   2) "quic" - sends each channel over a QUIC stream of its own, so that large
+#   messages, like block parts, don't hold up the others, like votes. The node
+#   listens on the UDP port of laddr, and also accepts TCP connections on its
+#   TCP port, falling back to TCP for the peers that don't accept QUIC.
+transport = "{{ .P2P.Transport }}"
+
 # Comma separated list of seed nodes to connect to
 seeds = "{{ .P2P.Seeds }}"
 
@@ -319,9 +353,12 @@ dial_timeout = "{{ .P2P.DialTimeout }}"
 # The type of mempool for this node to use.
 #
 #  Possible types:
-#  - "flood" : concurrent linked list mempool with flooding gossip protocol
+#  - "flood"    : concurrent linked list mempool with flooding gossip protocol
 #  (default)
-#  - "nop"   : nop-mempool (short for no operation; the ABCI app is responsible
+#  - "priority" : mempool ordering txs by the priority returned by the app in
+#  CheckTx, keeping txs of the same sender ordered by nonce; uses the same
+#  flooding gossip protocol as "flood"
+#  - "nop"      : nop-mempool (short for no operation; the ABCI app is responsible
 #  for storing, disseminating and proposing txs). "create_empty_blocks=false" is
 #  not supported.
 type = "{{ .Mempool.Type }}"
@@ -346,6 +383,19 @@ recheck_timeout = "{{ .Mempool.RecheckTimeout }}"
 # the tx to will see it until it is included in a block.
 broadcast = {{ .Mempool.Broadcast }}
 
+# experimental_gossip_protocol defines how transactions are relayed to peers:
+#   - "push" (default): full transactions are sent to every peer.
+#   - "pull": only the keys of new transactions are announced to peers, which
+#     then request the transactions they don't have. It is only used with peers
+#     that also support it; for other peers, "push" is used.
+experimental_gossip_protocol = "{{ .Mempool.ExperimentalGossipProtocol }}"
+
+# Directory of the mempool journal, where the transactions added to and removed
+# from the mempool are recorded, so that the transactions left in the mempool
+# are restored when the node restarts. If empty, the journal is disabled. Only
+# supported by the "flood" mempool.
+wal_dir = "{{ js .Mempool.WalPath }}"
+
 # Maximum number of transactions in the mempool
 size = {{ .Mempool.Size }}
 
@@ -366,6 +416,30 @@ cache_size = {{ .Mempool.CacheSize }}
 # again in the future.
 keep-invalid-txs-in-cache = {{ .Mempool.KeepInvalidTxsInCache }}
 
+# ttl_duration, if non-zero, defines the maximum amount of time a transaction
+# can stay in the mempool. Expired transactions are removed when a new block is
+# committed.
+ttl_duration = "{{ .Mempool.TTLDuration }}"
+
+# ttl_num_blocks, if non-zero, defines the maximum number of blocks a
+# transaction can stay in the mempool. If both ttl_duration and ttl_num_blocks
+# are set, a transaction is removed as soon as any of them is exceeded.
+ttl_num_blocks = {{ .Mempool.TTLNumBlocks }}
+
+# max_txs_per_peer and max_txs_bytes_per_peer, if non-zero, define the maximum
+# number of transactions, and their total size in bytes, that a single peer can
+# contribute to the mempool. A peer that repeatedly exceeds its quota is
+# disconnected. Only supported by the "flood" mempool.
+max_txs_per_peer = {{ .Mempool.MaxTxsPerPeer }}
+max_txs_bytes_per_peer = {{ .Mempool.MaxTxsBytesPerPeer }}
+
+# max_txs_per_sender and max_txs_bytes_per_sender, if non-zero, define the
+# maximum number of transactions, and their total size in bytes, with the same
+# sender, as returned by the application in CheckTx. Only supported by the
+# "flood" mempool.
+max_txs_per_sender = {{ .Mempool.MaxTxsPerSender }}
+max_txs_bytes_per_sender = {{ .Mempool.MaxTxsBytesPerSender }}
+
 # Experimental parameters to limit gossiping txs to up to the specified number of peers.
 # We use two independent upper values for persistent and non-persistent peers.
 # Unconditional peers are not affected by this feature.
@@ -386,6 +460,12 @@ experimental_max_gossip_connections_to_non_persistent_peers = {{ .Mempool.Experi
 # Use this feature with caution and consider the impact on transaction processing performance.
 experimental_publish_event_pending_tx = {{ .Mempool.ExperimentalPublishEventPendingTx }}
 
+# ExperimentalPublishEventDroppedTx enables publishing `TxRejected`, `TxEvicted` and `TxRecheckFailed` events
+# when a transaction is not added to, or is removed from, the mempool without being committed.
+# Note: These events are published while processing CheckTx responses, so a flood of invalid transactions
+# results in as many events. Use this feature with caution and consider the impact on transaction processing performance.
+experimental_publish_event_dropped_tx = {{ .Mempool.ExperimentalPublishEventDroppedTx }}
+
 #######################################################
 ###         State Sync Configuration Options        ###
 #######################################################
@@ -408,6 +488,11 @@ trust_height = {{ .StateSync.TrustHeight }}
 trust_hash = "{{ .StateSync.TrustHash }}"
 trust_period = "{{ .StateSync.TrustPeriod }}"
 
+# Peers (comma-separated node IDs) to use instead of rpc_servers for light client verification
+# and retrieval of state data, over the p2p network. At least two are required, and they must be
+# reachable, e.g. listed in p2p.persistent_peers.
+provider_peers = "{{ StringsJoin .StateSync.ProviderPeers "," }}"
+
 # Time to spend discovering snapshots before switching to blocksync. If set to
 # 0, state sync will be trying indefinitely.
 max_discovery_time = "{{ .StateSync.MaxDiscoveryTime }}"
@@ -436,6 +521,12 @@ chunk_fetchers = "{{ .StateSync.ChunkFetchers }}"
 #   1) "v0" - the default block sync implementation
 version = "{{ .BlockSync.Version }}"
 
+# Directory of block archives, as written by the export-blocks command, to sync
+# blocks from before fetching the remaining ones from peers. Blocks read from
+# the archives are verified as if they were received from peers.
+# Relative paths are prefixed with the CometBFT home directory. Empty disables it.
+archive_dir = "{{ js .BlockSync.ArchivePath }}"
+
 #######################################################
 ###         Consensus Configuration Options         ###
 #######################################################
@@ -454,6 +545,18 @@ timeout_vote_delta = "{{ .Consensus.TimeoutVoteDelta }}"
 # Deprecated: use `next_block_delay` in the ABCI application's `FinalizeBlockResponse`.
 timeout_commit = "{{ .Consensus.TimeoutCommit }}"
 
+# Adapt timeout_propose and timeout_vote to the network latency: the timeouts
+# are derived from the block times and precommit timestamps in the commits of
+# the last adaptive_timeouts_window heights, and kept within the bounds below.
+# Validators with the same settings derive the same timeouts. The timeouts only
+# change between heights, and the *_delta values are still added at each round.
+adaptive_timeouts = {{ .Consensus.AdaptiveTimeouts }}
+adaptive_timeouts_window = {{ .Consensus.AdaptiveTimeoutsWindow }}
+timeout_propose_min = "{{ .Consensus.TimeoutProposeMin }}"
+timeout_propose_max = "{{ .Consensus.TimeoutProposeMax }}"
+timeout_vote_min = "{{ .Consensus.TimeoutVoteMin }}"
+timeout_vote_max = "{{ .Consensus.TimeoutVoteMax }}"
+
 # How many blocks to look back to check existence of the node's consensus votes before joining consensus
 # When non-zero, the node will panic upon restart
 # if the same consensus key was used to sign {double_sign_check_height} last blocks.
@@ -469,6 +572,12 @@ peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_gossip_intraloop_sleep_duration = "{{ .Consensus.PeerGossipIntraloopSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# Number of most recent heights for which every step transition, timeout,
+# proposal and vote is recorded, along with the peer it was received from and
+# the local receive time. The trace of a height is served by the
+# /consensus_trace RPC endpoint. 0 disables the recorder.
+round_trace_heights = {{ .Consensus.RoundTraceHeights }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/config"
+	cmterrors "github.com/cometbft/cometbft/types/errors"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -136,6 +137,14 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		require.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	// tamper with transport
+	cfg.Transport = "invalid"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Transport = config.P2PTransportQUIC
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.Transport = config.P2PTransportTCP
+	require.NoError(t, cfg.ValidateBasic())
 }
 
 func TestMempoolConfigValidateBasic(t *testing.T) {
@@ -145,8 +154,33 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 	// tamper with type
 	reflect.ValueOf(cfg).Elem().FieldByName("Type").SetString("invalid")
 	require.Error(t, cfg.ValidateBasic())
+	reflect.ValueOf(cfg).Elem().FieldByName("Type").SetString(config.MempoolTypePriority)
+	require.NoError(t, cfg.ValidateBasic())
 	reflect.ValueOf(cfg).Elem().FieldByName("Type").SetString(config.MempoolTypeFlood)
 
+	// tamper with gossip protocol
+	cfg.ExperimentalGossipProtocol = "invalid"
+	require.Error(t, cfg.ValidateBasic())
+	cfg.ExperimentalGossipProtocol = config.MempoolGossipProtocolPull
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.ExperimentalGossipProtocol = config.MempoolGossipProtocolPush
+
+	// the journal is only supported by the flood mempool
+	cfg.WalPath = "data/mempool.wal"
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.Type = config.MempoolTypePriority
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Type = config.MempoolTypeFlood
+	cfg.WalPath = ""
+
+	// quotas are only supported by the flood mempool
+	cfg.MaxTxsPerSender = 10
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.Type = config.MempoolTypePriority
+	require.Error(t, cfg.ValidateBasic())
+	cfg.Type = config.MempoolTypeFlood
+	cfg.MaxTxsPerSender = 0
+
 	setFieldTo := func(fieldName string, value int64) {
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(value)
 	}
@@ -163,6 +197,12 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		{"MaxTxBytes", []int64{1}, []int64{-1, 0}},
 		{"ExperimentalMaxGossipConnectionsToPersistentPeers", []int64{0, 1}, []int64{-1}},
 		{"ExperimentalMaxGossipConnectionsToNonPersistentPeers", []int64{0, 1}, []int64{-1}},
+		{"TTLDuration", []int64{0, 1}, []int64{-1}},
+		{"TTLNumBlocks", []int64{0, 1}, []int64{-1}},
+		{"MaxTxsPerPeer", []int64{0, 1}, []int64{-1}},
+		{"MaxTxsBytesPerPeer", []int64{0, 1}, []int64{-1}},
+		{"MaxTxsPerSender", []int64{0, 1}, []int64{-1}},
+		{"MaxTxsBytesPerSender", []int64{0, 1}, []int64{-1}},
 	}
 	for _, field := range fields2values {
 		for _, value := range field.AllowedValues {
@@ -178,6 +218,11 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		}
 	}
 
+	// quotas are not supported by the noop mempool
+	for _, name := range []string{"MaxTxsPerPeer", "MaxTxsBytesPerPeer", "MaxTxsPerSender", "MaxTxsBytesPerSender"} {
+		setFieldTo(name, 0)
+	}
+
 	// with noop mempool, zero values are allowed for the fields below
 	reflect.ValueOf(cfg).Elem().FieldByName("Type").SetString(config.MempoolTypeNop)
 	fieldNames := []string{
@@ -195,6 +240,19 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := config.TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.Enable = true
+	cfg.TrustHeight = 1
+	cfg.TrustHash = "0123456789abcdef"
+	require.ErrorIs(t, cfg.ValidateBasic(), cmterrors.ErrRequiredField{Field: "rpc_servers"})
+
+	// provider_peers replace rpc_servers
+	cfg.ProviderPeers = []string{"first_peer"}
+	require.ErrorIs(t, cfg.ValidateBasic(), config.ErrNotEnoughProviderPeers)
+	cfg.ProviderPeers = []string{"first_peer", ""}
+	require.ErrorIs(t, cfg.ValidateBasic(), config.ErrEmptyProviderPeerEntry)
+	cfg.ProviderPeers = []string{"first_peer", "second_peer"}
+	require.NoError(t, cfg.ValidateBasic())
 }
 
 func TestBlockSyncConfigValidateBasic(t *testing.T) {
@@ -230,6 +288,13 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"PeerQueryMaj23SleepDuration":          {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *config.ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *config.ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
+		"AdaptiveTimeouts":                     {func(c *config.ConsensusConfig) { c.AdaptiveTimeouts = true }, false},
+		"AdaptiveTimeoutsWindow zero":          {func(c *config.ConsensusConfig) { c.AdaptiveTimeouts, c.AdaptiveTimeoutsWindow = true, 0 }, true},
+		"AdaptiveTimeoutsWindow negative":      {func(c *config.ConsensusConfig) { c.AdaptiveTimeoutsWindow = -1 }, true},
+		"TimeoutProposeMin negative":           {func(c *config.ConsensusConfig) { c.TimeoutProposeMin = -1 }, true},
+		"TimeoutProposeMax below min":          {func(c *config.ConsensusConfig) { c.TimeoutProposeMax = c.TimeoutProposeMin - 1 }, true},
+		"TimeoutVoteMin negative":              {func(c *config.ConsensusConfig) { c.TimeoutVoteMin = -1 }, true},
+		"TimeoutVoteMax below min":             {func(c *config.ConsensusConfig) { c.TimeoutVoteMax = c.TimeoutVoteMin - 1 }, true},
 	}
 	for desc, tc := range testcases {
 		t.Run(desc, func(t *testing.T) {
@@ -272,6 +337,11 @@ func TestStateSyncPossibleMisconfigurations(t *testing.T) {
 	// providing rpc_servers while enable = false is a possible misconfiguration
 	cfg.RPCServers = []string{"first_rpc"}
 	require.Equal(t, []string{"rpc_servers specified but enable = false"}, cfg.PossibleMisconfigurations())
+	cfg.ProviderPeers = []string{"first_peer"}
+	require.Equal(t, []string{
+		"rpc_servers specified but enable = false",
+		"provider_peers specified but enable = false",
+	}, cfg.PossibleMisconfigurations())
 	// enabling statesync deletes possible misconfiguration
 	cfg.Enable = true
 	require.Len(t, cfg.PossibleMisconfigurations(), 0)
@@ -8,6 +8,8 @@ import (
 var (
 	ErrEmptyRPCServerEntry             = errors.New("found empty rpc_servers entry")
 	ErrNotEnoughRPCServers             = errors.New("at least two rpc_servers entries are required")
+	ErrEmptyProviderPeerEntry          = errors.New("found empty provider_peers entry")
+	ErrNotEnoughProviderPeers          = errors.New("at least two provider_peers entries are required")
 	ErrInsufficientChunkRequestTimeout = errors.New("timeout for re-requesting a chunk (chunk_request_timeout) is less than 5 seconds")
 	ErrUnknownLogFormat                = errors.New("unknown log_format (must be 'plain' or 'json')")
 	ErrSubscriptionBufferSizeInvalid   = fmt.Errorf("experimental_subscription_buffer_size must be >= %d", minSubscriptionBufferSize)
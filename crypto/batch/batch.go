@@ -2,15 +2,25 @@ package batch
 
 import (
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 )
 
 // CreateBatchVerifier checks if a key type implements the batch verifier interface.
-// Currently only ed25519 supports batch verification.
+// Currently ed25519, secp256k1 and, if enabled, bls12381 support batch
+// verification.
 func CreateBatchVerifier(pk crypto.PubKey) (crypto.BatchVerifier, bool) {
 	switch pk.Type() {
 	case ed25519.KeyType:
 		return ed25519.NewBatchVerifier(), true
+	case secp256k1.KeyType:
+		return secp256k1.NewBatchVerifier(), true
+	case bls12381.KeyType:
+		if !bls12381.Enabled {
+			return nil, false
+		}
+		return bls12381.NewBatchVerifier(), true
 	default:
 		return nil, false
 	}
@@ -24,8 +34,10 @@ func SupportsBatchVerifier(pk crypto.PubKey) bool {
 	}
 
 	switch pk.Type() {
-	case ed25519.KeyType:
+	case ed25519.KeyType, secp256k1.KeyType:
 		return true
+	case bls12381.KeyType:
+		return bls12381.Enabled
 	default:
 		return false
 	}
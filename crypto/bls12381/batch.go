@@ -0,0 +1,29 @@
+//go:build !bls12381
+
+package bls12381
+
+import (
+	"github.com/cometbft/cometbft/crypto"
+)
+
+// Compile-time type assertion.
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier represents a BLS batch verifier noop when blst is not set as a
+// build flag and cgo is disabled.
+type BatchVerifier struct{}
+
+// NewBatchVerifier always panics.
+func NewBatchVerifier() crypto.BatchVerifier {
+	panic("bls12_381 is disabled")
+}
+
+// Add always panics.
+func (*BatchVerifier) Add(crypto.PubKey, []byte, []byte) error {
+	panic("bls12_381 is disabled")
+}
+
+// Verify always panics.
+func (*BatchVerifier) Verify() (bool, []bool) {
+	panic("bls12_381 is disabled")
+}
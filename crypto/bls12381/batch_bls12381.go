@@ -0,0 +1,105 @@
+//go:build bls12381
+
+package bls12381
+
+import (
+	"errors"
+
+	blst "github.com/supranational/blst/bindings/go"
+
+	"github.com/cometbft/cometbft/crypto"
+)
+
+var (
+	// ErrNotBLS12381Key is returned when a key of another type is added to the
+	// BatchVerifier.
+	ErrNotBLS12381Key = errors.New("bls12381: pubkey is not BLS12-381")
+	// ErrInvalidSignature is returned when a signature added to the
+	// BatchVerifier can't be decompressed or fails the group check.
+	ErrInvalidSignature = errors.New("bls12381: invalid signature")
+)
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier implements batch verification for BLS12-381, by aggregating
+// the signatures and verifying the aggregate signature against the messages
+// and public keys, which takes n+1 pairings instead of 2n.
+//
+// Aggregate verification is only secure over distinct messages (otherwise
+// rogue public keys could forge a valid aggregate), so the signatures are
+// verified one by one if the same message is added twice.
+type BatchVerifier struct {
+	pubKeys    []*blstPublicKey
+	msgs       []blst.Message
+	signatures []*blstSignature
+
+	msgSet       map[string]struct{}
+	hasDuplicate bool
+}
+
+// NewBatchVerifier creates a new BatchVerifier.
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{msgSet: make(map[string]struct{})}
+}
+
+// Add implements crypto.BatchVerifier.
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	// Keys decoded from protobuf are pointers, see NewPublicKeyFromBytes.
+	var pk *blstPublicKey
+	switch k := key.(type) {
+	case PubKey:
+		pk = k.pk
+	case *PubKey:
+		pk = k.pk
+	default:
+		return ErrNotBLS12381Key
+	}
+
+	sig := new(blstSignature).Uncompress(signature)
+	if sig == nil {
+		return ErrInvalidSignature
+	}
+	// Group check signature. Do not check for infinity, like VerifySignature.
+	if !sig.SigValidate(false) {
+		return ErrInvalidSignature
+	}
+
+	if _, ok := b.msgSet[string(msg)]; ok {
+		b.hasDuplicate = true
+	}
+	b.msgSet[string(msg)] = struct{}{}
+
+	b.pubKeys = append(b.pubKeys, pk)
+	b.msgs = append(b.msgs, msg)
+	b.signatures = append(b.signatures, sig)
+	return nil
+}
+
+// Verify implements crypto.BatchVerifier. If the aggregate signature is
+// invalid, the signatures are verified one by one to find the invalid ones.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	n := len(b.signatures)
+	if n == 0 {
+		return false, nil
+	}
+
+	valid := make([]bool, n)
+	if !b.hasDuplicate {
+		// Signatures were group checked in Add, and public keys when created.
+		agg := new(blst.P2Aggregate)
+		if agg.Aggregate(b.signatures, false) &&
+			agg.ToAffine().AggregateVerify(false, b.pubKeys, false, b.msgs, dstMinSig) {
+			for i := range valid {
+				valid[i] = true
+			}
+			return true, valid
+		}
+	}
+
+	allValid := true
+	for i, sig := range b.signatures {
+		valid[i] = sig.Verify(false, b.pubKeys[i], false, b.msgs[i], dstMinSig)
+		allValid = allValid && valid[i]
+	}
+	return allValid, valid
+}
@@ -0,0 +1,113 @@
+//go:build bls12381
+
+package bls12381_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+)
+
+func TestBatchVerifier(t *testing.T) {
+	testCases := map[string]struct {
+		msg     func(i int) []byte
+		corrupt bool
+	}{
+		"distinct messages":           {func(i int) []byte { return []byte{byte(i)} }, false},
+		"distinct messages, invalid":  {func(i int) []byte { return []byte{byte(i)} }, true},
+		"duplicate messages":          {func(int) []byte { return []byte("msg") }, false},
+		"duplicate messages, invalid": {func(int) []byte { return []byte("msg") }, true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			const n = 10
+			v := bls12381.NewBatchVerifier()
+			for i := 0; i < n; i++ {
+				priv, err := bls12381.GenPrivKey()
+				require.NoError(t, err)
+				msg := tc.msg(i)
+				// Sign another message, to keep the signature well-formed.
+				if tc.corrupt && i == n-1 {
+					msg = []byte("corrupt")
+				}
+				sig, err := priv.Sign(msg)
+				require.NoError(t, err)
+				require.NoError(t, v.Add(priv.PubKey(), tc.msg(i), sig))
+			}
+
+			ok, valid := v.Verify()
+			assert.Equal(t, !tc.corrupt, ok)
+			require.Len(t, valid, n)
+			for i := 0; i < n-1; i++ {
+				assert.True(t, valid[i], "signature %d", i)
+			}
+			assert.Equal(t, !tc.corrupt, valid[n-1])
+		})
+	}
+}
+
+func TestBatchVerifier_Add(t *testing.T) {
+	v := bls12381.NewBatchVerifier()
+	ok, valid := v.Verify()
+	require.False(t, ok, "empty batch")
+	require.Empty(t, valid)
+
+	priv, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	sig, err := priv.Sign([]byte("msg"))
+	require.NoError(t, err)
+
+	require.ErrorIs(t, v.Add(ed25519.GenPrivKey().PubKey(), []byte("msg"), sig), bls12381.ErrNotBLS12381Key)
+	require.ErrorIs(t, v.Add(priv.PubKey(), []byte("msg"), sig[:len(sig)-1]), bls12381.ErrInvalidSignature)
+	require.NoError(t, v.Add(priv.PubKey(), []byte("msg"), sig))
+
+	// Keys decoded from bytes are pointers.
+	pubKey, err := bls12381.NewPublicKeyFromBytes(priv.PubKey().Bytes())
+	require.NoError(t, err)
+	require.NoError(t, v.Add(pubKey, []byte("msg2"), sig))
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	for _, sigsCount := range []int{1, 8, 64, 1024} {
+		b.Run(fmt.Sprintf("sig-count-%d", sigsCount), func(b *testing.B) {
+			// Pre-generate all of the keys, and signatures, but do not
+			// benchmark key-generation and signing. Messages are distinct,
+			// like the vote sign bytes of a commit.
+			pubs := make([]crypto.PubKey, 0, sigsCount)
+			msgs := make([][]byte, 0, sigsCount)
+			sigs := make([][]byte, 0, sigsCount)
+			for i := 0; i < sigsCount; i++ {
+				priv, err := bls12381.GenPrivKey()
+				require.NoError(b, err)
+				msg := []byte(fmt.Sprintf("BatchVerifyTest-%d", i))
+				sig, err := priv.Sign(msg)
+				require.NoError(b, err)
+				pubs = append(pubs, priv.PubKey())
+				msgs = append(msgs, msg)
+				sigs = append(sigs, sig)
+			}
+			b.ResetTimer()
+
+			b.ReportAllocs()
+			// NOTE: dividing by n so that metrics are per-signature
+			for i := 0; i < b.N/sigsCount; i++ {
+				v := bls12381.NewBatchVerifier()
+				for i := 0; i < sigsCount; i++ {
+					err := v.Add(pubs[i], msgs[i], sigs[i])
+					require.NoError(b, err)
+				}
+
+				if ok, _ := v.Verify(); !ok {
+					b.Fatal("signature set failed batch verification")
+				}
+			}
+		})
+	}
+}
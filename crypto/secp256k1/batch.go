@@ -0,0 +1,90 @@
+package secp256k1
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/cometbft/cometbft/crypto"
+)
+
+var (
+	ErrNotSecp256k1Key  = errors.New("secp256k1: pubkey is not secp256k1")
+	ErrInvalidPubKey    = errors.New("secp256k1: invalid pubkey")
+	ErrInvalidSignature = errors.New("secp256k1: invalid signature")
+)
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier implements batch verification for secp256k1.
+//
+// ECDSA signatures can't be verified as a batch like Ed25519 ones, so the
+// signatures are verified concurrently instead, one per CPU at a time. Public
+// keys are parsed when added, so invalid entries fail early.
+type BatchVerifier struct {
+	entries []batchEntry
+}
+
+type batchEntry struct {
+	pubKey    *secp256k1.PublicKey
+	msg       []byte
+	signature []byte
+}
+
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add implements crypto.BatchVerifier.
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	pkSecp, ok := key.(PubKey)
+	if !ok {
+		return ErrNotSecp256k1Key
+	}
+
+	pub, err := secp256k1.ParsePubKey(pkSecp)
+	if err != nil {
+		return ErrInvalidPubKey
+	}
+
+	// check that the signature is the correct length
+	if len(signature) != 64 {
+		return ErrInvalidSignature
+	}
+
+	b.entries = append(b.entries, batchEntry{pubKey: pub, msg: msg, signature: signature})
+	return nil
+}
+
+// Verify implements crypto.BatchVerifier.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	if len(b.entries) == 0 {
+		return false, nil
+	}
+
+	var (
+		valid   = make([]bool, len(b.entries))
+		workers = min(runtime.GOMAXPROCS(0), len(b.entries))
+		wg      sync.WaitGroup
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(b.entries); i += workers {
+				e := b.entries[i]
+				valid[i] = verifySignature(e.pubKey, e.msg, e.signature)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, v := range valid {
+		if !v {
+			return false, valid
+		}
+	}
+	return true, valid
+}
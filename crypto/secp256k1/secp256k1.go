@@ -195,6 +195,12 @@ func (pubKey PubKey) VerifySignature(msg []byte, sigStr []byte) bool {
 		return false
 	}
 
+	return verifySignature(pub, msg, sigStr)
+}
+
+// verifySignature verifies a signature of the form R || S against a parsed
+// public key. Caller needs to ensure that len(sigStr) == 64.
+func verifySignature(pub *secp256k1.PublicKey, msg []byte, sigStr []byte) bool {
 	// parse the signature:
 	signature := signatureFromBytes(sigStr)
 	// Reject malleable signatures. libsecp256k1 does this check but decred doesn't.
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 )
 
@@ -123,3 +124,36 @@ func TestGenPrivKeySecp256k1(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchVerifier(t *testing.T) {
+	v := secp256k1.NewBatchVerifier()
+	ok, valid := v.Verify()
+	require.False(t, ok, "empty batch")
+	require.Empty(t, valid)
+
+	const n = 10
+	for i := 0; i < n; i++ {
+		priv := secp256k1.GenPrivKey()
+		msg := []byte{byte(i)}
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		// Corrupt the last signature.
+		if i == n-1 {
+			sig[0] ^= 0xff
+		}
+		require.NoError(t, v.Add(priv.PubKey(), msg, sig))
+	}
+
+	ok, valid = v.Verify()
+	assert.False(t, ok)
+	require.Len(t, valid, n)
+	for i := 0; i < n-1; i++ {
+		assert.True(t, valid[i], "signature %d", i)
+	}
+	assert.False(t, valid[n-1])
+
+	priv := secp256k1.GenPrivKey()
+	require.ErrorIs(t, v.Add(ed25519.GenPrivKey().PubKey(), []byte("msg"), make([]byte, 64)), secp256k1.ErrNotSecp256k1Key)
+	require.ErrorIs(t, v.Add(secp256k1.PubKey{0x01}, []byte("msg"), make([]byte, 64)), secp256k1.ErrInvalidPubKey)
+	require.ErrorIs(t, v.Add(priv.PubKey(), []byte("msg"), make([]byte, 63)), secp256k1.ErrInvalidSignature)
+}
@@ -181,14 +181,25 @@ func (g *Group) TotalSizeLimit() int64 {
 
 // MaxIndex returns index of the last file in the group.
 func (g *Group) MaxIndex() int {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
 	return g.maxIndex
 }
 
 // MinIndex returns index of the first file in the group.
 func (g *Group) MinIndex() int {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
 	return g.minIndex
 }
 
+// IndexPath returns the path of the file with the given index in the group.
+func (g *Group) IndexPath(index int) string {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return filePathForIndex(g.Head.Path, index, g.maxIndex)
+}
+
 // Write writes the contents of p into the current head of the group. It
 // returns the number of bytes written. If nn < len(p), it also returns an
 // error explaining why the write is short.
@@ -317,6 +328,23 @@ func (g *Group) RotateFile() {
 	g.maxIndex++
 }
 
+// RemoveFilesBefore removes all the files of the group with an index lower than
+// the given one. The head is never removed.
+func (g *Group) RemoveFilesBefore(index int) error {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	gInfo := g.readGroupInfo()
+	for i := gInfo.MinIndex; i < index && i < gInfo.MaxIndex; i++ {
+		path := filePathForIndex(g.Head.Path, i, gInfo.MaxIndex)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		g.minIndex = i + 1
+	}
+	return nil
+}
+
 // NewReader returns a new group reader.
 // CONTRACT: Caller must close the returned GroupReader.
 func (g *Group) NewReader(index int) (*GroupReader, error) {
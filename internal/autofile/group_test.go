@@ -291,3 +291,44 @@ func TestMaxIndex(t *testing.T) {
 	// Cleanup
 	destroyTestGroup(t, g)
 }
+
+func TestRemoveFilesBefore(t *testing.T) {
+	g := createTestGroupWithHeadSizeLimit(t, 0)
+
+	for i := 0; i < 3; i++ {
+		err := g.WriteLine("Line")
+		require.NoError(t, err)
+		err = g.FlushAndSync()
+		require.NoError(t, err)
+		g.RotateFile()
+	}
+	err := g.WriteLine("Head")
+	require.NoError(t, err)
+	err = g.FlushAndSync()
+	require.NoError(t, err)
+
+	err = g.RemoveFilesBefore(2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, g.MinIndex())
+	assert.NoFileExists(t, g.Head.Path+".000")
+	assert.NoFileExists(t, g.Head.Path+".001")
+	assert.FileExists(t, g.Head.Path+".002")
+
+	// The head is never removed.
+	err = g.RemoveFilesBefore(10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, g.MinIndex())
+	assert.NoFileExists(t, g.Head.Path+".002")
+	assert.FileExists(t, g.Head.Path)
+
+	// Reading starts from the head.
+	gr, err := g.NewReader(g.MinIndex())
+	require.NoError(t, err)
+	defer gr.Close()
+	b, err := io.ReadAll(gr)
+	require.NoError(t, err)
+	assert.Equal(t, "Head\n", string(b))
+
+	// Cleanup
+	destroyTestGroup(t, g)
+}
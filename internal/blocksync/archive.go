@@ -0,0 +1,285 @@
+package blocksync
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	bcproto "github.com/cometbft/cometbft/api/cometbft/blocksync/v1"
+	"github.com/cometbft/cometbft/libs/protoio"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// An archive is a directory of gzip-compressed files, each one holding the
+// blocks of a contiguous range of heights. Blocks are encoded as
+// length-delimited BlockResponse messages, i.e. exactly as they are sent to
+// peers, together with their extended commit if vote extensions were enabled
+// at their height. Files are named after the range of heights they hold, so
+// that they can be read in order.
+
+// ArchiveFileExt is the extension of the block archive files.
+const ArchiveFileExt = ".blocks.gz"
+
+// ArchiveFileName returns the name of the archive file holding the blocks
+// from height first to height last (inclusive).
+func ArchiveFileName(first, last int64) string {
+	return fmt.Sprintf("%020d-%020d%s", first, last, ArchiveFileExt)
+}
+
+// ExportBlocks writes the blocks of the store with heights in [from, to] to
+// archive files in dir, holding at most blocksPerFile blocks each. Extended
+// commits are included for the heights at which vote extensions are enabled
+// according to state. It returns the paths of the files written.
+func ExportBlocks(store sm.BlockStore, state sm.State, dir string, from, to, blocksPerFile int64) ([]string, error) {
+	if blocksPerFile <= 0 {
+		return nil, fmt.Errorf("blocks per file must be positive, got %d", blocksPerFile)
+	}
+	if from < store.Base() || to > store.Height() || from > to {
+		return nil, fmt.Errorf("invalid range [%d, %d]: the store has blocks [%d, %d]",
+			from, to, store.Base(), store.Height())
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for first := from; first <= to; first += blocksPerFile {
+		last := min(first+blocksPerFile-1, to)
+		path, err := exportArchiveFile(store, state, dir, first, last)
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// exportArchiveFile writes the blocks [first, last] to a single archive file.
+// The file is written under a temporary name first, so that an interrupted
+// export never leaves a truncated archive behind.
+func exportArchiveFile(store sm.BlockStore, state sm.State, dir string, first, last int64) (string, error) {
+	f, err := os.CreateTemp(dir, ".export-*")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+
+	gz := gzip.NewWriter(f)
+	w := protoio.NewDelimitedWriter(gz)
+	for height := first; height <= last; height++ {
+		msg, err := archiveBlockResponse(store, state, height)
+		if err != nil {
+			return "", err
+		}
+		if _, err := w.WriteMsg(msg); err != nil {
+			return "", err
+		}
+	}
+	if err := gz.Close(); err != nil {
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, ArchiveFileName(first, last))
+	if err := os.Rename(f.Name(), path); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func archiveBlockResponse(store sm.BlockStore, state sm.State, height int64) (*bcproto.BlockResponse, error) {
+	block, _ := store.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("block at height %d not found", height)
+	}
+	var extCommit *types.ExtendedCommit
+	if state.ConsensusParams.Feature.VoteExtensionsEnabled(height) {
+		extCommit = store.LoadBlockExtendedCommit(height)
+		if extCommit == nil {
+			return nil, fmt.Errorf("extended commit at height %d not found", height)
+		}
+	}
+	bl, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return &bcproto.BlockResponse{
+		Block:     bl,
+		ExtCommit: extCommit.ToProto(),
+	}, nil
+}
+
+type archiveFile struct {
+	path        string
+	first, last int64
+}
+
+// listArchiveFiles returns the archive files in dir, sorted by height.
+func listArchiveFiles(dir string) ([]archiveFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []archiveFile
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		first, last, ok := parseArchiveFileName(e.Name())
+		if !ok {
+			continue
+		}
+		files = append(files, archiveFile{path: filepath.Join(dir, e.Name()), first: first, last: last})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].first < files[j].first })
+	return files, nil
+}
+
+func parseArchiveFileName(name string) (first, last int64, ok bool) {
+	heights, found := strings.CutSuffix(name, ArchiveFileExt)
+	if !found {
+		return 0, 0, false
+	}
+	firstStr, lastStr, found := strings.Cut(heights, "-")
+	if !found {
+		return 0, 0, false
+	}
+	first, err := strconv.ParseInt(firstStr, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	last, err = strconv.ParseInt(lastStr, 10, 64)
+	if err != nil || first > last {
+		return 0, 0, false
+	}
+	return first, last, true
+}
+
+// archiveReader reads the blocks of an archive in increasing height order,
+// starting from a given height.
+type archiveReader struct {
+	files  []archiveFile
+	height int64 // height of the next block to return
+	last   int64 // last height in the archive
+
+	file *os.File
+	gz   *gzip.Reader
+	r    protoio.ReadCloser
+}
+
+// newArchiveReader returns a reader of the blocks in dir, starting from the
+// given height.
+func newArchiveReader(dir string, height int64) (*archiveReader, error) {
+	files, err := listArchiveFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	for len(files) > 0 && files[0].last < height {
+		files = files[1:]
+	}
+	r := &archiveReader{files: files, height: height}
+	if len(files) > 0 {
+		r.last = files[len(files)-1].last
+	}
+	return r, nil
+}
+
+// lastHeight returns the last height held by the archive.
+func (r *archiveReader) lastHeight() int64 {
+	return r.last
+}
+
+// next returns the next block and its extended commit, if any. It returns
+// io.EOF once the archive holds no block at the next height.
+func (r *archiveReader) next() (*types.Block, *types.ExtendedCommit, error) {
+	for {
+		if r.r == nil {
+			if err := r.openNextFile(); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		msg := &bcproto.BlockResponse{}
+		if _, err := r.r.ReadMsg(msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				r.closeFile()
+				continue
+			}
+			return nil, nil, fmt.Errorf("reading %s: %w", r.file.Name(), err)
+		}
+		if msg.Block == nil {
+			return nil, nil, fmt.Errorf("reading %s: %w", r.file.Name(), ErrNilMessage)
+		}
+
+		// Files may start before the requested height.
+		if msg.Block.Header.Height < r.height {
+			continue
+		}
+		if msg.Block.Header.Height != r.height {
+			return nil, nil, fmt.Errorf("reading %s: %w", r.file.Name(),
+				ErrInvalidHeight{Height: msg.Block.Header.Height, Reason: fmt.Sprintf("expected %d", r.height)})
+		}
+
+		block, err := types.BlockFromProto(msg.Block)
+		if err != nil {
+			return nil, nil, err
+		}
+		var extCommit *types.ExtendedCommit
+		if msg.ExtCommit != nil {
+			extCommit, err = types.ExtendedCommitFromProto(msg.ExtCommit)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		r.height++
+		return block, extCommit, nil
+	}
+}
+
+// openNextFile opens the next archive file, as long as it holds the next
+// height.
+func (r *archiveReader) openNextFile() error {
+	if len(r.files) == 0 || r.files[0].first > r.height {
+		return io.EOF
+	}
+	af := r.files[0]
+	r.files = r.files[1:]
+
+	f, err := os.Open(af.path)
+	if err != nil {
+		return err
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("reading %s: %w", af.path, err)
+	}
+	r.file, r.gz, r.r = f, gz, protoio.NewDelimitedReader(gz, MaxMsgSize)
+	return nil
+}
+
+func (r *archiveReader) closeFile() {
+	if r.r == nil {
+		return
+	}
+	r.gz.Close()
+	r.file.Close()
+	r.file, r.gz, r.r = nil, nil, nil
+}
+
+// Close closes the archive file being read, if any.
+func (r *archiveReader) Close() {
+	r.closeFile()
+}
@@ -0,0 +1,123 @@
+package blocksync
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+func TestExportBlocks(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_archive_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc()
+
+	pair := newReactor(t, log.TestingLogger(), genDoc, privVals, 30)
+	defer func() {
+		require.NoError(t, pair.app.Stop())
+	}()
+
+	dir := filepath.Join(config.RootDir, "archive")
+	paths, err := ExportBlocks(pair.reactor.store, pair.reactor.initialState, dir, 1, 30, 7)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, ArchiveFileName(1, 7)),
+		filepath.Join(dir, ArchiveFileName(8, 14)),
+		filepath.Join(dir, ArchiveFileName(15, 21)),
+		filepath.Join(dir, ArchiveFileName(22, 28)),
+		filepath.Join(dir, ArchiveFileName(29, 30)),
+	}, paths)
+
+	_, err = ExportBlocks(pair.reactor.store, pair.reactor.initialState, dir, 1, 31, 7)
+	require.Error(t, err)
+
+	r, err := newArchiveReader(dir, 10)
+	require.NoError(t, err)
+	defer r.Close()
+	assert.EqualValues(t, 30, r.lastHeight())
+
+	for height := int64(10); height <= 30; height++ {
+		block, extCommit, err := r.next()
+		require.NoError(t, err)
+		stored, _ := pair.reactor.store.LoadBlock(height)
+		assert.Equal(t, stored.Hash(), block.Hash())
+		require.NotNil(t, extCommit)
+		assert.Equal(t, height, extCommit.Height)
+	}
+	_, _, err = r.next()
+	require.ErrorIs(t, err, io.EOF)
+}
+
+func TestReactorSyncFromArchive(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_archive_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc()
+
+	const archiveHeight = 20
+	source := newReactor(t, log.TestingLogger(), genDoc, privVals, archiveHeight)
+	defer func() {
+		require.NoError(t, source.app.Stop())
+	}()
+	dir := filepath.Join(config.RootDir, "archive")
+	_, err := ExportBlocks(source.reactor.store, source.reactor.initialState, dir, 1, archiveHeight, 8)
+	require.NoError(t, err)
+
+	pair := newReactor(t, log.TestingLogger(), genDoc, privVals, 0)
+	pair.reactor.archiveDir = dir
+	p2p.MakeConnectedSwitches(config.P2P, 1, func(_ int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKSYNC", pair.reactor)
+		return s
+	}, p2p.Connect2Switches)
+	defer func() {
+		require.NoError(t, pair.reactor.Stop())
+		require.NoError(t, pair.app.Stop())
+	}()
+
+	// The last block of the archive can only be verified with the commit in
+	// the next block, which must come from peers.
+	require.Eventually(t, func() bool {
+		return pair.reactor.store.Height() == archiveHeight-1
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Eventually(t, pair.reactor.pool.IsRunning, time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, archiveHeight, pair.reactor.pool.Height())
+}
+
+func TestReactorSyncFromInvalidArchive(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_archive_test")
+	defer os.RemoveAll(config.RootDir)
+
+	// The archive holds the blocks of another chain.
+	otherGenDoc, otherPrivVals := randGenesisDoc()
+	source := newReactor(t, log.TestingLogger(), otherGenDoc, otherPrivVals, 10)
+	defer func() {
+		require.NoError(t, source.app.Stop())
+	}()
+	dir := filepath.Join(config.RootDir, "archive")
+	_, err := ExportBlocks(source.reactor.store, source.reactor.initialState, dir, 1, 10, 10)
+	require.NoError(t, err)
+
+	genDoc, privVals := randGenesisDoc()
+	pair := newReactor(t, log.TestingLogger(), genDoc, privVals, 0)
+	pair.reactor.archiveDir = dir
+	p2p.MakeConnectedSwitches(config.P2P, 1, func(_ int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKSYNC", pair.reactor)
+		return s
+	}, p2p.Connect2Switches)
+	defer func() {
+		require.NoError(t, pair.reactor.Stop())
+		require.NoError(t, pair.app.Stop())
+	}()
+
+	// The reactor gives up on the archive and falls back to its peers.
+	require.Eventually(t, pair.reactor.pool.IsRunning, 10*time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, 0, pair.reactor.store.Height())
+	assert.EqualValues(t, 1, pair.reactor.pool.Height())
+}
@@ -229,6 +229,27 @@ func (pool *BlockPool) PeekTwoBlocks() (first, second *types.Block, firstExtComm
 	return first, second, firstExtCommit
 }
 
+// PeekBlocks returns up to n consecutive blocks, starting at pool.height,
+// that have already been downloaded. The caller will verify the commits.
+func (pool *BlockPool) PeekBlocks(n int) []*types.Block {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+
+	blocks := make([]*types.Block, 0, n)
+	for height := pool.height; len(blocks) < n; height++ {
+		r := pool.requesters[height]
+		if r == nil {
+			break
+		}
+		block := r.getBlock()
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
 // PopRequest removes the requester at pool.height and increments pool.height.
 func (pool *BlockPool) PopRequest() {
 	pool.mtx.Lock()
@@ -341,6 +362,14 @@ func (pool *BlockPool) Height() int64 {
 	return pool.height
 }
 
+// SetHeight sets the height of the next block to request. It must be called
+// before the pool is started.
+func (pool *BlockPool) SetHeight(height int64) {
+	pool.mtx.Lock()
+	defer pool.mtx.Unlock()
+	pool.height = height
+}
+
 // MaxPeerHeight returns the highest reported height.
 func (pool *BlockPool) MaxPeerHeight() int64 {
 	pool.mtx.Lock()
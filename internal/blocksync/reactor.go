@@ -1,8 +1,11 @@
 package blocksync
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"reflect"
+	"runtime"
 	"sync"
 	"time"
 
@@ -31,6 +34,10 @@ const (
 	statusUpdateIntervalSeconds = 10
 	// check if we should switch to consensus reactor.
 	switchToConsensusIntervalSeconds = 1
+
+	// number of downloaded blocks whose commits are verified ahead,
+	// concurrently, of the block being applied.
+	verifyAheadWindow = 32
 )
 
 type consensusReactor interface {
@@ -63,6 +70,7 @@ type Reactor struct {
 	blockExec     *sm.BlockExecutor
 	store         sm.BlockStore
 	pool          *BlockPool
+	verifier      *commitVerifier
 	blockSync     bool
 	localAddr     crypto.Address
 	poolRoutineWg sync.WaitGroup
@@ -72,12 +80,32 @@ type Reactor struct {
 
 	switchToConsensusMs int
 
+	// directory of block archives to sync from before fetching blocks from
+	// peers, if any.
+	archiveDir string
+	// guards starting and stopping the pool, which happen in different
+	// goroutines when syncing from an archive first.
+	poolMtx sync.Mutex
+
 	metrics *Metrics
 }
 
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
+// WithArchiveDir sets the directory of block archives, as written by
+// ExportBlocks, from which the reactor syncs blocks before switching to its
+// peers. Blocks are verified exactly as the blocks received from peers.
+func WithArchiveDir(dir string) ReactorOption {
+	return func(bcR *Reactor) {
+		bcR.archiveDir = dir
+	}
+}
+
 // NewReactor returns new reactor instance.
 func NewReactor(state sm.State, blockExec *sm.BlockExecutor, store *store.BlockStore,
 	blockSync bool, localAddr crypto.Address, metrics *Metrics, offlineStateSyncHeight int64,
+	options ...ReactorOption,
 ) *Reactor {
 	storeHeight := store.Height()
 	if storeHeight == 0 {
@@ -112,12 +140,16 @@ func NewReactor(state sm.State, blockExec *sm.BlockExecutor, store *store.BlockS
 		blockExec:    blockExec,
 		store:        store,
 		pool:         pool,
+		verifier:     newCommitVerifier(state.ChainID, runtime.NumCPU()),
 		blockSync:    blockSync,
 		localAddr:    localAddr,
 		requestsCh:   requestsCh,
 		errorsCh:     errorsCh,
 		metrics:      metrics,
 	}
+	for _, option := range options {
+		option(bcR)
+	}
 	bcR.BaseReactor = *p2p.NewBaseReactor("Reactor", bcR)
 	return bcR
 }
@@ -151,6 +183,15 @@ func (bcR *Reactor) SwitchToBlockSync(state sm.State) error {
 }
 
 func (bcR *Reactor) startPool(stateSynced bool) error {
+	if bcR.archiveDir != "" {
+		bcR.poolRoutineWg.Add(1)
+		go func() {
+			defer bcR.poolRoutineWg.Done()
+			bcR.archiveRoutine(stateSynced)
+		}()
+		return nil
+	}
+
 	err := bcR.pool.Start()
 	if err != nil {
 		return err
@@ -163,12 +204,102 @@ func (bcR *Reactor) startPool(stateSynced bool) error {
 	return nil
 }
 
+// archiveRoutine syncs the blocks of the archive directory, then starts
+// fetching the remaining blocks from peers.
+func (bcR *Reactor) archiveRoutine(stateSynced bool) {
+	state, blocksSynced := bcR.syncFromArchive(bcR.initialState)
+
+	bcR.poolMtx.Lock()
+	defer bcR.poolMtx.Unlock()
+	if !bcR.IsRunning() {
+		return
+	}
+	if blocksSynced > 0 {
+		bcR.initialState = state
+		// Peers may already be reporting their range to the pool.
+		bcR.pool.SetHeight(state.LastBlockHeight + 1)
+	}
+	if err := bcR.pool.Start(); err != nil {
+		bcR.Logger.Error("Error starting pool", "err", err)
+		return
+	}
+	bcR.poolRoutineWg.Add(1)
+	go func() {
+		defer bcR.poolRoutineWg.Done()
+		bcR.poolRoutine(stateSynced || blocksSynced > 0)
+	}()
+}
+
+// syncFromArchive verifies and applies the blocks of the archive directory
+// that follow state, until it runs out of blocks or finds an invalid one. It
+// returns the resulting state and the number of blocks synced.
+func (bcR *Reactor) syncFromArchive(state sm.State) (sm.State, uint64) {
+	bcR.metrics.Syncing.Set(1)
+	defer bcR.metrics.Syncing.Set(0)
+
+	height := state.LastBlockHeight + 1
+	if state.LastBlockHeight == 0 {
+		height = state.InitialHeight
+	}
+	r, err := newArchiveReader(bcR.archiveDir, height)
+	if err != nil {
+		bcR.Logger.Error("Failed to open block archive", "dir", bcR.archiveDir, "err", err)
+		return state, 0
+	}
+	defer r.Close()
+
+	bcR.Logger.Info("Syncing blocks from archive", "dir", bcR.archiveDir, "height", height)
+	var (
+		blocksSynced = uint64(0)
+		lastHundred  = time.Now()
+	)
+	first, extCommit, err := r.next()
+	for err == nil && bcR.IsRunning() {
+		var second *types.Block
+		var secondExtCommit *types.ExtendedCommit
+		second, secondExtCommit, err = r.next()
+		if err != nil {
+			break
+		}
+
+		firstParts, err := first.MakePartSet(types.BlockPartSizeBytes)
+		if err != nil {
+			bcR.Logger.Error("Failed to make part set", "height", first.Height, "err", err)
+			break
+		}
+		firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstParts.Header()}
+		if err := bcR.verifyBlock(first, second, firstID, state, extCommit); err != nil {
+			bcR.Logger.Error("Invalid block in archive", "height", first.Height, "err", err)
+			break
+		}
+		state = bcR.saveAndApplyBlock(first, second, firstParts, firstID, state, extCommit, r.lastHeight())
+
+		blocksSynced++
+		if blocksSynced%100 == 0 {
+			bcR.Logger.Info("Block Sync Rate", "height", first.Height, "archive_height", r.lastHeight(),
+				"blocks/s", 100/time.Since(lastHundred).Seconds())
+			lastHundred = time.Now()
+		}
+		first, extCommit = second, secondExtCommit
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		bcR.Logger.Error("Failed to read block archive", "dir", bcR.archiveDir, "err", err)
+	}
+
+	bcR.Logger.Info("Synced blocks from archive", "blocks", blocksSynced, "height", state.LastBlockHeight)
+	return state, blocksSynced
+}
+
 // OnStop implements service.Service.
 func (bcR *Reactor) OnStop() {
 	if bcR.blockSync {
-		if err := bcR.pool.Stop(); err != nil {
-			bcR.Logger.Error("Error stopping pool", "err", err)
+		bcR.poolMtx.Lock()
+		if bcR.pool.IsRunning() {
+			if err := bcR.pool.Stop(); err != nil {
+				bcR.Logger.Error("Error stopping pool", "err", err)
+			}
 		}
+		bcR.poolMtx.Unlock()
 		bcR.poolRoutineWg.Wait()
 	}
 }
@@ -368,6 +499,10 @@ FOR_LOOP:
 			// coupling them as it's written here.  TODO uncouple from request
 			// routine.
 
+			// Verify the commits of the next downloaded blocks in the
+			// background, while blocks are applied one at a time.
+			bcR.verifier.verifyAhead(bcR.pool.PeekBlocks(verifyAheadWindow+1), state)
+
 			// See if there are any blocks to sync.
 			first, second, extCommit := bcR.pool.PeekTwoBlocks()
 			if first == nil || second == nil {
@@ -395,7 +530,7 @@ FOR_LOOP:
 			// Try again quickly next loop.
 			didProcessCh <- struct{}{}
 
-			firstParts, err := first.MakePartSet(types.BlockPartSizeBytes)
+			firstParts, err := bcR.verifier.partSet(first, second)
 			if err != nil {
 				bcR.Logger.Error("failed to make ",
 					"height", first.Height,
@@ -530,19 +665,44 @@ func (bcR *Reactor) isCaughtUp(state sm.State, blocksSynced uint64, stateSynced
 }
 
 func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.PartSet, state sm.State, extCommit *types.ExtendedCommit) (sm.State, error) {
-	var (
-		chainID            = bcR.initialState.ChainID
-		firstPartSetHeader = firstParts.Header()
-		firstID            = types.BlockID{Hash: first.Hash(), PartSetHeader: firstPartSetHeader}
-	)
+	firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstParts.Header()}
+
+	if err := bcR.verifyBlock(first, second, firstID, state, extCommit); err != nil {
+		peerID := bcR.pool.RemovePeerAndRedoAllPeerRequests(first.Height)
+		peer := bcR.Switch.Peers().Get(peerID)
+		if peer != nil {
+			// NOTE: we've already removed the peer's request, but we
+			// still need to clean up the rest.
+			bcR.Switch.StopPeerForError(peer, ErrReactorValidation{Err: err})
+		}
+		peerID2 := bcR.pool.RemovePeerAndRedoAllPeerRequests(second.Height)
+		peer2 := bcR.Switch.Peers().Get(peerID2)
+		if peer2 != nil && peer2 != peer {
+			// NOTE: we've already removed the peer's request, but we
+			// still need to clean up the rest.
+			bcR.Switch.StopPeerForError(peer2, ErrReactorValidation{Err: err})
+		}
+		return state, err
+	}
+
+	// SUCCESS. Pop the block from the pool.
+	bcR.pool.PopRequest()
+	bcR.verifier.prune(first.Height + 1)
 
-	// Finally, verify the first block using the second's commit
+	return bcR.saveAndApplyBlock(first, second, firstParts, firstID, state, extCommit, bcR.pool.MaxPeerHeight()), nil
+}
+
+// verifyBlock verifies the first block using the second's commit, and checks
+// that its extended commit is present iff vote extensions are enabled at its
+// height.
+func (bcR *Reactor) verifyBlock(first, second *types.Block, firstID types.BlockID, state sm.State, extCommit *types.ExtendedCommit) error {
+	// Finally, verify the first block using the second's commit, unless it
+	// was already verified ahead.
 	// NOTE: we can probably make this more efficient, but note that calling
 	// first.Hash() doesn't verify the tx contents, so MakePartSet() is
 	// currently necessary.
 	// TODO(sergio): Should we also validate against the extended commit?
-	err := state.Validators.VerifyCommitLight(
-		chainID, firstID, first.Height, second.LastCommit)
+	err := bcR.verifier.verifyCommit(first, second, firstID, state)
 
 	if err == nil {
 		// validate the block before we persist it
@@ -561,27 +721,20 @@ func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.P
 		// if vote extensions were required at this height, ensure they exist.
 		err = extCommit.EnsureExtensions(true)
 	}
+	return err
+}
 
-	if err != nil {
-		peerID := bcR.pool.RemovePeerAndRedoAllPeerRequests(first.Height)
-		peer := bcR.Switch.Peers().Get(peerID)
-		if peer != nil {
-			// NOTE: we've already removed the peer's request, but we
-			// still need to clean up the rest.
-			bcR.Switch.StopPeerForError(peer, ErrReactorValidation{Err: err})
-		}
-		peerID2 := bcR.pool.RemovePeerAndRedoAllPeerRequests(second.Height)
-		peer2 := bcR.Switch.Peers().Get(peerID2)
-		if peer2 != nil && peer2 != peer {
-			// NOTE: we've already removed the peer's request, but we
-			// still need to clean up the rest.
-			bcR.Switch.StopPeerForError(peer2, ErrReactorValidation{Err: err})
-		}
-		return state, err
-	}
-
-	// SUCCESS. Pop the block from the pool.
-	bcR.pool.PopRequest()
+// saveAndApplyBlock persists the verified first block and applies it to the
+// state, which it returns.
+func (bcR *Reactor) saveAndApplyBlock(
+	first, second *types.Block,
+	firstParts *types.PartSet,
+	firstID types.BlockID,
+	state sm.State,
+	extCommit *types.ExtendedCommit,
+	syncingToHeight int64,
+) sm.State {
+	extensionsEnabled := state.ConsensusParams.Feature.VoteExtensionsEnabled(first.Height)
 
 	// TODO: batch saves so we dont persist to disk every block
 	if extensionsEnabled {
@@ -596,7 +749,7 @@ func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.P
 
 	// TODO: same thing for app - but we would need a way to
 	// get the hash without persisting the state
-	state, err = bcR.blockExec.ApplyVerifiedBlock(state, firstID, first, bcR.pool.MaxPeerHeight())
+	state, err := bcR.blockExec.ApplyVerifiedBlock(state, firstID, first, syncingToHeight)
 	if err != nil {
 		// TODO This is bad, are we zombie?
 		panic(fmt.Sprintf("Failed to process committed block (%d:%X): %v", first.Height, first.Hash(), err))
@@ -604,5 +757,5 @@ func (bcR *Reactor) processBlock(first, second *types.Block, firstParts *types.P
 
 	bcR.metrics.recordBlockMetrics(first)
 
-	return state, nil
+	return state
 }
@@ -0,0 +1,160 @@
+package blocksync
+
+import (
+	"bytes"
+	"sync"
+
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// commitVerification is the verification of a block with the commit of the
+// next block.
+type commitVerification struct {
+	first    *types.Block
+	commit   *types.Commit
+	valsHash []byte
+
+	done    chan struct{}
+	parts   *types.PartSet
+	blockID types.BlockID
+	err     error
+}
+
+// commitVerifier verifies the commits of a window of downloaded blocks
+// concurrently, ahead of the blocks being applied, which still happens one at
+// a time and in order. Commits are verified with VerifyCommitLight, which
+// uses a batch verifier if the key type of the validators supports it.
+//
+// A block can only be verified ahead if its validator set is known, that is
+// if it is the current or the next validator set of the state. The blocks that
+// can't be verified ahead, or whose block or commit got replaced since, are
+// verified when applied.
+type commitVerifier struct {
+	chainID string
+	sem     chan struct{} // limits the number of concurrent verifications
+
+	mtx           sync.Mutex
+	verifications map[int64]*commitVerification // by height of the first block
+}
+
+func newCommitVerifier(chainID string, workers int) *commitVerifier {
+	return &commitVerifier{
+		chainID:       chainID,
+		sem:           make(chan struct{}, workers),
+		verifications: make(map[int64]*commitVerification),
+	}
+}
+
+// verifyAhead starts verifying, in the background, each of the given
+// consecutive blocks with the commit of the next one.
+func (v *commitVerifier) verifyAhead(blocks []*types.Block, state sm.State) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	var (
+		valSets   []*types.ValidatorSet
+		valHashes [][]byte
+	)
+	for i := 0; i < len(blocks)-1; i++ {
+		first, commit := blocks[i], blocks[i+1].LastCommit
+		if cv, ok := v.verifications[first.Height]; ok && cv.first == first && cv.commit == commit {
+			continue
+		}
+
+		if valSets == nil {
+			// The validator sets are copied and their lazily computed fields
+			// are filled in, so that they can be shared between the
+			// verifications.
+			for _, vals := range []*types.ValidatorSet{state.Validators, state.NextValidators} {
+				if vals.IsNilOrEmpty() {
+					continue
+				}
+				vals = vals.Copy()
+				vals.TotalVotingPower()
+				vals.GetProposer()
+				valSets = append(valSets, vals)
+				valHashes = append(valHashes, vals.Hash())
+			}
+		}
+		var vals *types.ValidatorSet
+		for j, hash := range valHashes {
+			if bytes.Equal(first.ValidatorsHash, hash) {
+				vals = valSets[j]
+				break
+			}
+		}
+		if vals == nil {
+			continue
+		}
+
+		cv := &commitVerification{
+			first:    first,
+			commit:   commit,
+			valsHash: first.ValidatorsHash,
+			done:     make(chan struct{}),
+		}
+		v.verifications[first.Height] = cv
+		go v.verify(cv, vals)
+	}
+}
+
+func (v *commitVerifier) verify(cv *commitVerification, vals *types.ValidatorSet) {
+	defer close(cv.done)
+	v.sem <- struct{}{}
+	defer func() { <-v.sem }()
+
+	cv.parts, cv.err = cv.first.MakePartSet(types.BlockPartSizeBytes)
+	if cv.err != nil {
+		return
+	}
+	cv.blockID = types.BlockID{Hash: cv.first.Hash(), PartSetHeader: cv.parts.Header()}
+	cv.err = vals.VerifyCommitLight(v.chainID, cv.blockID, cv.first.Height, cv.commit)
+}
+
+// get returns the verification of first with the commit of second, waiting
+// for it to complete. It returns nil if first wasn't verified ahead with this
+// commit.
+func (v *commitVerifier) get(first, second *types.Block) *commitVerification {
+	v.mtx.Lock()
+	cv, ok := v.verifications[first.Height]
+	v.mtx.Unlock()
+	if !ok || cv.first != first || cv.commit != second.LastCommit {
+		return nil
+	}
+	<-cv.done
+	return cv
+}
+
+// partSet returns the part set of the block, reusing the one computed for its
+// verification if possible.
+func (v *commitVerifier) partSet(block *types.Block, next *types.Block) (*types.PartSet, error) {
+	if cv := v.get(block, next); cv != nil && cv.parts != nil {
+		return cv.parts, nil
+	}
+	return block.MakePartSet(types.BlockPartSizeBytes)
+}
+
+// verifyCommit verifies the first block with the commit of the second,
+// reusing the result of its verification ahead if it was done with the
+// current validator set of the state.
+func (v *commitVerifier) verifyCommit(first, second *types.Block, firstID types.BlockID, state sm.State) error {
+	cv := v.get(first, second)
+	if cv != nil && cv.parts != nil && cv.blockID.Equals(firstID) &&
+		bytes.Equal(cv.valsHash, state.Validators.Hash()) {
+		return cv.err
+	}
+	return state.Validators.VerifyCommitLight(v.chainID, firstID, first.Height, second.LastCommit)
+}
+
+// prune drops the verifications of the blocks below the given height.
+func (v *commitVerifier) prune(height int64) {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	for h := range v.verifications {
+		if h < height {
+			delete(v.verifications, h)
+		}
+	}
+}
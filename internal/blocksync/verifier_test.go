@@ -0,0 +1,102 @@
+package blocksync
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestCommitVerifier(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_verifier_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc()
+
+	const maxBlockHeight = 10
+	pair := newReactor(t, log.TestingLogger(), genDoc, privVals, maxBlockHeight)
+	defer func() {
+		require.NoError(t, pair.app.Stop())
+	}()
+
+	blocks := make([]*types.Block, 0, maxBlockHeight)
+	for height := int64(1); height <= maxBlockHeight; height++ {
+		block, _ := pair.reactor.store.LoadBlock(height)
+		require.NotNil(t, block)
+		blocks = append(blocks, block)
+	}
+
+	// Replace the commit of block 5 with one signing another block, which
+	// also changes the hash of block 6.
+	tampered, _ := pair.reactor.store.LoadBlock(6)
+	tampered.LastCommit.BlockID = blocks[6].LastCommit.BlockID
+	blocks[5] = tampered
+
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+
+	v := newCommitVerifier(state.ChainID, 4)
+	v.verifyAhead(blocks, state)
+
+	for i := 0; i < len(blocks)-1; i++ {
+		first, second := blocks[i], blocks[i+1]
+		cv := v.get(first, second)
+		require.NotNil(t, cv, "height %d", first.Height)
+
+		parts, err := v.partSet(first, second)
+		require.NoError(t, err)
+		assert.Same(t, cv.parts, parts)
+		firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: parts.Header()}
+
+		err = v.verifyCommit(first, second, firstID, state)
+		if first.Height == 5 || first.Height == 6 {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err, "height %d", first.Height)
+		}
+	}
+
+	// The last block needs the commit of the next one.
+	assert.Nil(t, v.get(blocks[len(blocks)-1], blocks[len(blocks)-1]))
+
+	// Blocks replaced since their verification are verified again.
+	other, _ := pair.reactor.store.LoadBlock(3)
+	assert.Nil(t, v.get(other, blocks[3]))
+
+	v.prune(5)
+	assert.Nil(t, v.get(blocks[3], blocks[4]))
+	assert.NotNil(t, v.get(blocks[4], blocks[5]))
+}
+
+func TestCommitVerifierUnknownValidators(t *testing.T) {
+	config = test.ResetTestRoot("blocksync_verifier_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc()
+
+	pair := newReactor(t, log.TestingLogger(), genDoc, privVals, 3)
+	defer func() {
+		require.NoError(t, pair.app.Stop())
+	}()
+	first, _ := pair.reactor.store.LoadBlock(1)
+	second, _ := pair.reactor.store.LoadBlock(2)
+
+	// The validators of the blocks are neither the current nor the next
+	// validators of the state, so the blocks aren't verified ahead.
+	otherGenDoc, _ := randGenesisDoc()
+	state, err := sm.MakeGenesisState(otherGenDoc)
+	require.NoError(t, err)
+
+	v := newCommitVerifier(state.ChainID, 4)
+	v.verifyAhead([]*types.Block{first, second}, state)
+	assert.Nil(t, v.get(first, second))
+
+	parts, err := v.partSet(first, second)
+	require.NoError(t, err)
+	firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: parts.Header()}
+	require.Error(t, v.verifyCommit(first, second, firstID, state))
+}
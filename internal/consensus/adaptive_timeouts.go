@@ -0,0 +1,188 @@
+package consensus
+
+import (
+	"slices"
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// The adaptive timeouts are adaptiveTimeoutMultiplier times the
+	// adaptiveTimeoutPercentile of the latencies in the window, so that a few
+	// slow heights don't inflate them, but most heights fit in them with some
+	// margin.
+	adaptiveTimeoutPercentile = 0.95
+	adaptiveTimeoutMultiplier = 1.5
+)
+
+// heightLatencies are the latencies derived from the canonical commit of a
+// height.
+type heightLatencies struct {
+	height int64
+	// Time between the block time and the earliest precommit for the block.
+	// Only set if the block was committed in round 0, as the block time is the
+	// time at which it was first proposed.
+	proposal    time.Duration
+	hasProposal bool
+	// Time between the earliest precommit for the block and the one that made
+	// them reach +2/3 of the voting power.
+	quorum time.Duration
+}
+
+// adaptiveTimeouts derives timeout_propose and timeout_vote from the
+// latencies of the last committed heights, which are computed from data in
+// the canonical commits only: the block time, the round the block was
+// committed in, and the timestamps and voting power of its precommits.
+// Validators with the same config, and the last heights in their stores,
+// therefore derive the same timeouts.
+//
+// The timeouts are only recomputed at the start of a height, and are kept
+// within the bounds set in the config. Until the latencies of a full window of
+// heights are available, for example right after state sync, the configured
+// timeouts are used.
+//
+// NOTE: Not thread safe. Should only be manipulated by functions downstream of
+// the cs.receiveRoutine.
+type adaptiveTimeouts struct {
+	config *cfg.ConsensusConfig
+	window []heightLatencies // consecutive heights, in increasing order
+}
+
+func newAdaptiveTimeouts(config *cfg.ConsensusConfig) *adaptiveTimeouts {
+	return &adaptiveTimeouts{config: config}
+}
+
+// update moves the window so that it ends at lastHeight, loading the
+// latencies of the heights that are missing from the stores.
+func (at *adaptiveTimeouts) update(blockStore sm.BlockStore, stateStore sm.Store, lastHeight int64) {
+	first := lastHeight - int64(at.config.AdaptiveTimeoutsWindow) + 1
+	window := make([]heightLatencies, 0, at.config.AdaptiveTimeoutsWindow)
+	for _, l := range at.window {
+		if l.height >= first && l.height <= lastHeight {
+			window = append(window, l)
+		}
+	}
+	if len(window) > 0 {
+		first = window[len(window)-1].height + 1
+	}
+	for height := max(first, blockStore.Base(), 1); height <= lastHeight; height++ {
+		l, ok := loadHeightLatencies(blockStore, stateStore, height)
+		if !ok {
+			// Heights must be consecutive, so start over after the gap.
+			window = window[:0]
+			continue
+		}
+		window = append(window, l)
+	}
+	at.window = window
+}
+
+// timeouts returns the base timeout_propose and timeout_vote adapted to the
+// latencies in the window.
+func (at *adaptiveTimeouts) timeouts() (propose, vote time.Duration) {
+	if len(at.window) < at.config.AdaptiveTimeoutsWindow {
+		return at.config.TimeoutPropose, at.config.TimeoutVote
+	}
+
+	var proposals, quorums []time.Duration
+	for _, l := range at.window {
+		if l.hasProposal {
+			proposals = append(proposals, l.proposal)
+		}
+		quorums = append(quorums, l.quorum)
+	}
+	// No block committed in round 0 means proposals don't make it in time.
+	propose = at.config.TimeoutProposeMax
+	if len(proposals) > 0 {
+		propose = adapt(proposals, at.config.TimeoutProposeMin, at.config.TimeoutProposeMax)
+	}
+	vote = adapt(quorums, at.config.TimeoutVoteMin, at.config.TimeoutVoteMax)
+	return propose, vote
+}
+
+func adapt(latencies []time.Duration, minTimeout, maxTimeout time.Duration) time.Duration {
+	timeout := time.Duration(adaptiveTimeoutMultiplier * float64(percentile(latencies, adaptiveTimeoutPercentile)))
+	return min(max(timeout, minTimeout), maxTimeout)
+}
+
+// percentile returns the latency under which the fraction p of the latencies
+// fall. latencies must not be empty.
+func percentile(latencies []time.Duration, p float64) time.Duration {
+	sorted := slices.Clone(latencies)
+	slices.Sort(sorted)
+	return sorted[int(p*float64(len(sorted)-1))]
+}
+
+// loadHeightLatencies computes the latencies of a height from its canonical
+// commit, which is only stored once the next block is. It returns false if
+// the data is not available.
+func loadHeightLatencies(blockStore sm.BlockStore, stateStore sm.Store, height int64) (heightLatencies, bool) {
+	meta := blockStore.LoadBlockMeta(height)
+	commit := blockStore.LoadBlockCommit(height)
+	if meta == nil || commit == nil {
+		return heightLatencies{}, false
+	}
+	vals, err := stateStore.LoadValidators(height)
+	if err != nil || vals.Size() != len(commit.Signatures) {
+		return heightLatencies{}, false
+	}
+
+	type precommit struct {
+		timestamp time.Time
+		power     int64
+	}
+	precommits := make([]precommit, 0, len(commit.Signatures))
+	for i, sig := range commit.Signatures {
+		if sig.BlockIDFlag == types.BlockIDFlagCommit {
+			precommits = append(precommits, precommit{timestamp: sig.Timestamp, power: vals.Validators[i].VotingPower})
+		}
+	}
+	if len(precommits) == 0 {
+		return heightLatencies{}, false
+	}
+	slices.SortStableFunc(precommits, func(a, b precommit) int { return a.timestamp.Compare(b.timestamp) })
+
+	earliest := precommits[0].timestamp
+	l := heightLatencies{height: height}
+	var power int64
+	for _, p := range precommits {
+		power += p.power
+		if power*3 > vals.TotalVotingPower()*2 {
+			l.quorum = p.timestamp.Sub(earliest)
+			break
+		}
+	}
+	if commit.Round == 0 {
+		// Clocks may be skewed, so a precommit can seem older than the block.
+		l.proposal = max(earliest.Sub(meta.Header.Time), 0)
+		l.hasProposal = true
+	}
+	return l, true
+}
+
+// proposeTimeout returns the amount of time to wait for a proposal.
+func (cs *State) proposeTimeout(round int32) time.Duration {
+	return cs.TimeoutPropose + cs.config.TimeoutProposeDelta*time.Duration(round)
+}
+
+// voteTimeout returns the amount of time to wait for straggler votes after
+// receiving any +2/3 prevotes or precommits.
+func (cs *State) voteTimeout(round int32) time.Duration {
+	return cs.TimeoutVote + cs.config.TimeoutVoteDelta*time.Duration(round)
+}
+
+// updateTimeouts sets the base timeouts of the height following
+// lastBlockHeight. The last canonical commit stored is the one of the height
+// before.
+func (cs *State) updateTimeouts(lastBlockHeight int64) {
+	cs.TimeoutPropose, cs.TimeoutVote = cs.config.TimeoutPropose, cs.config.TimeoutVote
+	if cs.adaptiveTimeouts != nil {
+		cs.adaptiveTimeouts.update(cs.blockStore, cs.blockExec.Store(), lastBlockHeight-1)
+		cs.TimeoutPropose, cs.TimeoutVote = cs.adaptiveTimeouts.timeouts()
+	}
+	cs.metrics.TimeoutProposeSeconds.Set(cs.TimeoutPropose.Seconds())
+	cs.metrics.TimeoutVoteSeconds.Set(cs.TimeoutVote.Seconds())
+}
@@ -0,0 +1,164 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+	smmocks "github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestPercentile(t *testing.T) {
+	latencies := []time.Duration{5, 1, 3}
+	assert.Equal(t, time.Duration(1), percentile(latencies, 0))
+	assert.Equal(t, time.Duration(3), percentile(latencies, 0.5))
+	assert.Equal(t, time.Duration(5), percentile(latencies, 1))
+	// The latencies are not modified.
+	assert.Equal(t, []time.Duration{5, 1, 3}, latencies)
+}
+
+// mockAdaptiveTimeoutsStores returns stores holding, for each height, a block
+// committed in the given round by 4 validators with equal voting power. Their
+// precommits are signed at the given offsets from the block time.
+func mockAdaptiveTimeoutsStores(
+	t *testing.T,
+	base int64,
+	rounds map[int64]int32,
+	offsets [4]time.Duration,
+) (*smmocks.BlockStore, *smmocks.Store) {
+	t.Helper()
+	vals, _ := types.RandValidatorSet(4, 10)
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	blockStore := &smmocks.BlockStore{}
+	stateStore := &smmocks.Store{}
+	blockStore.On("Base").Return(base)
+	blockStore.On("LoadBlockMeta", mock.Anything).Return(func(height int64) *types.BlockMeta {
+		if _, ok := rounds[height]; !ok {
+			return nil
+		}
+		return &types.BlockMeta{Header: types.Header{Height: height, Time: blockTime}}
+	})
+	blockStore.On("LoadBlockCommit", mock.Anything).Return(func(height int64) *types.Commit {
+		round, ok := rounds[height]
+		if !ok {
+			return nil
+		}
+		sigs := make([]types.CommitSig, 0, len(offsets))
+		for i, offset := range offsets {
+			sigs = append(sigs, types.CommitSig{
+				BlockIDFlag:      types.BlockIDFlagCommit,
+				ValidatorAddress: vals.Validators[i].Address,
+				Timestamp:        blockTime.Add(offset),
+			})
+		}
+		return &types.Commit{Height: height, Round: round, Signatures: sigs}
+	})
+	stateStore.On("LoadValidators", mock.Anything).Return(vals, nil)
+	return blockStore, stateStore
+}
+
+func TestAdaptiveTimeouts(t *testing.T) {
+	config := cfg.DefaultConsensusConfig()
+	config.AdaptiveTimeouts = true
+	config.AdaptiveTimeoutsWindow = 4
+
+	rounds := map[int64]int32{1: 0, 2: 0, 3: 1, 4: 0, 5: 0}
+	// +2/3 of the voting power is reached with the third precommit.
+	offsets := [4]time.Duration{3 * time.Second, time.Second, 2 * time.Second, time.Minute}
+	blockStore, stateStore := mockAdaptiveTimeoutsStores(t, 1, rounds, offsets)
+
+	at := newAdaptiveTimeouts(config)
+	// Not enough heights yet.
+	at.update(blockStore, stateStore, 3)
+	propose, vote := at.timeouts()
+	assert.Equal(t, config.TimeoutPropose, propose)
+	assert.Equal(t, config.TimeoutVote, vote)
+
+	at.update(blockStore, stateStore, 4)
+	require.Len(t, at.window, 4)
+	propose, vote = at.timeouts()
+	// The proposal latency is 1s, up to the earliest precommit.
+	assert.Equal(t, 1500*time.Millisecond, propose)
+	// The quorum latency is 2s, between the earliest and the third precommit.
+	assert.Equal(t, 3*time.Second, vote)
+
+	// The window moves with the heights, and only the new ones are loaded.
+	at.update(blockStore, stateStore, 5)
+	require.Len(t, at.window, 4)
+	assert.Equal(t, int64(2), at.window[0].height)
+	blockStore.AssertNumberOfCalls(t, "LoadBlockCommit", 5)
+
+	// A node that loads the same heights from scratch derives the same
+	// timeouts.
+	restarted := newAdaptiveTimeouts(config)
+	restarted.update(blockStore, stateStore, 5)
+	assert.Equal(t, at.window, restarted.window)
+
+	// A height that is not available, e.g. below the block store base, leaves
+	// the window incomplete.
+	at.update(blockStore, stateStore, 6)
+	propose, vote = at.timeouts()
+	assert.Equal(t, config.TimeoutPropose, propose)
+	assert.Equal(t, config.TimeoutVote, vote)
+}
+
+func TestAdaptiveTimeoutsBounds(t *testing.T) {
+	config := cfg.DefaultConsensusConfig()
+	config.AdaptiveTimeouts = true
+	config.AdaptiveTimeoutsWindow = 2
+
+	// No block committed in round 0: the propose timeout is the maximum.
+	offsets := [4]time.Duration{time.Millisecond, time.Millisecond, time.Millisecond, time.Millisecond}
+	blockStore, stateStore := mockAdaptiveTimeoutsStores(t, 1, map[int64]int32{1: 2, 2: 1}, offsets)
+	at := newAdaptiveTimeouts(config)
+	at.update(blockStore, stateStore, 2)
+	propose, vote := at.timeouts()
+	assert.Equal(t, config.TimeoutProposeMax, propose)
+	assert.Equal(t, config.TimeoutVoteMin, vote)
+
+	offsets = [4]time.Duration{0, time.Minute, time.Hour, time.Hour}
+	blockStore, stateStore = mockAdaptiveTimeoutsStores(t, 1, map[int64]int32{1: 0, 2: 0}, offsets)
+	at = newAdaptiveTimeouts(config)
+	at.update(blockStore, stateStore, 2)
+	propose, vote = at.timeouts()
+	assert.Equal(t, config.TimeoutProposeMin, propose)
+	assert.Equal(t, config.TimeoutVoteMax, vote)
+}
+
+func TestStateAdaptiveTimeouts(t *testing.T) {
+	cs, _ := randState(1)
+	cs.config.AdaptiveTimeouts = true
+	cs.config.AdaptiveTimeoutsWindow = 2
+	cs.adaptiveTimeouts = newAdaptiveTimeouts(cs.config)
+	height, round := cs.Height, cs.Round
+
+	newRoundCh := subscribe(cs.eventBus, types.EventQueryNewRound)
+	startTestRound(cs, height, round)
+
+	// The canonical commit of a height is only stored with the next block, so
+	// the window is complete two heights later.
+	ensureNewRound(newRoundCh, height, round)
+	for h := height + 1; h <= height+2; h++ {
+		ensureNewRound(newRoundCh, h, 0)
+		rs := cs.GetRoundState()
+		assert.Equal(t, cs.config.TimeoutPropose, rs.TimeoutPropose)
+		assert.Equal(t, cs.config.TimeoutVote, rs.TimeoutVote)
+	}
+
+	ensureNewRound(newRoundCh, height+3, 0)
+	rs := cs.GetRoundState()
+	// This node is the only validator, so it precommits right after proposing,
+	// and its own precommit forms a quorum.
+	assert.Equal(t, cs.config.TimeoutProposeMin, rs.TimeoutPropose)
+	assert.Equal(t, cs.config.TimeoutVoteMin, rs.TimeoutVote)
+
+	bz, err := cs.GetRoundStateJSON()
+	require.NoError(t, err)
+	assert.Contains(t, string(bz), `"timeout_vote":"5000000"`)
+}
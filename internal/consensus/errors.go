@@ -21,6 +21,7 @@ var (
 	ErrSignatureFoundInPastBlocks = errors.New("found signature from the same key")
 	ErrPubKeyIsNotSet             = errors.New("pubkey is not set. Look for \"Can't get private validator pubkey\" errors")
 	ErrProposalTooManyParts       = errors.New("proposal block has too many parts")
+	ErrRoundTraceDisabled         = errors.New("consensus round trace is disabled (see round_trace_heights)")
 )
 
 type ErrInvalidVote struct {
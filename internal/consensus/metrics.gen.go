@@ -206,6 +206,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 
 			Buckets: []float64{-1.5, -1.0, -0.5, -0.2, 0, 0.2, 0.5, 1.0, 1.5, 2.0, 2.5, 4.0, 8.0},
 		}, append(labels, "is_timely")).With(labelsAndValues...),
+		TimeoutProposeSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "timeout_propose_seconds",
+			Help:      "Base timeout_propose of the current height in seconds.",
+		}, labels).With(labelsAndValues...),
+		TimeoutVoteSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "timeout_vote_seconds",
+			Help:      "Base timeout_vote of the current height in seconds.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -242,5 +254,7 @@ func NopMetrics() *Metrics {
 		RoundVotingPowerPercent:     discard.NewGauge(),
 		LateVotes:                   discard.NewCounter(),
 		ProposalTimestampDifference: discard.NewHistogram(),
+		TimeoutProposeSeconds:       discard.NewGauge(),
+		TimeoutVoteSeconds:          discard.NewGauge(),
 	}
 }
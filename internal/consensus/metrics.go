@@ -139,6 +139,18 @@ type Metrics struct {
 	// parameter SynchronyParams.MessageDelay, used by the PBTS algorithm.
 	// metrics:Difference in seconds between the local time when a proposal message is received and the timestamp in the proposal message.
 	ProposalTimestampDifference metrics.Histogram `metrics_bucketsizes:"-1.5, -1.0, -0.5, -0.2, 0, 0.2, 0.5, 1.0, 1.5, 2.0, 2.5, 4.0, 8.0" metrics_labels:"is_timely"`
+
+	// TimeoutProposeSeconds is the base timeout_propose of the current
+	// height, which is adapted to the latencies of the last heights if
+	// adaptive timeouts are enabled.
+	// metrics:Base timeout_propose of the current height in seconds.
+	TimeoutProposeSeconds metrics.Gauge
+
+	// TimeoutVoteSeconds is the base timeout_vote of the current height, which
+	// is adapted to the latencies of the last heights if adaptive timeouts are
+	// enabled.
+	// metrics:Base timeout_vote of the current height in seconds.
+	TimeoutVoteSeconds metrics.Gauge
 }
 
 func (m *Metrics) MarkProposalProcessed(accepted bool) {
@@ -359,7 +359,7 @@ func (conR *Reactor) Receive(e p2p.Envelope) {
 			conR.rsMtx.RUnlock()
 			ps.SetHasVoteFromPeer(msg.Vote, height, valSize, lastCommitSize)
 
-			cs.peerMsgQueue <- msgInfo{msg, e.Src.ID(), time.Time{}}
+			cs.peerMsgQueue <- msgInfo{msg, e.Src.ID(), cmttime.Now()}
 
 		default:
 			// don't punish (leave room for soft upgrades)
@@ -1040,6 +1040,11 @@ func (conR *Reactor) peerStatsRoutine() {
 					conR.Switch.MarkPeerAsGood(peer)
 				}
 			}
+			conR.Switch.ReportBehaviour(peer, p2p.BehaviourUsefulMessage)
+		case msg := <-conR.conS.invalidMsgQueue:
+			if peer := conR.Switch.Peers().Get(msg.PeerID); peer != nil {
+				conR.Switch.ReportBehaviour(peer, p2p.BehaviourInvalidMessage)
+			}
 		case <-conR.conS.Quit():
 			return
 
@@ -0,0 +1,184 @@
+package consensus
+
+import (
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p/nodekey"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// Types of round trace events.
+const (
+	RoundTraceStep     = "step"
+	RoundTraceTimeout  = "timeout"
+	RoundTraceProposal = "proposal"
+	RoundTraceVote     = "vote"
+)
+
+// maxRoundTraceEvents is the maximum number of events recorded per height, so
+// that a height that takes a very large number of rounds doesn't exhaust the
+// memory.
+const maxRoundTraceEvents = 100_000
+
+// RoundTraceEvent is a step transition, a timeout, or a proposal or vote
+// received by the consensus state, as recorded by the RoundTraceRecorder.
+type RoundTraceEvent struct {
+	Type   string `json:"type"`
+	Height int64  `json:"height"`
+	Round  int32  `json:"round"`
+	// Step entered, or step at which a timeout fired.
+	Step string `json:"step,omitempty"`
+	// Duration of a timeout.
+	Timeout time.Duration `json:"timeout,omitempty"`
+	// Peer a proposal or vote was received from. Empty if it was created by
+	// this node.
+	PeerID nodekey.ID `json:"peer_id,omitempty"`
+	// Type, validator and block of a vote.
+	VoteType         string `json:"vote_type,omitempty"`
+	ValidatorAddress string `json:"validator_address,omitempty"`
+	ValidatorIndex   int32  `json:"validator_index,omitempty"`
+	// Block a proposal or vote is for. Empty for nil votes.
+	BlockHash string `json:"block_hash,omitempty"`
+	// Local time at which the event happened, or at which the message was
+	// received.
+	Time time.Time `json:"time"`
+	// Why the proposal or vote was rejected, if it was.
+	Error string `json:"error,omitempty"`
+}
+
+// RoundTraceRecorder keeps the RoundTraceEvents of the most recent heights,
+// so that the rounds of a height can be analyzed after the fact, e.g. to see
+// which validators were late. It is safe for concurrent use.
+type RoundTraceRecorder struct {
+	mtx        cmtsync.Mutex
+	maxHeights int64
+	lastHeight int64
+	traces     map[int64][]RoundTraceEvent
+}
+
+// NewRoundTraceRecorder returns a RoundTraceRecorder keeping the events of the
+// last maxHeights heights.
+func NewRoundTraceRecorder(maxHeights int) *RoundTraceRecorder {
+	return &RoundTraceRecorder{
+		maxHeights: int64(maxHeights),
+		traces:     make(map[int64][]RoundTraceEvent),
+	}
+}
+
+// Record adds an event to the trace of its height. Events for heights that
+// are too old are dropped.
+func (r *RoundTraceRecorder) Record(ev RoundTraceEvent) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if ev.Height <= r.lastHeight-r.maxHeights {
+		return
+	}
+	if ev.Height > r.lastHeight {
+		r.lastHeight = ev.Height
+		for height := range r.traces {
+			if height <= r.lastHeight-r.maxHeights {
+				delete(r.traces, height)
+			}
+		}
+	}
+	if len(r.traces[ev.Height]) >= maxRoundTraceEvents {
+		return
+	}
+	r.traces[ev.Height] = append(r.traces[ev.Height], ev)
+}
+
+// Trace returns the events recorded for height, in the order in which they
+// happened, or false if the height is not recorded.
+func (r *RoundTraceRecorder) Trace(height int64) ([]RoundTraceEvent, bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	trace, ok := r.traces[height]
+	if !ok {
+		return nil, false
+	}
+	return append([]RoundTraceEvent(nil), trace...), true
+}
+
+// traceStep records the step the state just entered.
+func (cs *State) traceStep() {
+	if cs.roundTrace == nil || cs.replayMode {
+		return
+	}
+	cs.roundTrace.Record(RoundTraceEvent{
+		Type:   RoundTraceStep,
+		Height: cs.Height,
+		Round:  cs.Round,
+		Step:   cs.Step.String(),
+		Time:   cmttime.Now(),
+	})
+}
+
+// traceTimeout records a timeout that causes a state transition.
+func (cs *State) traceTimeout(ti timeoutInfo) {
+	if cs.roundTrace == nil || cs.replayMode {
+		return
+	}
+	cs.roundTrace.Record(RoundTraceEvent{
+		Type:    RoundTraceTimeout,
+		Height:  ti.Height,
+		Round:   ti.Round,
+		Step:    ti.Step.String(),
+		Timeout: ti.Duration,
+		Time:    cmttime.Now(),
+	})
+}
+
+// traceMsg records a proposal or vote, along with the error returned when
+// processing it. Messages for heights other than the current and previous one
+// are not recorded, so that peers can't evict the recorded heights.
+func (cs *State) traceMsg(mi msgInfo, err error) {
+	if cs.roundTrace == nil || cs.replayMode {
+		return
+	}
+
+	ev := RoundTraceEvent{
+		PeerID: mi.PeerID,
+		Time:   mi.ReceiveTime,
+	}
+	if ev.Time.IsZero() {
+		ev.Time = cmttime.Now()
+	}
+	if err != nil {
+		ev.Error = err.Error()
+	}
+
+	switch msg := mi.Msg.(type) {
+	case *ProposalMessage:
+		ev.Type = RoundTraceProposal
+		ev.Height, ev.Round = msg.Proposal.Height, msg.Proposal.Round
+		ev.BlockHash = msg.Proposal.BlockID.Hash.String()
+	case *VoteMessage:
+		ev.Type = RoundTraceVote
+		ev.Height, ev.Round = msg.Vote.Height, msg.Vote.Round
+		ev.VoteType = voteTypeString(msg.Vote.Type)
+		ev.ValidatorAddress = msg.Vote.ValidatorAddress.String()
+		ev.ValidatorIndex = msg.Vote.ValidatorIndex
+		ev.BlockHash = msg.Vote.BlockID.Hash.String()
+	default:
+		return
+	}
+	if ev.Height != cs.Height && ev.Height != cs.Height-1 {
+		return
+	}
+	cs.roundTrace.Record(ev)
+}
+
+func voteTypeString(typ types.SignedMsgType) string {
+	switch typ {
+	case types.PrevoteType:
+		return "prevote"
+	case types.PrecommitType:
+		return "precommit"
+	default:
+		return typ.String()
+	}
+}
@@ -0,0 +1,92 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestRoundTraceRecorder(t *testing.T) {
+	r := NewRoundTraceRecorder(2)
+
+	_, ok := r.Trace(1)
+	assert.False(t, ok)
+
+	r.Record(RoundTraceEvent{Type: RoundTraceStep, Height: 1})
+	r.Record(RoundTraceEvent{Type: RoundTraceStep, Height: 2})
+	r.Record(RoundTraceEvent{Type: RoundTraceVote, Height: 1})
+	trace, ok := r.Trace(1)
+	require.True(t, ok)
+	assert.Len(t, trace, 2)
+
+	// Moving to height 3 evicts height 1, and late events for it are dropped.
+	r.Record(RoundTraceEvent{Type: RoundTraceStep, Height: 3})
+	r.Record(RoundTraceEvent{Type: RoundTraceVote, Height: 1})
+	_, ok = r.Trace(1)
+	assert.False(t, ok)
+	for _, height := range []int64{2, 3} {
+		trace, ok = r.Trace(height)
+		require.True(t, ok)
+		assert.Len(t, trace, 1)
+	}
+
+	// The returned trace is a copy.
+	trace[0].Type = RoundTraceTimeout
+	trace, _ = r.Trace(3)
+	assert.Equal(t, RoundTraceStep, trace[0].Type)
+}
+
+func TestStateRoundTrace(t *testing.T) {
+	cs, _ := randState(1)
+	cs.roundTrace = NewRoundTraceRecorder(10)
+	height, round := cs.Height, cs.Round
+
+	_, err := cs.GetRoundTraceJSON(height)
+	require.Error(t, err)
+
+	newRoundCh := subscribe(cs.eventBus, types.EventQueryNewRound)
+	startTestRound(cs, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewRound(newRoundCh, height+1, 0)
+	// The precommit that completes a height is recorded once the next height
+	// has started.
+	ensureNewRound(newRoundCh, height+2, 0)
+
+	bz, err := cs.GetRoundTraceJSON(height)
+	require.NoError(t, err)
+	var trace []RoundTraceEvent
+	require.NoError(t, cmtjson.Unmarshal(bz, &trace))
+
+	steps := make(map[string]bool)
+	votes := make(map[string]bool)
+	proposals := 0
+	for _, ev := range trace {
+		assert.Equal(t, height, ev.Height)
+		assert.False(t, ev.Time.IsZero())
+		switch ev.Type {
+		case RoundTraceStep:
+			steps[ev.Step] = true
+		case RoundTraceProposal:
+			proposals++
+			assert.Empty(t, ev.Error)
+		case RoundTraceVote:
+			votes[ev.VoteType] = true
+			assert.Equal(t, cs.privValidatorPubKey.Address().String(), ev.ValidatorAddress)
+		}
+	}
+	for _, step := range []string{"RoundStepPropose", "RoundStepPrevote", "RoundStepPrecommit", "RoundStepCommit"} {
+		assert.True(t, steps[step], step)
+	}
+	assert.Equal(t, 1, proposals)
+	assert.True(t, votes["prevote"])
+	assert.True(t, votes["precommit"])
+
+	// Disabled recorder.
+	cs2, _ := randState(1)
+	_, err = cs2.GetRoundTraceJSON(1)
+	require.ErrorIs(t, err, ErrRoundTraceDisabled)
+}
@@ -63,6 +63,8 @@ type txNotifier interface {
 type evidencePool interface {
 	// reports conflicting votes to the evidence pool to be processed into evidence
 	ReportConflictingVotes(voteA, voteB *types.Vote)
+	// reports conflicting proposals to the evidence pool to be processed into evidence
+	ReportConflictingProposals(proposalA, proposalB *types.Proposal)
 }
 
 // State handles execution of the consensus algorithm.
@@ -107,6 +109,10 @@ type State struct {
 	// so statistics can be computed by reactor
 	statsMsgQueue chan msgInfo
 
+	// invalid block parts are written on this channel, so that the reactor
+	// can report their senders to the switch
+	invalidMsgQueue chan msgInfo
+
 	// we use eventBus to trigger msg broadcasts in the reactor,
 	// and to notify external subscribers, eg. through a websocket
 	eventBus *types.EventBus
@@ -135,6 +141,12 @@ type State struct {
 	// for reporting metrics
 	metrics *Metrics
 
+	// records step transitions, timeouts, proposals and votes; nil if disabled
+	roundTrace *RoundTraceRecorder
+
+	// adapts the timeouts to the latencies of the last heights; nil if disabled
+	adaptiveTimeouts *adaptiveTimeouts
+
 	// offline state sync height indicating to which height the node synced offline
 	offlineStateSyncHeight int64
 
@@ -165,6 +177,7 @@ func NewState(
 		internalMsgQueue: make(chan msgInfo, msgQueueSize),
 		timeoutTicker:    NewTimeoutTicker(),
 		statsMsgQueue:    make(chan msgInfo, msgQueueSize),
+		invalidMsgQueue:  make(chan msgInfo, msgQueueSize),
 		done:             make(chan struct{}),
 		doWALCatchup:     true,
 		wal:              nilWAL{},
@@ -172,6 +185,9 @@ func NewState(
 		evsw:             cmtevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
 	}
+	if config.AdaptiveTimeouts {
+		cs.adaptiveTimeouts = newAdaptiveTimeouts(config)
+	}
 	for _, option := range options {
 		option(cs)
 	}
@@ -220,6 +236,12 @@ func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *State) { cs.metrics = metrics }
 }
 
+// StateRoundTrace sets the recorder of step transitions, timeouts, proposals
+// and votes.
+func StateRoundTrace(recorder *RoundTraceRecorder) StateOption {
+	return func(cs *State) { cs.roundTrace = recorder }
+}
+
 // OfflineStateSyncHeight indicates the height at which the node
 // statesync offline - before booting sets the metrics.
 func OfflineStateSyncHeight(height int64) StateOption {
@@ -270,6 +292,19 @@ func (cs *State) GetRoundStateJSON() ([]byte, error) {
 	return cmtjson.Marshal(cs.RoundState)
 }
 
+// GetRoundTraceJSON returns a json of the RoundTraceEvents recorded for the
+// given height.
+func (cs *State) GetRoundTraceJSON(height int64) ([]byte, error) {
+	if cs.roundTrace == nil {
+		return nil, ErrRoundTraceDisabled
+	}
+	trace, ok := cs.roundTrace.Trace(height)
+	if !ok {
+		return nil, fmt.Errorf("no round trace for height %d", height)
+	}
+	return cmtjson.Marshal(trace)
+}
+
 // GetRoundStateSimpleJSON returns a json of RoundStateSimple.
 func (cs *State) GetRoundStateSimpleJSON() ([]byte, error) {
 	cs.mtx.RLock()
@@ -740,6 +775,8 @@ func (cs *State) updateToState(state sm.State) {
 		cs.StartTime = cs.CommitTime.Add(timeoutCommit)
 	}
 
+	cs.updateTimeouts(state.LastBlockHeight)
+
 	cs.Validators = validators
 	cs.Proposal = nil
 	cs.ProposalReceiveTime = time.Time{}
@@ -773,6 +810,7 @@ func (cs *State) newStep() {
 	}
 
 	cs.nSteps++
+	cs.traceStep()
 
 	// newStep is called by updateToState in NewState before the eventBus is set!
 	if cs.eventBus != nil {
@@ -923,6 +961,13 @@ func (cs *State) handleMsg(mi msgInfo) {
 		if added {
 			cs.statsMsgQueue <- mi
 		}
+		if errors.Is(err, types.ErrPartSetInvalidProof) || errors.Is(err, types.ErrPartSetUnexpectedIndex) {
+			select {
+			case cs.invalidMsgQueue <- mi:
+			default:
+				// don't block consensus if the reactor is lagging behind
+			}
+		}
 
 		if err != nil && msg.Round != cs.Round {
 			cs.Logger.Debug(
@@ -962,6 +1007,8 @@ func (cs *State) handleMsg(mi msgInfo) {
 		return
 	}
 
+	cs.traceMsg(mi, err)
+
 	if err != nil {
 		cs.Logger.Error(
 			"Failed to process message",
@@ -987,6 +1034,8 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	cs.traceTimeout(ti)
+
 	switch ti.Step {
 	case cstypes.RoundStepNewHeight:
 		// NewRound event fired from enterNewRound.
@@ -1186,7 +1235,7 @@ func (cs *State) enterPropose(height int64, round int32) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.scheduleTimeout(cs.proposeTimeout(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -1598,7 +1647,7 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.voteTimeout(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1736,7 +1785,7 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 	}()
 
 	// wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.voteTimeout(round), height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block.
@@ -2046,9 +2095,12 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 // -----------------------------------------------------------------------------
 
 func (cs *State) defaultSetProposal(proposal *types.Proposal, recvTime time.Time) error {
+	if proposal == nil {
+		return nil
+	}
 	// Already have one
-	// TODO: possibly catch double proposals
-	if cs.Proposal != nil || proposal == nil {
+	if cs.Proposal != nil {
+		cs.checkConflictingProposal(proposal)
 		return nil
 	}
 
@@ -2102,6 +2154,27 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal, recvTime time.Time
 	return nil
 }
 
+// checkConflictingProposal reports proposal to the evidence pool if it was
+// signed by the proposer of the round, but for a different block than the
+// proposal we already have.
+func (cs *State) checkConflictingProposal(proposal *types.Proposal) {
+	if proposal.Height != cs.Proposal.Height || proposal.Round != cs.Proposal.Round ||
+		proposal.BlockID.Equals(cs.Proposal.BlockID) {
+		return
+	}
+
+	proposer := cs.Validators.GetProposer()
+	if !proposer.PubKey.VerifySignature(
+		types.ProposalSignBytes(cs.state.ChainID, proposal.ToProto()), proposal.Signature,
+	) {
+		return
+	}
+
+	cs.Logger.Info("Found conflicting proposal from the proposer; reporting it as evidence",
+		"proposal", proposal, "existing", cs.Proposal, "proposer", proposer.Address)
+	cs.evpool.ReportConflictingProposals(cs.Proposal, proposal)
+}
+
 func (cs *State) readSerializedBlockFromBlockParts() ([]byte, error) {
 	// reuse a serialized block buffer from cs
 	var serializedBlockBuffer []byte
@@ -25,7 +25,9 @@ import (
 	"github.com/cometbft/cometbft/libs/protoio"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	p2pmock "github.com/cometbft/cometbft/p2p/mock"
+	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
 )
 
 /*
@@ -249,6 +251,63 @@ func TestStateBadProposal(t *testing.T) {
 	signAddVotes(cs1, types.PrecommitType, chainID, blockID, true, vs2)
 }
 
+// conflictingProposalsPool records the conflicting proposals reported by
+// consensus.
+type conflictingProposalsPool struct {
+	sm.EmptyEvidencePool
+	reported [][2]*types.Proposal
+}
+
+func (p *conflictingProposalsPool) ReportConflictingProposals(proposalA, proposalB *types.Proposal) {
+	p.reported = append(p.reported, [2]*types.Proposal{proposalA, proposalB})
+}
+
+func TestStateConflictingProposal(t *testing.T) {
+	cs1, vss := randState(2)
+	height, round, chainID := cs1.Height, cs1.Round, cs1.state.ChainID
+	evpool := &conflictingProposalsPool{}
+	cs1.evpool = evpool
+
+	// find the proposer of the round
+	proposerAddr := cs1.Validators.GetProposer().Address
+	var proposer *validatorStub
+	for _, vs := range vss {
+		pubKey, err := vs.GetPubKey()
+		require.NoError(t, err)
+		if bytes.Equal(pubKey.Address(), proposerAddr) {
+			proposer = vs
+		}
+	}
+	require.NotNil(t, proposer)
+
+	makeProposal := func(blockHash string, vs *validatorStub) *types.Proposal {
+		blockID := types.BlockID{
+			Hash:          tmhash.Sum([]byte(blockHash)),
+			PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+		}
+		proposal := types.NewProposal(height, round, -1, blockID, cmttime.Now())
+		signProposal(t, proposal, chainID, vs)
+		return proposal
+	}
+	proposalA := makeProposal("blockA", proposer)
+	require.NoError(t, cs1.defaultSetProposal(proposalA, cmttime.Now()))
+
+	// the same proposal, or one not signed by the proposer, is not reported
+	require.NoError(t, cs1.defaultSetProposal(proposalA, cmttime.Now()))
+	other := vss[0]
+	if other == proposer {
+		other = vss[1]
+	}
+	require.NoError(t, cs1.defaultSetProposal(makeProposal("blockB", other), cmttime.Now()))
+	require.Empty(t, evpool.reported)
+
+	// a conflicting proposal from the proposer is reported
+	proposalB := makeProposal("blockB", proposer)
+	require.NoError(t, cs1.defaultSetProposal(proposalB, cmttime.Now()))
+	require.Equal(t, [][2]*types.Proposal{{proposalA, proposalB}}, evpool.reported)
+	require.Equal(t, proposalA, cs1.Proposal)
+}
+
 func TestStateOversizedBlock(t *testing.T) {
 	const maxBytes = int64(types.BlockPartSizeBytes)
 
@@ -101,6 +101,11 @@ type RoundState struct {
 	LastCommit                *types.VoteSet      `json:"last_commit"`  // Last precommits at Height-1
 	LastValidators            *types.ValidatorSet `json:"last_validators"`
 	TriggeredTimeoutPrecommit bool                `json:"triggered_timeout_precommit"`
+
+	// Base timeouts of the height, before the per-round deltas are added.
+	// They differ from the configured ones if adaptive timeouts are enabled.
+	TimeoutPropose time.Duration `json:"timeout_propose"`
+	TimeoutVote    time.Duration `json:"timeout_vote"`
 }
 
 // Compressed version of the RoundState for use in RPC.
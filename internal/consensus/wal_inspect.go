@@ -0,0 +1,278 @@
+package consensus
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	auto "github.com/cometbft/cometbft/internal/autofile"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/types"
+)
+
+// --------------------------------------------------------
+// Offline inspection of the WAL, used by the `cometbft wal` commands.
+// The node must be stopped while the WAL is being read or modified.
+
+// Types of WAL messages, as returned by WALMessageType.
+const (
+	WALMsgTypeRoundState = "round_state"
+	WALMsgTypeProposal   = "proposal"
+	WALMsgTypeBlockPart  = "block_part"
+	WALMsgTypeVote       = "vote"
+	WALMsgTypeMsgInfo    = "msg_info"
+	WALMsgTypeTimeout    = "timeout"
+	WALMsgTypeEndHeight  = "end_height"
+)
+
+// WALRecord is a message read from a WAL file, along with its location.
+type WALRecord struct {
+	Path   string // path of the file in the WAL group
+	Offset int64  // offset of the message in the file
+	Msg    *TimedWALMessage
+}
+
+// WALCorruptionError is returned when a message in a WAL file can't be
+// decoded.
+type WALCorruptionError struct {
+	Path   string
+	Offset int64
+	Err    error
+}
+
+func (e WALCorruptionError) Error() string {
+	return fmt.Sprintf("corrupted WAL message in %s at offset %d: %v", e.Path, e.Offset, e.Err)
+}
+
+func (e WALCorruptionError) Unwrap() error {
+	return e.Err
+}
+
+// WALMessageType returns the type of msg. Messages received from peers or
+// from the node itself are reported by their content, or as "msg_info" if
+// it's not a proposal, a block part or a vote.
+func WALMessageType(msg WALMessage) string {
+	switch m := msg.(type) {
+	case types.EventDataRoundState:
+		return WALMsgTypeRoundState
+	case msgInfo:
+		switch m.Msg.(type) {
+		case *ProposalMessage:
+			return WALMsgTypeProposal
+		case *BlockPartMessage:
+			return WALMsgTypeBlockPart
+		case *VoteMessage:
+			return WALMsgTypeVote
+		}
+		return WALMsgTypeMsgInfo
+	case timeoutInfo:
+		return WALMsgTypeTimeout
+	case EndHeightMessage:
+		return WALMsgTypeEndHeight
+	}
+	return fmt.Sprintf("%T", msg)
+}
+
+// WALMessageHeightRound returns the height and round that msg refers to. The
+// round is -1 for EndHeightMessage, and both are -1 for messages that don't
+// refer to any height.
+func WALMessageHeightRound(msg WALMessage) (height int64, round int32) {
+	switch m := msg.(type) {
+	case types.EventDataRoundState:
+		return m.Height, m.Round
+	case msgInfo:
+		switch cm := m.Msg.(type) {
+		case *ProposalMessage:
+			return cm.Proposal.Height, cm.Proposal.Round
+		case *BlockPartMessage:
+			return cm.Height, cm.Round
+		case *VoteMessage:
+			return cm.Vote.Height, cm.Vote.Round
+		}
+	case timeoutInfo:
+		return m.Height, m.Round
+	case EndHeightMessage:
+		return m.Height, -1
+	}
+	return -1, -1
+}
+
+// WALFiles returns the paths of the files in the WAL with head at walFile,
+// from the oldest one to the head.
+func WALFiles(walFile string) ([]string, error) {
+	if !cmtos.FileExists(walFile) {
+		return nil, fmt.Errorf("WAL file %s not found", walFile)
+	}
+	group, err := auto.OpenGroup(walFile)
+	if err != nil {
+		return nil, err
+	}
+	defer group.Close()
+
+	info := group.ReadGroupInfo()
+	paths := make([]string, 0, info.MaxIndex-info.MinIndex+1)
+	for index := info.MinIndex; index <= info.MaxIndex; index++ {
+		paths = append(paths, group.IndexPath(index))
+	}
+	return paths, nil
+}
+
+// WalkWAL calls fn for each message in the WAL with head at walFile, in the
+// order in which they were written. It stops at the first corrupted message
+// and returns a WALCorruptionError, or at the first error returned by fn.
+func WalkWAL(walFile string, fn func(rec WALRecord) error) error {
+	paths, err := WALFiles(walFile)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		err := walkWALFile(path, func(rec WALRecord, _ int64) error {
+			return fn(rec)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyWAL checks the checksum and the encoding of all the messages in the
+// WAL with head at walFile. It returns the number of valid messages and the
+// corrupted messages found. The rest of a file after a corrupted message can't
+// be decoded, so at most one corrupted message is reported per file.
+func VerifyWAL(walFile string) (numMsgs int, corrupted []WALCorruptionError, err error) {
+	paths, err := WALFiles(walFile)
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, path := range paths {
+		err := walkWALFile(path, func(WALRecord, int64) error {
+			numMsgs++
+			return nil
+		})
+		var errCorrupted WALCorruptionError
+		if errors.As(err, &errCorrupted) {
+			corrupted = append(corrupted, errCorrupted)
+		} else if err != nil {
+			return numMsgs, corrupted, err
+		}
+	}
+	return numMsgs, corrupted, nil
+}
+
+// errStopWalk is used to stop walking a WAL file.
+var errStopWalk = errors.New("stop walking WAL")
+
+// TruncateWAL removes all the messages after the EndHeightMessage for the
+// given height from the WAL with head at walFile, so that the node resumes
+// consensus at the next height on restart. It fails without modifying the WAL
+// if the message is not found, or if a corrupted message is found before it.
+// It returns the number of bytes removed.
+func TruncateWAL(walFile string, height int64) (int64, error) {
+	paths, err := WALFiles(walFile)
+	if err != nil {
+		return 0, err
+	}
+
+	// Find the end of the EndHeightMessage.
+	fileIdx, end := -1, int64(0)
+	for i, path := range paths {
+		err := walkWALFile(path, func(rec WALRecord, recEnd int64) error {
+			if m, ok := rec.Msg.Msg.(EndHeightMessage); ok && m.Height == height {
+				fileIdx, end = i, recEnd
+				return errStopWalk
+			}
+			return nil
+		})
+		if errors.Is(err, errStopWalk) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	if fileIdx < 0 {
+		return 0, fmt.Errorf("EndHeightMessage for height %d not found in WAL", height)
+	}
+
+	// Cut the file with the message, and empty or remove the following ones.
+	// The head file must always exist.
+	removed := int64(0)
+	for i := fileIdx; i < len(paths); i++ {
+		size := int64(0)
+		if i == fileIdx {
+			size = end
+		}
+		info, err := os.Stat(paths[i])
+		if err != nil {
+			return removed, err
+		}
+		if i == fileIdx || i == len(paths)-1 {
+			if err := truncateFile(paths[i], size); err != nil {
+				return removed, err
+			}
+		} else if err := os.Remove(paths[i]); err != nil {
+			return removed, err
+		}
+		removed += info.Size() - size
+	}
+	return removed, nil
+}
+
+func truncateFile(path string, size int64) error {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	if err := f.Truncate(size); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// walkWALFile calls fn for each message in the WAL file at path, along with
+// the offset right after the message.
+func walkWALFile(path string, fn func(rec WALRecord, end int64) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rd := &offsetReader{rd: bufio.NewReader(f)}
+	dec := NewWALDecoder(rd)
+	for {
+		offset := rd.offset
+		msg, err := dec.Decode()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return WALCorruptionError{Path: path, Offset: offset, Err: err}
+		}
+		if err := fn(WALRecord{Path: path, Offset: offset, Msg: msg}, rd.offset); err != nil {
+			return err
+		}
+	}
+}
+
+// offsetReader keeps track of the number of bytes read. Unlike a bufio.Reader,
+// it fills p completely unless it reaches the end of the input, as expected by
+// WALDecoder.
+type offsetReader struct {
+	rd     io.Reader
+	offset int64
+}
+
+func (r *offsetReader) Read(p []byte) (int, error) {
+	n, err := io.ReadFull(r.rd, p)
+	r.offset += int64(n)
+	return n, err
+}
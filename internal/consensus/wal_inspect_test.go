@@ -0,0 +1,155 @@
+package consensus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/cometbft/cometbft/internal/consensus/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// writeWALFile writes msgs to a new WAL file at path.
+func writeWALFile(t *testing.T, path string, msgs ...WALMessage) {
+	t.Helper()
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	enc := NewWALEncoder(f)
+	for _, msg := range msgs {
+		require.NoError(t, enc.Encode(&TimedWALMessage{Time: cmttime.Now(), Msg: msg}))
+	}
+}
+
+// newTestWAL writes a WAL of two files with heights 1 to 3, where height 3
+// is not finished.
+func newTestWAL(t *testing.T) string {
+	t.Helper()
+	walFile := filepath.Join(t.TempDir(), "wal")
+	writeWALFile(t, walFile+".000",
+		EndHeightMessage{0},
+		cmttypes.EventDataRoundState{Height: 1, Round: 0, Step: "RoundStepNewHeight"},
+		timeoutInfo{Duration: time.Second, Height: 1, Round: 0, Step: cstypes.RoundStepPropose},
+		EndHeightMessage{1},
+	)
+	writeWALFile(t, walFile,
+		cmttypes.EventDataRoundState{Height: 2, Round: 0, Step: "RoundStepNewHeight"},
+		timeoutInfo{Duration: time.Second, Height: 2, Round: 1, Step: cstypes.RoundStepPrevote},
+		EndHeightMessage{2},
+		cmttypes.EventDataRoundState{Height: 3, Round: 0, Step: "RoundStepNewHeight"},
+	)
+	return walFile
+}
+
+func walMessages(t *testing.T, walFile string) []WALMessage {
+	t.Helper()
+	var msgs []WALMessage
+	err := WalkWAL(walFile, func(rec WALRecord) error {
+		msgs = append(msgs, rec.Msg.Msg)
+		return nil
+	})
+	require.NoError(t, err)
+	return msgs
+}
+
+func TestWalkWAL(t *testing.T) {
+	walFile := newTestWAL(t)
+
+	var (
+		types   []string
+		heights []int64
+		rounds  []int32
+	)
+	err := WalkWAL(walFile, func(rec WALRecord) error {
+		types = append(types, WALMessageType(rec.Msg.Msg))
+		h, r := WALMessageHeightRound(rec.Msg.Msg)
+		heights = append(heights, h)
+		rounds = append(rounds, r)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		WALMsgTypeEndHeight, WALMsgTypeRoundState, WALMsgTypeTimeout, WALMsgTypeEndHeight,
+		WALMsgTypeRoundState, WALMsgTypeTimeout, WALMsgTypeEndHeight, WALMsgTypeRoundState,
+	}, types)
+	assert.Equal(t, []int64{0, 1, 1, 1, 2, 2, 2, 3}, heights)
+	assert.Equal(t, []int32{-1, 0, 0, -1, 0, 1, -1, 0}, rounds)
+
+	_, err = WALFiles(filepath.Join(t.TempDir(), "wal"))
+	require.Error(t, err)
+}
+
+func TestVerifyWAL(t *testing.T) {
+	walFile := newTestWAL(t)
+
+	numMsgs, corrupted, err := VerifyWAL(walFile)
+	require.NoError(t, err)
+	assert.Equal(t, 8, numMsgs)
+	assert.Empty(t, corrupted)
+
+	// Corrupt the data of the second message in the head.
+	var offset int64
+	err = WalkWAL(walFile, func(rec WALRecord) error {
+		if rec.Path == walFile && offset == 0 && rec.Offset > 0 {
+			offset = rec.Offset
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	bz, err := os.ReadFile(walFile)
+	require.NoError(t, err)
+	bz[offset+10] ^= 0xff
+	require.NoError(t, os.WriteFile(walFile, bz, 0o600))
+
+	numMsgs, corrupted, err = VerifyWAL(walFile)
+	require.NoError(t, err)
+	assert.Equal(t, 5, numMsgs)
+	require.Len(t, corrupted, 1)
+	assert.Equal(t, walFile, corrupted[0].Path)
+	assert.Equal(t, offset, corrupted[0].Offset)
+	assert.True(t, IsDataCorruptionError(corrupted[0].Err))
+
+	err = WalkWAL(walFile, func(WALRecord) error { return nil })
+	require.ErrorAs(t, err, &WALCorruptionError{})
+
+	// The WAL can't be truncated after the corrupted message.
+	_, err = TruncateWAL(walFile, 2)
+	require.ErrorAs(t, err, &WALCorruptionError{})
+}
+
+func TestTruncateWAL(t *testing.T) {
+	// Truncate in the head.
+	walFile := newTestWAL(t)
+	removed, err := TruncateWAL(walFile, 2)
+	require.NoError(t, err)
+	assert.Positive(t, removed)
+	msgs := walMessages(t, walFile)
+	require.Len(t, msgs, 7)
+	assert.Equal(t, EndHeightMessage{2}, msgs[6])
+
+	// Nothing to remove after the last height.
+	removed, err = TruncateWAL(walFile, 2)
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+
+	// Truncate in an older file; the head is emptied.
+	removed, err = TruncateWAL(walFile, 1)
+	require.NoError(t, err)
+	assert.Positive(t, removed)
+	msgs = walMessages(t, walFile)
+	require.Len(t, msgs, 4)
+	assert.Equal(t, EndHeightMessage{1}, msgs[3])
+	info, err := os.Stat(walFile)
+	require.NoError(t, err)
+	assert.Zero(t, info.Size())
+
+	// The height must be in the WAL.
+	_, err = TruncateWAL(walFile, 5)
+	require.Error(t, err)
+	assert.Len(t, walMessages(t, walFile), 4)
+}
@@ -37,6 +37,14 @@ type (
 		Height  int64
 	}
 
+	// ErrAddressNotProposerAtRound is returned when the signer of DuplicateProposalEvidence's proposals was
+	// not the proposer of their round.
+	ErrAddressNotProposerAtRound struct {
+		Address bytes.HexBytes
+		Height  int64
+		Round   int32
+	}
+
 	// ErrValidatorAddressesDoNotMatch is returned when provided DuplicateVoteEvidence's votes have different validators as signers.
 	ErrValidatorAddressesDoNotMatch struct {
 		ValidatorA bytes.HexBytes
@@ -66,6 +74,13 @@ type (
 		VoteA types.Vote
 		VoteB types.Vote
 	}
+
+	// ErrDuplicateProposalHRMismatch is returned when duplicate proposal evidence's proposals are not from the
+	// same height or round.
+	ErrDuplicateProposalHRMismatch struct {
+		ProposalA types.Proposal
+		ProposalB types.Proposal
+	}
 )
 
 func (e ErrNoHeaderAtHeight) Error() string {
@@ -88,6 +103,10 @@ func (e ErrAddressNotValidatorAtHeight) Error() string {
 	return fmt.Sprintf("address %X was not a validator at height %d", e.Address, e.Height)
 }
 
+func (e ErrAddressNotProposerAtRound) Error() string {
+	return fmt.Sprintf("address %X was not the proposer at height %d, round %d", e.Address, e.Height, e.Round)
+}
+
 func (e ErrValidatorAddressesDoNotMatch) Error() string {
 	return fmt.Sprintf("validator addresses do not match: %X vs %X",
 		e.ValidatorA,
@@ -123,3 +142,9 @@ func (e ErrDuplicateEvidenceHRTMismatch) Error() string {
 		e.VoteA.Height, e.VoteA.Round, e.VoteA.Type,
 		e.VoteB.Height, e.VoteB.Round, e.VoteB.Type)
 }
+
+func (e ErrDuplicateProposalHRMismatch) Error() string {
+	return fmt.Sprintf("h/r does not match: %d/%d vs %d/%d",
+		e.ProposalA.Height, e.ProposalA.Round,
+		e.ProposalB.Height, e.ProposalB.Round)
+}
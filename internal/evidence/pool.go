@@ -2,7 +2,9 @@ package evidence
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -39,7 +41,8 @@ type Pool struct {
 	// evidence from consensus is buffered to this slice, awaiting until the next height
 	// before being flushed to the pool. This prevents broadcasting and proposing of
 	// evidence before the height with which the evidence happened is finished.
-	consensusBuffer []duplicateVoteSet
+	consensusBuffer         []duplicateVoteSet
+	consensusProposalBuffer []duplicateProposalSet
 
 	pruningHeight int64
 	pruningTime   time.Time
@@ -106,13 +109,14 @@ func NewPool(evidenceDB dbm.DB, stateDB sm.Store, blockStore BlockStore, options
 	}
 
 	pool := &Pool{
-		stateDB:         stateDB,
-		blockStore:      blockStore,
-		state:           state,
-		logger:          log.NewNopLogger(),
-		evidenceStore:   evidenceDB,
-		evidenceList:    clist.New(),
-		consensusBuffer: make([]duplicateVoteSet, 0),
+		stateDB:                 stateDB,
+		blockStore:              blockStore,
+		state:                   state,
+		logger:                  log.NewNopLogger(),
+		evidenceStore:           evidenceDB,
+		evidenceList:            clist.New(),
+		consensusBuffer:         make([]duplicateVoteSet, 0),
+		consensusProposalBuffer: make([]duplicateProposalSet, 0),
 	}
 
 	for _, option := range options {
@@ -151,6 +155,52 @@ func (evpool *Pool) PendingEvidence(maxBytes int64) ([]types.Evidence, int64) {
 	return evidence, size
 }
 
+// CommittedEvidenceHeight returns the height of the block in which the
+// evidence with the given hash was committed, or false if it has not been
+// committed. Evidence committed before the index was introduced is not found.
+func (evpool *Pool) CommittedEvidenceHeight(hash []byte) (int64, bool, error) {
+	bz, err := evpool.evidenceStore.Get(evpool.dbKeyLayout.CalcKeyCommittedByHash(hash))
+	if err != nil {
+		return 0, false, fmt.Errorf("database error: %w", err)
+	}
+	if bz == nil {
+		return 0, false, nil
+	}
+	var h gogotypes.Int64Value
+	if err := proto.Unmarshal(bz, &h); err != nil {
+		return 0, false, fmt.Errorf("unable to unmarshal committed evidence height: %w", err)
+	}
+	return h.Value, true, nil
+}
+
+// SearchCommittedEvidence returns the committed evidence of misbehavior of the
+// validator with the given address between minHeight and maxHeight
+// (inclusive), ordered by height. Evidence committed before the index was
+// introduced is not found.
+func (evpool *Pool) SearchCommittedEvidence(address types.Address, minHeight, maxHeight int64) ([]types.Evidence, error) {
+	if minHeight > maxHeight {
+		return nil, fmt.Errorf("min height %d is greater than max height %d", minHeight, maxHeight)
+	}
+	start := evpool.dbKeyLayout.PrefixToBytesCommittedByValidator(address, minHeight)
+	end := evpool.dbKeyLayout.PrefixToBytesCommittedByValidator(address, min(maxHeight, math.MaxInt64-1)+1)
+
+	iter, err := evpool.evidenceStore.Iterator(start, end)
+	if err != nil {
+		return nil, fmt.Errorf("database error: %w", err)
+	}
+	defer iter.Close()
+
+	var evidence []types.Evidence
+	for ; iter.Valid(); iter.Next() {
+		ev, err := bytesToEv(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		evidence = append(evidence, ev)
+	}
+	return evidence, iter.Error()
+}
+
 // Update takes both the new state and the evidence committed at that height and performs
 // the following operations:
 //  1. Take any conflicting votes from consensus and use the state's LastBlockTime to form
@@ -177,7 +227,7 @@ func (evpool *Pool) Update(state sm.State, ev types.EvidenceList) {
 	evpool.updateState(state)
 
 	// move committed evidence out from the pending pool and into the committed pool
-	evpool.markEvidenceAsCommitted(ev)
+	evpool.markEvidenceAsCommitted(ev, state.LastBlockHeight)
 
 	// prune pending evidence when it has expired. This also updates when the next evidence will expire
 	if evpool.Size() > 0 && state.LastBlockHeight > evpool.pruningHeight &&
@@ -241,6 +291,20 @@ func (evpool *Pool) ReportConflictingVotes(voteA, voteB *types.Vote) {
 	})
 }
 
+// ReportConflictingProposals takes two conflicting proposals signed by the proposer of their
+// round and forms duplicate proposal evidence, once consensus at their height has been reached,
+// the same way as for ReportConflictingVotes.
+//
+// Proposals are not verified.
+func (evpool *Pool) ReportConflictingProposals(proposalA, proposalB *types.Proposal) {
+	evpool.mtx.Lock()
+	defer evpool.mtx.Unlock()
+	evpool.consensusProposalBuffer = append(evpool.consensusProposalBuffer, duplicateProposalSet{
+		ProposalA: proposalA,
+		ProposalB: proposalB,
+	})
+}
+
 // CheckEvidence takes an array of evidence from a block and verifies all the evidence there.
 // If it has already verified the evidence then it jumps to the next one. It ensures that no
 // evidence has already been committed or is being proposed twice. It also adds any
@@ -380,7 +444,7 @@ func (evpool *Pool) removePendingEvidence(evidence types.Evidence) {
 
 // markEvidenceAsCommitted processes all the evidence in the block, marking it as
 // committed and removing it from the pending database.
-func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
+func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList, height int64) {
 	blockEvidenceMap := make(map[string]struct{}, len(evidence))
 	for _, ev := range evidence {
 		if evpool.isPending(ev) {
@@ -402,6 +466,10 @@ func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
 		if err := evpool.evidenceStore.Set(key, evBytes); err != nil {
 			evpool.logger.Error("Unable to save committed evidence", "err", err, "key(height/hash)", key)
 		}
+
+		if err := evpool.indexCommittedEvidence(ev, height); err != nil {
+			evpool.logger.Error("Unable to index committed evidence", "err", err, "hash", ev.Hash())
+		}
 	}
 
 	// remove committed evidence from the clist
@@ -410,6 +478,37 @@ func (evpool *Pool) markEvidenceAsCommitted(evidence types.EvidenceList) {
 	}
 }
 
+// indexCommittedEvidence records the height at which the evidence was
+// committed, and the evidence itself for each validator it is against.
+func (evpool *Pool) indexCommittedEvidence(ev types.Evidence, height int64) error {
+	evpb, err := types.EvidenceToProto(ev)
+	if err != nil {
+		return cmterrors.ErrMsgToProto{MessageName: "Evidence", Err: err}
+	}
+	evBytes, err := evpb.Marshal()
+	if err != nil {
+		return fmt.Errorf("unable to marshal evidence: %w", err)
+	}
+	heightBytes, err := proto.Marshal(&gogotypes.Int64Value{Value: height})
+	if err != nil {
+		return fmt.Errorf("unable to marshal height: %w", err)
+	}
+
+	batch := evpool.evidenceStore.NewBatch()
+	defer batch.Close()
+
+	if err := batch.Set(evpool.dbKeyLayout.CalcKeyCommittedByHash(ev.Hash()), heightBytes); err != nil {
+		return err
+	}
+	for _, misbehavior := range ev.ABCI() {
+		key := evpool.dbKeyLayout.CalcKeyCommittedByValidator(misbehavior.Validator.Address, ev.Height(), ev.Hash())
+		if err := batch.Set(key, evBytes); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
 // listEvidence retrieves lists evidence from oldest to newest within maxBytes.
 // If maxBytes is -1, there's no cap on the size of returned evidence.
 func (evpool *Pool) listEvidence(prefixKey []byte, maxBytes int64) ([]types.Evidence, int64, error) {
@@ -507,9 +606,9 @@ func (evpool *Pool) updateState(state sm.State) {
 	evpool.state = state
 }
 
-// processConsensusBuffer converts all the duplicate votes witnessed from consensus
-// into DuplicateVoteEvidence. It sets the evidence timestamp to the block height
-// from the most recently committed block.
+// processConsensusBuffer converts all the duplicate votes and proposals witnessed from
+// consensus into DuplicateVoteEvidence and DuplicateProposalEvidence. It sets the evidence
+// timestamp to the block height from the most recently committed block.
 // Evidence is then added to the pool so as to be ready to be broadcasted and proposed.
 func (evpool *Pool) processConsensusBuffer(state sm.State) {
 	evpool.mtx.Lock()
@@ -517,77 +616,85 @@ func (evpool *Pool) processConsensusBuffer(state sm.State) {
 	for _, voteSet := range evpool.consensusBuffer {
 		// Check the height of the conflicting votes and fetch the corresponding time and validator set
 		// to produce the valid evidence
-		var (
-			dve *types.DuplicateVoteEvidence
-			err error
-		)
-		switch {
-		case voteSet.VoteA.Height == state.LastBlockHeight:
-			dve, err = types.NewDuplicateVoteEvidence(
-				voteSet.VoteA,
-				voteSet.VoteB,
-				state.LastBlockTime,
-				state.LastValidators,
-			)
-
-		case voteSet.VoteA.Height < state.LastBlockHeight:
-			var valSet *types.ValidatorSet
-			valSet, err = evpool.stateDB.LoadValidators(voteSet.VoteA.Height)
-			if err != nil {
-				evpool.logger.Error("failed to load validator set for conflicting votes", "height",
-					voteSet.VoteA.Height, "err", err,
-				)
-				continue
-			}
-			blockMeta := evpool.blockStore.LoadBlockMeta(voteSet.VoteA.Height)
-			if blockMeta == nil {
-				evpool.logger.Error("failed to load block time for conflicting votes", "height", voteSet.VoteA.Height)
-				continue
-			}
-			dve, err = types.NewDuplicateVoteEvidence(
-				voteSet.VoteA,
-				voteSet.VoteB,
-				blockMeta.Header.Time,
-				valSet,
-			)
-
-		default:
-			// evidence pool shouldn't expect to get votes from consensus of a height that is above the current
-			// state. If this error is seen then perhaps consider keeping the votes in the buffer and retry
-			// in following heights
-			evpool.logger.Error("inbound duplicate votes from consensus are of a greater height than current state",
-				"duplicate vote height", voteSet.VoteA.Height,
-				"state.LastBlockHeight", state.LastBlockHeight)
+		blockTime, valSet, err := evpool.consensusEvidenceContext(state, voteSet.VoteA.Height)
+		if err != nil {
+			evpool.logger.Error("failed to load context of conflicting votes", "height", voteSet.VoteA.Height, "err", err)
 			continue
 		}
+		dve, err := types.NewDuplicateVoteEvidence(voteSet.VoteA, voteSet.VoteB, blockTime, valSet)
 		if err != nil {
 			evpool.logger.Error("error in generating evidence from votes", "err", err)
 			continue
 		}
-
-		// check if we already have this evidence
-		if evpool.isPending(dve) {
-			evpool.logger.Info("evidence already pending; ignoring", "evidence", dve)
+		evpool.addConsensusEvidence(dve)
+	}
+	for _, proposalSet := range evpool.consensusProposalBuffer {
+		blockTime, valSet, err := evpool.consensusEvidenceContext(state, proposalSet.ProposalA.Height)
+		if err != nil {
+			evpool.logger.Error("failed to load context of conflicting proposals", "height", proposalSet.ProposalA.Height, "err", err)
 			continue
 		}
-
-		// check that the evidence is not already committed on chain
-		if evpool.isCommitted(dve) {
-			evpool.logger.Info("evidence already committed; ignoring", "evidence", dve)
+		dpe, err := types.NewDuplicateProposalEvidence(proposalSet.ProposalA, proposalSet.ProposalB, blockTime, valSet)
+		if err != nil {
+			evpool.logger.Error("error in generating evidence from proposals", "err", err)
 			continue
 		}
+		evpool.addConsensusEvidence(dpe)
+	}
+	// reset consensus buffers
+	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
+	evpool.consensusProposalBuffer = make([]duplicateProposalSet, 0)
+}
 
-		if err := evpool.addPendingEvidence(dve); err != nil {
-			evpool.logger.Error("failed to flush evidence from consensus buffer to pending list: %w", err)
-			continue
+// consensusEvidenceContext returns the time of the block at height and the validator set at
+// height, which are needed to form evidence of misbehavior witnessed by consensus at height.
+func (evpool *Pool) consensusEvidenceContext(state sm.State, height int64) (time.Time, *types.ValidatorSet, error) {
+	switch {
+	case height == state.LastBlockHeight:
+		return state.LastBlockTime, state.LastValidators, nil
+
+	case height < state.LastBlockHeight:
+		valSet, err := evpool.stateDB.LoadValidators(height)
+		if err != nil {
+			return time.Time{}, nil, fmt.Errorf("failed to load validator set: %w", err)
+		}
+		blockMeta := evpool.blockStore.LoadBlockMeta(height)
+		if blockMeta == nil {
+			return time.Time{}, nil, errors.New("failed to load block time")
 		}
+		return blockMeta.Header.Time, valSet, nil
+
+	default:
+		// evidence pool shouldn't expect to get votes or proposals from consensus of a height that is
+		// above the current state. If this error is seen then perhaps consider keeping them in the
+		// buffer and retry in following heights
+		return time.Time{}, nil, fmt.Errorf("height is greater than the last block height %d", state.LastBlockHeight)
+	}
+}
 
-		evpool.evidenceList.PushBack(dve)
+// addConsensusEvidence adds evidence formed from consensus to the pool, unless it is already
+// pending or committed.
+func (evpool *Pool) addConsensusEvidence(ev types.Evidence) {
+	// check if we already have this evidence
+	if evpool.isPending(ev) {
+		evpool.logger.Info("evidence already pending; ignoring", "evidence", ev)
+		return
+	}
 
-		evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", dve)
+	// check that the evidence is not already committed on chain
+	if evpool.isCommitted(ev) {
+		evpool.logger.Info("evidence already committed; ignoring", "evidence", ev)
+		return
 	}
-	// reset consensus buffer
-	evpool.consensusBuffer = make([]duplicateVoteSet, 0)
+
+	if err := evpool.addPendingEvidence(ev); err != nil {
+		evpool.logger.Error("failed to flush evidence from consensus buffer to pending list", "err", err)
+		return
+	}
+
+	evpool.evidenceList.PushBack(ev)
+
+	evpool.logger.Info("verified new evidence of byzantine behavior", "evidence", ev)
 }
 
 type duplicateVoteSet struct {
@@ -595,6 +702,11 @@ type duplicateVoteSet struct {
 	VoteB *types.Vote
 }
 
+type duplicateProposalSet struct {
+	ProposalA *types.Proposal
+	ProposalB *types.Proposal
+}
+
 func bytesToEv(evBytes []byte) (types.Evidence, error) {
 	var evpb cmtproto.Evidence
 	err := evpb.Unmarshal(evBytes)
@@ -619,6 +731,14 @@ type KeyLayout interface {
 	PrefixToBytesPending() []byte
 
 	PrefixToBytesCommitted() []byte
+
+	CalcKeyCommittedByHash(hash []byte) []byte
+
+	CalcKeyCommittedByValidator(address []byte, height int64, hash []byte) []byte
+
+	// PrefixToBytesCommittedByValidator returns the first key of the evidence
+	// committed against the validator for misbehavior at the given height.
+	PrefixToBytesCommittedByValidator(address []byte, height int64) []byte
 }
 
 type v1LegacyLayout struct{}
@@ -643,6 +763,21 @@ func (v1LegacyLayout) CalcKeyPending(evidence types.Evidence) []byte {
 	return append([]byte{baseKeyPending}, keySuffix(evidence)...)
 }
 
+// CalcKeyCommittedByHash implements EvidenceKeyLayout.
+func (v1LegacyLayout) CalcKeyCommittedByHash(hash []byte) []byte {
+	return append([]byte{baseKeyCommittedByHash}, []byte(fmt.Sprintf("%X", hash))...)
+}
+
+// CalcKeyCommittedByValidator implements EvidenceKeyLayout.
+func (v1LegacyLayout) CalcKeyCommittedByValidator(address []byte, height int64, hash []byte) []byte {
+	return append([]byte{baseKeyCommittedByValidator}, []byte(fmt.Sprintf("%X/%s/%X", address, bE(height), hash))...)
+}
+
+// PrefixToBytesCommittedByValidator implements EvidenceKeyLayout.
+func (v1LegacyLayout) PrefixToBytesCommittedByValidator(address []byte, height int64) []byte {
+	return append([]byte{baseKeyCommittedByValidator}, []byte(fmt.Sprintf("%X/%s", address, bE(height)))...)
+}
+
 var _ KeyLayout = (*v1LegacyLayout)(nil)
 
 type v2Layout struct{}
@@ -683,6 +818,33 @@ func (v2Layout) CalcKeyPending(evidence types.Evidence) []byte {
 	return key
 }
 
+// CalcKeyCommittedByHash implements EvidenceKeyLayout.
+func (v2Layout) CalcKeyCommittedByHash(hash []byte) []byte {
+	key, err := orderedcode.Append(nil, prefixCommittedByHash, string(hash))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// CalcKeyCommittedByValidator implements EvidenceKeyLayout.
+func (v2Layout) CalcKeyCommittedByValidator(address []byte, height int64, hash []byte) []byte {
+	key, err := orderedcode.Append(nil, prefixCommittedByValidator, string(address), height, string(hash))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// PrefixToBytesCommittedByValidator implements EvidenceKeyLayout.
+func (v2Layout) PrefixToBytesCommittedByValidator(address []byte, height int64) []byte {
+	key, err := orderedcode.Append(nil, prefixCommittedByValidator, string(address), height)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
 var _ KeyLayout = (*v2Layout)(nil)
 
 // -------- Util ---------
@@ -700,12 +862,16 @@ func keySuffix(evidence types.Evidence) []byte {
 // ---- v2 layout ----.
 const (
 	// prefixes must be unique across all db's.
-	prefixCommitted = int64(9)
-	prefixPending   = int64(10)
+	prefixCommitted            = int64(9)
+	prefixPending              = int64(10)
+	prefixCommittedByHash      = int64(13)
+	prefixCommittedByValidator = int64(14)
 )
 
 // ---- v1 layout ----.
 const (
-	baseKeyCommitted = byte(0x00)
-	baseKeyPending   = byte(0x01)
+	baseKeyCommitted            = byte(0x00)
+	baseKeyPending              = byte(0x01)
+	baseKeyCommittedByHash      = byte(0x02)
+	baseKeyCommittedByValidator = byte(0x03)
 )
@@ -1,6 +1,7 @@
 package evidence_test
 
 import (
+	"math"
 	"os"
 	"testing"
 	"time"
@@ -184,6 +185,37 @@ func TestReportConflictingVotes(t *testing.T) {
 	require.NotNil(t, next)
 }
 
+func TestReportConflictingProposals(t *testing.T) {
+	var height int64 = 10
+
+	pool, pv := defaultTestPool(t, height)
+	val := types.NewValidator(pv.PrivKey.PubKey(), 10)
+	ev, err := types.NewMockDuplicateProposalEvidenceWithValidator(height+1, defaultEvidenceTime, pv, evidenceChainID)
+	require.NoError(t, err)
+
+	// the proposals don't need to be reported in order
+	pool.ReportConflictingProposals(ev.ProposalB, ev.ProposalA)
+
+	// evidence from consensus should not be added immediately but reside in the consensus buffer
+	evList, evSize := pool.PendingEvidence(defaultEvidenceMaxBytes)
+	require.Empty(t, evList)
+	require.Zero(t, evSize)
+
+	// move to next height and update state and evidence pool
+	state := pool.State()
+	state.LastBlockHeight++
+	state.LastBlockTime = ev.Time()
+	state.LastValidators = types.NewValidatorSet([]*types.Validator{val})
+	pool.Update(state, []types.Evidence{})
+
+	// should be able to retrieve evidence from pool
+	evList, _ = pool.PendingEvidence(defaultEvidenceMaxBytes)
+	require.Equal(t, []types.Evidence{ev}, evList)
+
+	next := pool.EvidenceFront()
+	require.NotNil(t, next)
+}
+
 func TestEvidencePoolUpdate(t *testing.T) {
 	height := int64(21)
 	pool, val := defaultTestPool(t, height)
@@ -219,6 +251,65 @@ func TestEvidencePoolUpdate(t *testing.T) {
 	}
 }
 
+func TestCommittedEvidenceQueries(t *testing.T) {
+	for _, layout := range []string{"v1", "v2"} {
+		t.Run(layout, func(t *testing.T) {
+			height := int64(10)
+			val := types.NewMockPV()
+			valAddress := val.PrivKey.PubKey().Address()
+			stateStore := initializeValidatorState(val, height)
+			state, err := stateStore.Load()
+			require.NoError(t, err)
+			blockStore, err := initializeBlockStore(dbm.NewMemDB(), state, valAddress)
+			require.NoError(t, err)
+			pool, err := evidence.NewPool(dbm.NewMemDB(), stateStore, blockStore, evidence.WithDBKeyLayout(layout))
+			require.NoError(t, err)
+
+			var evList types.EvidenceList
+			for _, evHeight := range []int64{3, 5, 8} {
+				ev, err := types.NewMockDuplicateVoteEvidenceWithValidator(evHeight,
+					defaultEvidenceTime.Add(time.Duration(evHeight)*time.Minute), val, evidenceChainID)
+				require.NoError(t, err)
+				require.NoError(t, pool.AddEvidence(ev))
+				evList = append(evList, ev)
+			}
+			pending, _ := pool.PendingEvidence(-1)
+			assert.Len(t, pending, 3)
+
+			_, ok, err := pool.CommittedEvidenceHeight(evList[0].Hash())
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			state.LastBlockHeight = height + 1
+			state.LastBlockTime = defaultEvidenceTime.Add(11 * time.Minute)
+			pool.Update(state, evList[:2])
+
+			committedHeight, ok, err := pool.CommittedEvidenceHeight(evList[1].Hash())
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, height+1, committedHeight)
+			_, ok, err = pool.CommittedEvidenceHeight(evList[2].Hash())
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			found, err := pool.SearchCommittedEvidence(valAddress, 1, height)
+			require.NoError(t, err)
+			assert.Equal(t, []types.Evidence(evList[:2]), found)
+			found, err = pool.SearchCommittedEvidence(valAddress, 4, 5)
+			require.NoError(t, err)
+			assert.Equal(t, []types.Evidence{evList[1]}, found)
+			found, err = pool.SearchCommittedEvidence(valAddress, 6, math.MaxInt64)
+			require.NoError(t, err)
+			assert.Empty(t, found)
+			found, err = pool.SearchCommittedEvidence(types.NewMockPV().PrivKey.PubKey().Address(), 1, height)
+			require.NoError(t, err)
+			assert.Empty(t, found)
+			_, err = pool.SearchCommittedEvidence(valAddress, 5, 4)
+			require.Error(t, err)
+		})
+	}
+}
+
 func TestVerifyPendingEvidencePasses(t *testing.T) {
 	var height int64 = 1
 	pool, val := defaultTestPool(t, height)
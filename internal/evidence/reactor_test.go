@@ -65,6 +65,26 @@ func TestReactorBroadcastEvidence(t *testing.T) {
 	waitForEvidence(t, evList, pools)
 }
 
+func TestReactorBroadcastDuplicateProposalEvidence(t *testing.T) {
+	config := cfg.TestConfig()
+
+	val := types.NewMockPV()
+	height := int64(10)
+	stateDBs := []sm.Store{initializeValidatorState(val, height), initializeValidatorState(val, height)}
+	reactors, pools := makeAndConnectReactorsAndPools(config, stateDBs)
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			peer.Set(types.PeerStateKey, peerState{height})
+		}
+	}
+
+	ev, err := types.NewMockDuplicateProposalEvidenceWithValidator(height-1,
+		time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), val, evidenceChainID)
+	require.NoError(t, err)
+	require.NoError(t, pools[0].AddEvidence(ev))
+	waitForEvidence(t, types.EvidenceList{ev}, pools)
+}
+
 // We have two evidence reactors connected to one another but are at different heights.
 // Reactor 1 which is ahead receives a number of evidence. It should only send the evidence
 // that is below the height of the peer to that peer.
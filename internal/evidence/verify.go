@@ -54,6 +54,13 @@ func (evpool *Pool) verify(evidence types.Evidence) error {
 		}
 		return VerifyDuplicateVote(ev, state.ChainID, valSet)
 
+	case *types.DuplicateProposalEvidence:
+		valSet, err := evpool.stateDB.LoadValidators(evidence.Height())
+		if err != nil {
+			return err
+		}
+		return VerifyDuplicateProposal(ev, state.ChainID, valSet)
+
 	case *types.LightClientAttackEvidence:
 		commonHeader, err := getSignedHeader(evpool.blockStore, evidence.Height())
 		if err != nil {
@@ -213,6 +220,56 @@ func VerifyDuplicateVote(e *types.DuplicateVoteEvidence, chainID string, valSet
 	return nil
 }
 
+// VerifyDuplicateProposal verifies DuplicateProposalEvidence against the state of full node. This
+// involves the following checks:
+//   - the validator is the proposer of the round of the proposals, given the validator set at their height
+//   - the height and round of the proposals must be the same
+//   - the block ID's must be different
+//   - The signatures must both be valid
+func VerifyDuplicateProposal(e *types.DuplicateProposalEvidence, chainID string, valSet *types.ValidatorSet) error {
+	if valSet.IsNilOrEmpty() {
+		return ErrAddressNotValidatorAtHeight{Address: e.ValidatorAddress, Height: e.Height()}
+	}
+	proposer := valSet.GetProposer()
+	if e.ProposalA.Round > 0 {
+		proposer = valSet.CopyIncrementProposerPriority(e.ProposalA.Round).GetProposer()
+	}
+	if !bytes.Equal(proposer.Address, e.ValidatorAddress) {
+		return ErrAddressNotProposerAtRound{Address: e.ValidatorAddress, Height: e.Height(), Round: e.ProposalA.Round}
+	}
+	pubKey := proposer.PubKey
+
+	// H/R must be the same
+	if e.ProposalA.Height != e.ProposalB.Height || e.ProposalA.Round != e.ProposalB.Round {
+		return ErrDuplicateProposalHRMismatch{*e.ProposalA, *e.ProposalB}
+	}
+
+	// BlockIDs must be different
+	if e.ProposalA.BlockID.Equals(e.ProposalB.BlockID) {
+		return ErrSameBlockIDs{e.ProposalA.BlockID}
+	}
+
+	// validator voting power and total voting power must match
+	if proposer.VotingPower != e.ValidatorPower {
+		return ErrVotingPowerDoesNotMatch{TrustedVotingPower: proposer.VotingPower, EvidenceVotingPower: e.ValidatorPower}
+	}
+	if valSet.TotalVotingPower() != e.TotalVotingPower {
+		return ErrVotingPowerDoesNotMatch{TrustedVotingPower: valSet.TotalVotingPower(), EvidenceVotingPower: e.TotalVotingPower}
+	}
+
+	pa := e.ProposalA.ToProto()
+	pb := e.ProposalB.ToProto()
+	// Signatures must be valid
+	if !pubKey.VerifySignature(types.ProposalSignBytes(chainID, pa), e.ProposalA.Signature) {
+		return fmt.Errorf("verifying ProposalA: %w", types.ErrInvalidProposalSignature)
+	}
+	if !pubKey.VerifySignature(types.ProposalSignBytes(chainID, pb), e.ProposalB.Signature) {
+		return fmt.Errorf("verifying ProposalB: %w", types.ErrInvalidProposalSignature)
+	}
+
+	return nil
+}
+
 // validateABCIEvidence validates the ABCI component of the light client attack
 // evidence i.e voting power and byzantine validators.
 func validateABCIEvidence(
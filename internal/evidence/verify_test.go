@@ -443,6 +443,93 @@ func TestVerifyDuplicateVoteEvidence(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestVerifyDuplicateProposalEvidence(t *testing.T) {
+	val := types.NewMockPV()
+	val2 := types.NewMockPV()
+	valSet := types.NewValidatorSet([]*types.Validator{val.ExtractIntoValidator(1)})
+
+	blockID := makeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
+	blockID2 := makeBlockID([]byte("blockhash2"), 1000, []byte("partshash"))
+
+	const chainID = "mychain"
+
+	makeProposal := func(pv types.PrivValidator, chainID string, height int64, round int32, blockID types.BlockID) *types.Proposal {
+		proposal := types.NewProposal(height, round, -1, blockID, defaultEvidenceTime)
+		p := proposal.ToProto()
+		require.NoError(t, pv.SignProposal(chainID, p))
+		proposal.Signature = p.Signature
+		return proposal
+	}
+	proposal1 := makeProposal(val, chainID, 10, 2, blockID)
+
+	cases := []struct {
+		proposal2 *types.Proposal
+		valid     bool
+	}{
+		{makeProposal(val, chainID, 10, 2, blockID2), true},
+		{makeProposal(val, chainID, 10, 2, blockID), false},     // same block id
+		{makeProposal(val, "mychain2", 10, 2, blockID2), false}, // wrong chain id
+		{makeProposal(val, chainID, 11, 2, blockID2), false},    // wrong height
+		{makeProposal(val, chainID, 10, 3, blockID2), false},    // wrong round
+		{makeProposal(val2, chainID, 10, 2, blockID2), false},   // signed by wrong key
+	}
+	for _, c := range cases {
+		ev := &types.DuplicateProposalEvidence{
+			ProposalA:        proposal1,
+			ProposalB:        c.proposal2,
+			ValidatorAddress: val.PrivKey.PubKey().Address(),
+			ValidatorPower:   1,
+			TotalVotingPower: 1,
+			Timestamp:        defaultEvidenceTime,
+		}
+		if c.valid {
+			require.NoError(t, evidence.VerifyDuplicateProposal(ev, chainID, valSet), "evidence should be valid")
+		} else {
+			require.Error(t, evidence.VerifyDuplicateProposal(ev, chainID, valSet), "evidence should be invalid")
+		}
+	}
+
+	// the validator must be the proposer of the round
+	twoVals := types.NewValidatorSet([]*types.Validator{val.ExtractIntoValidator(1), val2.ExtractIntoValidator(1)})
+	nonProposer := val
+	if bytes.Equal(twoVals.GetProposer().Address, val.PrivKey.PubKey().Address()) {
+		nonProposer = val2
+	}
+	ev, err := types.NewMockDuplicateProposalEvidenceWithValidator(10, defaultEvidenceTime, nonProposer, chainID)
+	require.NoError(t, err)
+	ev.ValidatorPower, ev.TotalVotingPower = 1, 2
+	require.ErrorAs(t, evidence.VerifyDuplicateProposal(ev, chainID, twoVals), &evidence.ErrAddressNotProposerAtRound{})
+
+	// create good evidence and correct validator power
+	goodEv, err := types.NewMockDuplicateProposalEvidenceWithValidator(10, defaultEvidenceTime, val, chainID)
+	require.NoError(t, err)
+	goodEv.ValidatorPower = 1
+	goodEv.TotalVotingPower = 1
+	badEv, err := types.NewMockDuplicateProposalEvidenceWithValidator(10, defaultEvidenceTime, val, chainID)
+	require.NoError(t, err)
+	state := sm.State{
+		ChainID:         chainID,
+		LastBlockTime:   defaultEvidenceTime.Add(1 * time.Minute),
+		LastBlockHeight: 11,
+		ConsensusParams: *types.DefaultConsensusParams(),
+	}
+	stateStore := &smmocks.Store{}
+	stateStore.On("LoadValidators", int64(10)).Return(valSet, nil)
+	stateStore.On("Load").Return(state, nil)
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("LoadBlockMeta", int64(10)).Return(&types.BlockMeta{Header: types.Header{Time: defaultEvidenceTime}})
+
+	pool, err := evidence.NewPool(dbm.NewMemDB(), stateStore, blockStore)
+	require.NoError(t, err)
+
+	err = pool.CheckEvidence(types.EvidenceList{goodEv})
+	require.NoError(t, err)
+
+	// evidence with a different validator power should fail
+	err = pool.CheckEvidence(types.EvidenceList{badEv})
+	require.Error(t, err)
+}
+
 func makeLunaticEvidence(
 	t *testing.T,
 	height, commonHeight int64,
@@ -151,6 +151,10 @@ type Client struct {
 	// Highest trusted light block from the store (height=H).
 	latestTrustedBlock *types.LightBlock
 
+	// Outcome of the last cross-check against the witnesses.
+	divergenceMtx       cmtsync.Mutex
+	lastDivergenceCheck DivergenceCheck
+
 	// See RemoveNoLongerTrustedHeadersPeriod option
 	pruningSize uint16
 	// See ConfirmationFunction option
@@ -24,7 +24,7 @@ import (
 //
 // If there are no conflicting headers, the light client deems the verified target header
 // trusted and saves it to the trusted store.
-func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.LightBlock, now time.Time) error {
+func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.LightBlock, now time.Time) (err error) {
 	if primaryTrace == nil || len(primaryTrace) < 2 {
 		return ErrNilOrSinglePrimaryTrace
 	}
@@ -34,6 +34,9 @@ func (c *Client) detectDivergence(ctx context.Context, primaryTrace []*types.Lig
 		lastVerifiedHeader = lastVerifiedBlock.SignedHeader
 		witnessesToRemove  = make([]int, 0)
 	)
+	defer func() {
+		c.setLastDivergenceCheck(lastVerifiedHeader.Height, now, err)
+	}()
 	c.logger.Debug("Running detector against trace", "finalizeBlockHeight", lastVerifiedHeader.Height,
 		"endBlockHash", lastVerifiedHeader.Hash, "length", len(primaryTrace))
 
@@ -357,6 +357,57 @@ func TestClientDivergentTraces2(t *testing.T) {
 	assert.Len(t, c.Witnesses(), 3)
 }
 
+func TestClientStatus(t *testing.T) {
+	_, primaryHeaders, primaryVals := genMockNode(10, 5, 2, bTime)
+	primary := mockp.New(chainID, primaryHeaders, primaryVals)
+	firstBlock, err := primary.LightBlock(ctx, 1)
+	require.NoError(t, err)
+
+	_, mockHeaders, mockVals := genMockNode(10, 5, 2, bTime)
+	mockHeaders[1] = primaryHeaders[1]
+	mockVals[1] = primaryVals[1]
+	divergentWitness := mockp.New(chainID, mockHeaders, mockVals)
+
+	c, err := light.NewClient(
+		ctx,
+		chainID,
+		light.TrustOptions{
+			Height: 1,
+			Hash:   firstBlock.Hash(),
+			Period: 4 * time.Hour,
+		},
+		primary,
+		[]provider.Provider{primary, divergentWitness},
+		dbs.New(dbm.NewMemDB(), chainID),
+		light.Logger(log.TestingLogger()),
+		light.MaxRetryAttempts(1),
+	)
+	require.NoError(t, err)
+
+	status, err := c.Status()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, status.TrustedHeight)
+	assert.Equal(t, firstBlock.Hash(), status.TrustedHash)
+	assert.NotEmpty(t, status.Primary)
+	assert.Len(t, status.Witnesses, 2)
+	assert.Nil(t, status.LastDivergenceCheck)
+
+	// The divergent witness can't back its header with a valid trace, so it's
+	// removed, and the header matching the primary's one is trusted.
+	now := bTime.Add(1 * time.Hour)
+	_, err = c.VerifyLightBlockAtHeight(ctx, 5, now)
+	require.NoError(t, err)
+
+	status, err = c.Status()
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, status.TrustedHeight)
+	assert.Len(t, status.Witnesses, 1)
+	require.NotNil(t, status.LastDivergenceCheck)
+	assert.EqualValues(t, 5, status.LastDivergenceCheck.Height)
+	assert.Equal(t, now, status.LastDivergenceCheck.Time)
+	assert.Empty(t, status.LastDivergenceCheck.Error)
+}
+
 // 3. witness has the same first header, but different second header
 // => creation should succeed, but the verification should fail.
 func TestClientDivergentTraces3(t *testing.T) {
@@ -0,0 +1,145 @@
+package proxy
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	blocksvc "github.com/cometbft/cometbft/api/cometbft/services/block/v1"
+	brs "github.com/cometbft/cometbft/api/cometbft/services/block_results/v1"
+	"github.com/cometbft/cometbft/libs/log"
+	lrpc "github.com/cometbft/cometbft/light/rpc"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// latestHeightPollInterval is how often the light client is updated while
+// streaming the latest height.
+const latestHeightPollInterval = time.Second
+
+type blockService struct {
+	client *lrpc.Client
+	lc     lrpc.LightClient
+	logger log.Logger
+}
+
+// newBlockService creates a BlockService server, which serves blocks verified
+// by the light client.
+func newBlockService(client *lrpc.Client, lc lrpc.LightClient, logger log.Logger) blocksvc.BlockServiceServer {
+	return &blockService{
+		client: client,
+		lc:     lc,
+		logger: logger.With("service", "BlockService"),
+	}
+}
+
+// GetByHeight implements v1.BlockServiceServer GetByHeight method.
+func (s *blockService) GetByHeight(ctx context.Context, req *blocksvc.GetByHeightRequest) (*blocksvc.GetByHeightResponse, error) {
+	logger := s.logger.With("endpoint", "GetByHeight")
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Height cannot be zero or negative")
+	}
+
+	res, err := s.client.Block(ctx, &req.Height)
+	if err != nil {
+		logger.Error("Error fetching verified block", "height", req.Height, "err", err)
+		return nil, status.Errorf(codes.Internal, "Cannot get verified block at height %d: %v", req.Height, err)
+	}
+	bp, err := res.Block.ToProto()
+	if err != nil {
+		logger.Error("Error attempting to convert block to its Protobuf representation", "height", req.Height, "err", err)
+		return nil, status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	blockID := res.BlockID.ToProto()
+
+	return &blocksvc.GetByHeightResponse{
+		BlockId: &blockID,
+		Block:   bp,
+	}, nil
+}
+
+// GetLatestHeight implements v1.BlockServiceServer GetLatestHeight method. It
+// streams the latest height verified by the light client, which it updates
+// every latestHeightPollInterval.
+func (s *blockService) GetLatestHeight(_ *blocksvc.GetLatestHeightRequest, stream blocksvc.BlockService_GetLatestHeightServer) error {
+	logger := s.logger.With("endpoint", "GetLatestHeight")
+	ticker := time.NewTicker(latestHeightPollInterval)
+	defer ticker.Stop()
+
+	var lastSent int64
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Stream canceled")
+		case <-ticker.C:
+		}
+
+		// Other streams may update the light client too, so send the latest
+		// trusted height rather than the block returned by Update, if any.
+		if _, err := s.lc.Update(stream.Context(), cmttime.Now()); err != nil {
+			logger.Error("Failed to update light client", "err", err)
+			continue
+		}
+		l, err := s.lc.TrustedLightBlock(0)
+		if err != nil {
+			logger.Error("Failed to get latest trusted light block", "err", err)
+			continue
+		}
+		if l.Height <= lastSent {
+			continue
+		}
+		if err := stream.Send(&blocksvc.GetLatestHeightResponse{Height: l.Height}); err != nil {
+			logger.Error("Failed to stream new block", "err", err, "height", l.Height)
+			return status.Error(codes.Unavailable, "Cannot send stream response")
+		}
+		lastSent = l.Height
+	}
+}
+
+type blockResultsService struct {
+	client *lrpc.Client
+	logger log.Logger
+}
+
+// newBlockResultsService creates a BlockResultsService server, which serves
+// block results whose tx results are verified by the light client.
+func newBlockResultsService(client *lrpc.Client, logger log.Logger) brs.BlockResultsServiceServer {
+	return &blockResultsService{
+		client: client,
+		logger: logger.With("service", "BlockResultsService"),
+	}
+}
+
+// GetBlockResults implements v1.BlockResultsServiceServer GetBlockResults
+// method. Only the tx results are verified, against the LastResultsHash of the
+// next header.
+func (s *blockResultsService) GetBlockResults(ctx context.Context, req *brs.GetBlockResultsRequest) (*brs.GetBlockResultsResponse, error) {
+	logger := s.logger.With("endpoint", "GetBlockResults")
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Height cannot be zero or negative")
+	}
+
+	res, err := s.client.BlockResults(ctx, &req.Height)
+	if err != nil {
+		logger.Error("Error fetching verified block results", "height", req.Height, "err", err)
+		return nil, status.Errorf(codes.Internal, "Cannot get verified block results at height %d: %v", req.Height, err)
+	}
+
+	return &brs.GetBlockResultsResponse{
+		Height:                res.Height,
+		TxResults:             res.TxResults,
+		FinalizeBlockEvents:   formatProtoToRef(res.FinalizeBlockEvents),
+		ValidatorUpdates:      formatProtoToRef(res.ValidatorUpdates),
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+		AppHash:               res.AppHash,
+	}, nil
+}
+
+func formatProtoToRef[T any](collection []T) []*T {
+	res := []*T{}
+	for i := range collection {
+		res = append(res, &collection[i])
+	}
+	return res
+}
@@ -2,11 +2,17 @@ package proxy
 
 import (
 	"context"
+	"errors"
 	"net"
 	"net/http"
 
+	"google.golang.org/grpc"
+
+	blocksvc "github.com/cometbft/cometbft/api/cometbft/services/block/v1"
+	brs "github.com/cometbft/cometbft/api/cometbft/services/block_results/v1"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/light"
 	lrpc "github.com/cometbft/cometbft/light/rpc"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
@@ -15,11 +21,12 @@ import (
 
 // A Proxy defines parameters for running an HTTP server proxy.
 type Proxy struct {
-	Addr     string // TCP address to listen on, ":http" if empty
-	Config   *rpcserver.Config
-	Client   *lrpc.Client
-	Logger   log.Logger
-	Listener net.Listener
+	Addr        string // TCP address to listen on, ":http" if empty
+	Config      *rpcserver.Config
+	Client      *lrpc.Client
+	LightClient *light.Client
+	Logger      log.Logger
+	Listener    net.Listener
 }
 
 // NewProxy creates the struct used to run an HTTP server for serving light
@@ -37,10 +44,11 @@ func NewProxy(
 	}
 
 	return &Proxy{
-		Addr:   listenAddr,
-		Config: config,
-		Client: lrpc.NewClient(rpcClient, lightClient, opts...),
-		Logger: logger,
+		Addr:        listenAddr,
+		Config:      config,
+		Client:      lrpc.NewClient(rpcClient, lightClient, opts...),
+		LightClient: lightClient,
+		Logger:      logger,
 	}, nil
 }
 
@@ -83,11 +91,32 @@ func (p *Proxy) ListenAndServeTLS(certFile, keyFile string) error {
 	)
 }
 
+// ServeGRPC serves the gRPC BlockService and BlockResultsService on the given
+// listener, with the data verified by the light client. Other services of the
+// gRPC API are not served, since their data can't be verified.
+//
+// It only returns upon error, e.g. once the listener is closed.
+func (p *Proxy) ServeGRPC(listener net.Listener) error {
+	if err := p.startClient(); err != nil {
+		return err
+	}
+
+	logger := p.Logger.With("module", "grpc-server")
+	server := grpc.NewServer()
+	blocksvc.RegisterBlockServiceServer(server, newBlockService(p.Client, p.LightClient, logger))
+	brs.RegisterBlockResultsServiceServer(server, newBlockResultsService(p.Client, logger))
+	logger.Info("Starting gRPC server", "addr", listener.Addr())
+	return server.Serve(listener)
+}
+
 func (p *Proxy) listen() (net.Listener, *http.ServeMux, error) {
 	mux := http.NewServeMux()
 
 	// 1) Register regular routes.
 	r := RPCRoutes(p.Client)
+	if p.LightClient != nil {
+		r["light_status"] = rpcserver.NewRPCFunc(makeLightStatusFunc(p.LightClient), "")
+	}
 	rpcserver.RegisterRPCFuncs(mux, r, p.Logger)
 
 	// 2) Allow websocket connections.
@@ -106,10 +135,8 @@ func (p *Proxy) listen() (net.Listener, *http.ServeMux, error) {
 	mux.HandleFunc("/v1/websocket", wm.WebsocketHandler)
 
 	// 3) Start a client.
-	if !p.Client.IsRunning() {
-		if err := p.Client.Start(); err != nil {
-			return nil, mux, ErrStartHTTPClient{Err: err}
-		}
+	if err := p.startClient(); err != nil {
+		return nil, mux, err
 	}
 
 	// 4) Start listening for new connections.
@@ -120,3 +147,15 @@ func (p *Proxy) listen() (net.Listener, *http.ServeMux, error) {
 
 	return listener, mux, nil
 }
+
+// startClient starts the client, unless it's running already, since both the
+// JSON-RPC and the gRPC servers use it.
+func (p *Proxy) startClient() error {
+	if p.Client.IsRunning() {
+		return nil
+	}
+	if err := p.Client.Start(); err != nil && !errors.Is(err, service.ErrAlreadyStarted) {
+		return ErrStartHTTPClient{Err: err}
+	}
+	return nil
+}
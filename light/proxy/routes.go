@@ -2,6 +2,7 @@ package proxy
 
 import (
 	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/light"
 	lrpc "github.com/cometbft/cometbft/light/rpc"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -36,7 +37,9 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page", rpcserver.Cacheable("height")),
 		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), ""),
 		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), ""),
+		"consensus_trace":      rpcserver.NewRPCFunc(makeConsensusTraceFunc(c), "height"),
 		"consensus_params":     rpcserver.NewRPCFunc(makeConsensusParamsFunc(c), "height", rpcserver.Cacheable("height")),
+		"state_sync_status":    rpcserver.NewRPCFunc(makeStateSyncStatusFunc(c), ""),
 		"unconfirmed_tx":       rpcserver.NewRPCFunc(makeUnconfirmedTxFunc(c), "hash"),
 		"unconfirmed_txs":      rpcserver.NewRPCFunc(makeUnconfirmedTxsFunc(c), "limit"),
 		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), ""),
@@ -52,6 +55,17 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 
 		// evidence API
 		"broadcast_evidence": rpcserver.NewRPCFunc(makeBroadcastEvidenceFunc(c), "evidence"),
+		"pending_evidence":   rpcserver.NewRPCFunc(makePendingEvidenceFunc(c), "limit"),
+		"committed_evidence": rpcserver.NewRPCFunc(makeCommittedEvidenceFunc(c), "hash"),
+		"evidence_search":    rpcserver.NewRPCFunc(makeEvidenceSearchFunc(c), "validator,min_height,max_height,page,per_page"),
+	}
+}
+
+type rpcLightStatusFunc func(ctx *rpctypes.Context) (*light.Status, error)
+
+func makeLightStatusFunc(lc *light.Client) rpcLightStatusFunc {
+	return func(*rpctypes.Context) (*light.Status, error) {
+		return lc.Status()
 	}
 }
 
@@ -224,6 +238,22 @@ func makeConsensusStateFunc(c *lrpc.Client) rpcConsensusStateFunc {
 	}
 }
 
+type rpcConsensusTraceFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusTrace, error)
+
+func makeConsensusTraceFunc(c *lrpc.Client) rpcConsensusTraceFunc {
+	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+		return c.ConsensusTrace(ctx.Context(), height)
+	}
+}
+
+type rpcStateSyncStatusFunc func(ctx *rpctypes.Context) (*ctypes.ResultStateSyncStatus, error)
+
+func makeStateSyncStatusFunc(c *lrpc.Client) rpcStateSyncStatusFunc {
+	return func(ctx *rpctypes.Context) (*ctypes.ResultStateSyncStatus, error) {
+		return c.StateSyncStatus(ctx.Context())
+	}
+}
+
 type rpcConsensusParamsFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusParams, error)
 
 func makeConsensusParamsFunc(c *lrpc.Client) rpcConsensusParamsFunc {
@@ -309,3 +339,37 @@ func makeBroadcastEvidenceFunc(c *lrpc.Client) rpcBroadcastEvidenceFunc {
 		return c.BroadcastEvidence(ctx.Context(), ev)
 	}
 }
+
+type rpcPendingEvidenceFunc func(ctx *rpctypes.Context, limit *int) (*ctypes.ResultPendingEvidence, error)
+
+func makePendingEvidenceFunc(c *lrpc.Client) rpcPendingEvidenceFunc {
+	return func(ctx *rpctypes.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+		return c.PendingEvidence(ctx.Context(), limit)
+	}
+}
+
+type rpcCommittedEvidenceFunc func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultCommittedEvidence, error)
+
+func makeCommittedEvidenceFunc(c *lrpc.Client) rpcCommittedEvidenceFunc {
+	return func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultCommittedEvidence, error) {
+		return c.CommittedEvidence(ctx.Context(), hash)
+	}
+}
+
+type rpcEvidenceSearchFunc func(
+	ctx *rpctypes.Context,
+	validator []byte,
+	minHeight, maxHeight *int64,
+	page, perPage *int,
+) (*ctypes.ResultEvidenceSearch, error)
+
+func makeEvidenceSearchFunc(c *lrpc.Client) rpcEvidenceSearchFunc {
+	return func(
+		ctx *rpctypes.Context,
+		validator []byte,
+		minHeight, maxHeight *int64,
+		page, perPage *int,
+	) (*ctypes.ResultEvidenceSearch, error) {
+		return c.EvidenceSearch(ctx.Context(), validator, minHeight, maxHeight, page, perPage)
+	}
+}
@@ -216,6 +216,10 @@ func (c *Client) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckT
 	return c.next.CheckTx(ctx, tx)
 }
 
+func (c *Client) SimulateTx(ctx context.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error) {
+	return c.next.SimulateTx(ctx, tx)
+}
+
 func (c *Client) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.next.NetInfo(ctx)
 }
@@ -228,6 +232,14 @@ func (c *Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusSta
 	return c.next.ConsensusState(ctx)
 }
 
+func (c *Client) ConsensusTrace(ctx context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.next.ConsensusTrace(ctx, height)
+}
+
+func (c *Client) StateSyncStatus(ctx context.Context) (*ctypes.ResultStateSyncStatus, error) {
+	return c.next.StateSyncStatus(ctx)
+}
+
 func (c *Client) ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	res, err := c.next.ConsensusParams(ctx, height)
 	if err != nil {
@@ -541,6 +553,23 @@ func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*cty
 	return c.next.BroadcastEvidence(ctx, ev)
 }
 
+func (c *Client) PendingEvidence(ctx context.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return c.next.PendingEvidence(ctx, limit)
+}
+
+func (c *Client) CommittedEvidence(ctx context.Context, hash []byte) (*ctypes.ResultCommittedEvidence, error) {
+	return c.next.CommittedEvidence(ctx, hash)
+}
+
+func (c *Client) EvidenceSearch(
+	ctx context.Context,
+	validator []byte,
+	minHeight, maxHeight *int64,
+	page, perPage *int,
+) (*ctypes.ResultEvidenceSearch, error) {
+	return c.next.EvidenceSearch(ctx, validator, minHeight, maxHeight, page, perPage)
+}
+
 func (c *Client) Subscribe(ctx context.Context, subscriber, query string,
 	outCapacity ...int,
 ) (out <-chan ctypes.ResultEvent, err error) {
@@ -0,0 +1,85 @@
+package light
+
+import (
+	"fmt"
+	"time"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+)
+
+// DivergenceCheck is the outcome of a cross-check of a verified header against
+// the headers of the witnesses.
+type DivergenceCheck struct {
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+	// Error is empty if at least one witness returned the same header, and
+	// none returned a conflicting one.
+	Error string `json:"error,omitempty"`
+}
+
+// Status is the state of the light client: the latest trusted header, the
+// providers it's connected to and the last cross-check of the primary against
+// the witnesses.
+type Status struct {
+	TrustedHeight int64             `json:"trusted_height"`
+	TrustedHash   cmtbytes.HexBytes `json:"trusted_hash"`
+	TrustedTime   time.Time         `json:"trusted_time"`
+
+	Primary   string   `json:"primary"`
+	Witnesses []string `json:"witnesses"`
+
+	// LastDivergenceCheck is nil if no header was cross-checked since the
+	// client started.
+	LastDivergenceCheck *DivergenceCheck `json:"last_divergence_check"`
+}
+
+// Status returns the status of the light client.
+//
+// Safe for concurrent use by multiple goroutines.
+func (c *Client) Status() (*Status, error) {
+	status := &Status{}
+
+	height, err := c.LastTrustedHeight()
+	if err != nil {
+		return nil, ErrGetLastTrustedHeight{Err: err}
+	}
+	if height > 0 {
+		l, err := c.trustedStore.LightBlock(height)
+		if err != nil {
+			return nil, err
+		}
+		status.TrustedHeight = l.Height
+		status.TrustedHash = l.Hash()
+		status.TrustedTime = l.Time
+	}
+
+	c.providerMutex.Lock()
+	if c.primary != nil {
+		status.Primary = fmt.Sprint(c.primary)
+	}
+	status.Witnesses = make([]string, len(c.witnesses))
+	for i, w := range c.witnesses {
+		status.Witnesses[i] = fmt.Sprint(w)
+	}
+	c.providerMutex.Unlock()
+
+	c.divergenceMtx.Lock()
+	if !c.lastDivergenceCheck.Time.IsZero() {
+		check := c.lastDivergenceCheck
+		status.LastDivergenceCheck = &check
+	}
+	c.divergenceMtx.Unlock()
+
+	return status, nil
+}
+
+func (c *Client) setLastDivergenceCheck(height int64, now time.Time, err error) {
+	check := DivergenceCheck{Height: height, Time: now}
+	if err != nil {
+		check.Error = err.Error()
+	}
+
+	c.divergenceMtx.Lock()
+	c.lastDivergenceCheck = check
+	c.divergenceMtx.Unlock()
+}
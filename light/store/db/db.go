@@ -3,6 +3,8 @@ package db
 import (
 	"encoding/binary"
 
+	lru "github.com/hashicorp/golang-lru/v2"
+
 	dbm "github.com/cometbft/cometbft-db"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
@@ -11,6 +13,12 @@ import (
 	cmterrors "github.com/cometbft/cometbft/types/errors"
 )
 
+// lightBlockCacheSize is the number of verified light blocks kept in memory,
+// so that serving recent headers and validator sets doesn't read them from the
+// db every time. They are kept encoded, and decoded on every read: light blocks
+// memoize hashes lazily, so they can't be shared between callers.
+const lightBlockCacheSize = 1000
+
 type dbs struct {
 	db     dbm.DB
 	prefix string
@@ -19,6 +27,8 @@ type dbs struct {
 	size uint16
 
 	dbKeyLayout LightStoreKeyLayout
+
+	lightBlockCache *lru.Cache[int64, []byte]
 }
 
 func isEmpty(db dbm.DB) bool {
@@ -72,6 +82,13 @@ func New(db dbm.DB, prefix string) store.Store {
 func NewWithDBVersion(db dbm.DB, prefix string, dbKeyVersion string) store.Store {
 	dbStore := &dbs{db: db, prefix: prefix}
 
+	var err error
+	// err can only occur if the size is non-positive, so is impossible here.
+	dbStore.lightBlockCache, err = lru.New[int64, []byte](lightBlockCacheSize)
+	if err != nil {
+		panic(err)
+	}
+
 	setDBKeyLayout(db, dbStore, dbKeyVersion)
 
 	size := uint16(0)
@@ -116,6 +133,7 @@ func (s *dbs) SaveLightBlock(lb *types.LightBlock) error {
 		return store.ErrStore{Err: err}
 	}
 	s.size++
+	s.lightBlockCache.Add(lb.Height, lbBz)
 
 	return nil
 }
@@ -144,6 +162,7 @@ func (s *dbs) DeleteLightBlock(height int64) error {
 		return store.ErrStore{Err: err}
 	}
 	s.size--
+	s.lightBlockCache.Remove(height)
 
 	return nil
 }
@@ -156,16 +175,20 @@ func (s *dbs) LightBlock(height int64) (*types.LightBlock, error) {
 		panic("negative or zero height")
 	}
 
-	bz, err := s.db.Get(s.lbKey(height))
-	if err != nil {
-		panic(err)
-	}
-	if len(bz) == 0 {
-		return nil, store.ErrLightBlockNotFound
+	bz, cached := s.lightBlockCache.Get(height)
+	if !cached {
+		var err error
+		bz, err = s.db.Get(s.lbKey(height))
+		if err != nil {
+			panic(err)
+		}
+		if len(bz) == 0 {
+			return nil, store.ErrLightBlockNotFound
+		}
 	}
 
 	var lbpb cmtproto.LightBlock
-	err = lbpb.Unmarshal(bz)
+	err := lbpb.Unmarshal(bz)
 	if err != nil {
 		return nil, store.ErrUnmarshal{Err: err}
 	}
@@ -174,6 +197,9 @@ func (s *dbs) LightBlock(height int64) (*types.LightBlock, error) {
 	if err != nil {
 		return nil, store.ErrProtoConversion{Err: err}
 	}
+	if !cached {
+		s.lightBlockCache.Add(height, bz)
+	}
 
 	return lightBlock, err
 }
@@ -303,6 +329,7 @@ func (s *dbs) Prune(size uint16) error {
 			if err = b.Delete(s.lbKey(height)); err != nil {
 				return store.ErrStore{Err: err}
 			}
+			s.lightBlockCache.Remove(height)
 		}
 		itr.Next()
 		numToPrune--
@@ -13,6 +13,7 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/light/store"
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
 	"github.com/cometbft/cometbft/version"
@@ -200,6 +201,73 @@ func Test_Prune(t *testing.T) {
 	assert.EqualValues(t, 7, dbStore.Size())
 }
 
+func Test_LightBlockCache(t *testing.T) {
+	db := dbm.NewMemDB()
+	dbStore := New(db, "Test_LightBlockCache")
+
+	for i := 1; i <= 3; i++ {
+		err := dbStore.SaveLightBlock(randLightBlock(int64(i)))
+		require.NoError(t, err)
+	}
+
+	// Cached light blocks are served without reading them from the db, and each
+	// caller gets its own copy.
+	key := dbStore.(*dbs).lbKey(1)
+	bz, err := db.Get(key)
+	require.NoError(t, err)
+	lb1, err := dbStore.LightBlock(1)
+	require.NoError(t, err)
+	err = db.Delete(key)
+	require.NoError(t, err)
+	lb2, err := dbStore.LightBlock(1)
+	require.NoError(t, err)
+	err = db.Set(key, bz)
+	require.NoError(t, err)
+	assert.Equal(t, lb1, lb2)
+	assert.NotSame(t, lb1, lb2)
+	assert.NotSame(t, lb1.ValidatorSet, lb2.ValidatorSet)
+
+	// Deleted and pruned light blocks are evicted.
+	err = dbStore.DeleteLightBlock(3)
+	require.NoError(t, err)
+	_, err = dbStore.LightBlock(3)
+	require.ErrorIs(t, err, store.ErrLightBlockNotFound)
+
+	err = dbStore.Prune(1)
+	require.NoError(t, err)
+	_, err = dbStore.LightBlock(1)
+	require.ErrorIs(t, err, store.ErrLightBlockNotFound)
+
+	// Light blocks of a reopened store are served from the db.
+	lb, err := New(db, "Test_LightBlockCache").LightBlock(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, lb.Height)
+}
+
+func Test_LightBlockConcurrentReads(t *testing.T) {
+	dbStore := New(dbm.NewMemDB(), "Test_LightBlockConcurrentReads")
+	err := dbStore.SaveLightBlock(randLightBlock(1))
+	require.NoError(t, err)
+
+	// Light blocks memoize hashes and the proposer lazily, which must not race
+	// between the callers reading the same cached light block.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lb, err := dbStore.LightBlock(1)
+			if !assert.NoError(t, err) {
+				return
+			}
+			lb.ValidatorSet.Hash()
+			lb.ValidatorSet.GetProposer()
+			lb.Commit.Hash()
+		}()
+	}
+	wg.Wait()
+}
+
 func Test_Concurrency(t *testing.T) {
 	dbStore := New(dbm.NewMemDB(), "Test_Prune")
 
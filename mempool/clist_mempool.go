@@ -2,6 +2,7 @@ package mempool
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"fmt"
 	"slices"
@@ -38,6 +39,12 @@ type CListMempool struct {
 	notifiedTxsAvailable atomic.Bool
 	txsAvailable         chan struct{} // fires once for each height, when the mempool is not empty
 	onNewTx              func(types.Tx)
+	onTxFromPeerAdded    func(nodekey.ID)
+	onTxExpired          TxExpiredCallback
+	onTxRejected         TxRejectedCallback
+	onTxEvicted          TxEvictedCallback
+	onTxRecheckFailed    TxRecheckFailedCallback
+	onTxReplaced         TxReplacedCallback
 
 	config *config.MempoolConfig
 
@@ -59,25 +66,35 @@ type CListMempool struct {
 	laneBytes map[LaneID]int64                // number of bytes per lane (for metrics)
 	txsBytes  int64                           // total size of mempool, in bytes
 	numTxs    int64                           // total number of txs in the mempool
+	quotas    *txsQuotas                      // txs contributed by each peer and sender
+
+	// Lanes, only replaced by UpdateLanes while holding both txsMtx and
+	// addTxChMtx, so that blocking iterators can read them with either one.
+	defaultLane LaneID
+	sortedLanes []lane // lanes sorted by priority, in descending order
 
 	addTxChMtx    cmtsync.RWMutex  // Protects the fields below
 	addTxCh       chan struct{}    // Blocks until the next TX is added
 	addTxSeq      int64            // Helps detect is new TXs have been added to a given lane
 	addTxLaneSeqs map[LaneID]int64 // Sequence of the last TX added to a given lane
-
-	// Immutable fields, only set during initialization.
-	defaultLane LaneID
-	sortedLanes []lane // lanes sorted by priority, in descending order
+	lanesVersion  int64            // Incremented each time the lanes are replaced
 
 	// Keep a cache of already-seen txs.
 	// This reduces the pressure on the proxyApp.
 	cache TxCache
 
+	// Optional on-disk journal of the added and removed txs, used to restore
+	// the mempool contents on restart.
+	journal *Journal
+
 	logger  log.Logger
 	metrics *Metrics
 }
 
-var _ Mempool = &CListMempool{}
+var (
+	_ Mempool      = &CListMempool{}
+	_ LanesUpdater = &CListMempool{}
+)
 
 // CListMempoolOption sets an optional parameter on the mempool.
 type CListMempoolOption func(*CListMempool)
@@ -112,6 +129,7 @@ func NewCListMempool(
 		proxyAppConn:  proxyAppConn,
 		txsMap:        make(map[types.TxKey]*clist.CElement),
 		laneBytes:     make(map[LaneID]int64),
+		quotas:        newTxsQuotas(cfg),
 		logger:        log.NewNopLogger(),
 		metrics:       NopMetrics(),
 		addTxCh:       make(chan struct{}),
@@ -120,27 +138,13 @@ func NewCListMempool(
 	mp.height.Store(height)
 
 	// Initialize lanes
-	if lanesInfo == nil || len(lanesInfo.lanes) == 0 {
-		// The only lane will be "default" with priority 1.
-		lanesInfo = &LanesInfo{lanes: map[LaneID]LanePriority{defaultLane: 1}, defaultLane: defaultLane}
-	}
-	numLanes := len(lanesInfo.lanes)
-	mp.lanes = make(map[LaneID]*clist.CList, numLanes)
+	lanesInfo = orDefaultLanes(lanesInfo)
 	mp.defaultLane = lanesInfo.defaultLane
-	mp.sortedLanes = make([]lane, 0, numLanes)
-	for id, priority := range lanesInfo.lanes {
-		mp.lanes[id] = clist.New()
-		mp.sortedLanes = append(mp.sortedLanes, lane{id: id, priority: priority})
+	mp.sortedLanes = sortLanes(lanesInfo)
+	mp.lanes = make(map[LaneID]*clist.CList, len(mp.sortedLanes))
+	for _, lane := range mp.sortedLanes {
+		mp.lanes[lane.id] = clist.New()
 	}
-	slices.SortStableFunc(mp.sortedLanes, func(i, j lane) int {
-		if i.priority > j.priority {
-			return -1
-		}
-		if i.priority < j.priority {
-			return 1
-		}
-		return 0
-	})
 
 	mp.recheck = newRecheck(mp)
 
@@ -157,6 +161,34 @@ func NewCListMempool(
 	return mp
 }
 
+// orDefaultLanes returns lanesInfo, or a single "default" lane with priority 1
+// if it has no lanes.
+func orDefaultLanes(lanesInfo *LanesInfo) *LanesInfo {
+	if lanesInfo == nil || len(lanesInfo.lanes) == 0 {
+		return &LanesInfo{lanes: map[LaneID]LanePriority{defaultLane: 1}, defaultLane: defaultLane}
+	}
+	return lanesInfo
+}
+
+// sortLanes returns the lanes in lanesInfo sorted by priority, in descending
+// order.
+func sortLanes(lanesInfo *LanesInfo) []lane {
+	sortedLanes := make([]lane, 0, len(lanesInfo.lanes))
+	for id, priority := range lanesInfo.lanes {
+		sortedLanes = append(sortedLanes, lane{id: id, priority: priority})
+	}
+	slices.SortStableFunc(sortedLanes, func(i, j lane) int {
+		if i.priority > j.priority {
+			return -1
+		}
+		if i.priority < j.priority {
+			return 1
+		}
+		return 0
+	})
+	return sortedLanes
+}
+
 func (mem *CListMempool) addToCache(tx types.Tx) bool {
 	return mem.cache.Push(tx)
 }
@@ -176,15 +208,30 @@ func (mem *CListMempool) tryRemoveFromCache(tx types.Tx) {
 
 func (mem *CListMempool) removeAllTxs(lane LaneID) {
 	mem.txsMtx.Lock()
-	defer mem.txsMtx.Unlock()
-
+	removed := make([]types.Tx, 0, mem.lanes[lane].Len())
 	for e := mem.lanes[lane].Front(); e != nil; e = e.Next() {
 		mem.lanes[lane].Remove(e)
 		e.DetachPrev()
+		removed = append(removed, e.Value.(*mempoolTx).tx)
 	}
 	mem.txsMap = make(map[types.TxKey]*clist.CElement)
 	delete(mem.laneBytes, lane)
 	mem.txsBytes = 0
+	mem.quotas.reset()
+	mem.txsMtx.Unlock()
+
+	if mem.onTxEvicted != nil {
+		for _, tx := range removed {
+			mem.onTxEvicted(tx, lane, ErrMempoolFlushed)
+		}
+	}
+}
+
+// setTxFromPeerAddedCallback sets the function called with the sender of every
+// tx received from a peer that is added to the mempool. It must be called
+// before the mempool receives txs.
+func (mem *CListMempool) setTxFromPeerAddedCallback(cb func(sender nodekey.ID)) {
+	mem.onTxFromPeerAdded = cb
 }
 
 // addSender adds a peer ID to the list of senders on the entry corresponding to
@@ -245,6 +292,43 @@ func WithNewTxCallback(cb func(types.Tx)) CListMempoolOption {
 	return func(mem *CListMempool) { mem.onNewTx = cb }
 }
 
+// WithTxExpiredCallback sets a callback function to be executed when a
+// transaction is removed from the mempool because its TTL expired.
+func WithTxExpiredCallback(cb TxExpiredCallback) CListMempoolOption {
+	return func(mem *CListMempool) { mem.onTxExpired = cb }
+}
+
+// WithTxRejectedCallback sets a callback function to be executed when a
+// transaction is rejected after CheckTx.
+func WithTxRejectedCallback(cb TxRejectedCallback) CListMempoolOption {
+	return func(mem *CListMempool) { mem.onTxRejected = cb }
+}
+
+// WithTxEvictedCallback sets a callback function to be executed when a valid
+// transaction is removed from the mempool before being committed.
+func WithTxEvictedCallback(cb TxEvictedCallback) CListMempoolOption {
+	return func(mem *CListMempool) { mem.onTxEvicted = cb }
+}
+
+// WithTxRecheckFailedCallback sets a callback function to be executed when a
+// transaction is removed from the mempool because it failed a recheck.
+func WithTxRecheckFailedCallback(cb TxRecheckFailedCallback) CListMempoolOption {
+	return func(mem *CListMempool) { mem.onTxRecheckFailed = cb }
+}
+
+// WithTxReplacedCallback sets a callback function to be executed when a
+// transaction is replaced by a new one, as requested by the application.
+func WithTxReplacedCallback(cb TxReplacedCallback) CListMempoolOption {
+	return func(mem *CListMempool) { mem.onTxReplaced = cb }
+}
+
+// WithJournal sets a journal where to record the txs added to and removed from
+// the mempool. The txs in the journal are restored when the mempool Reactor
+// starts, or when it finishes syncing.
+func WithJournal(journal *Journal) CListMempoolOption {
+	return func(mem *CListMempool) { mem.journal = journal }
+}
+
 // Safe for concurrent use by multiple goroutines.
 func (mem *CListMempool) Lock() {
 	mem.updateMtx.Lock()
@@ -296,6 +380,44 @@ func (mem *CListMempool) LaneSizes(lane LaneID) (numTxs int, bytes int64) {
 	return txs.Len(), bytes
 }
 
+// Lanes returns the IDs of the lanes, sorted by priority in descending order.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) Lanes() []LaneID {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	lanes := make([]LaneID, 0, len(mem.sortedLanes))
+	for _, lane := range mem.sortedLanes {
+		lanes = append(lanes, lane.id)
+	}
+	return lanes
+}
+
+// LaneTxs returns up to limit transactions in the given lane, in the order in
+// which they were added, skipping the first offset ones.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *CListMempool) LaneTxs(lane LaneID, offset, limit int) (types.Txs, error) {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	txs, ok := mem.lanes[lane]
+	if !ok {
+		return nil, ErrLaneNotFound{laneID: lane}
+	}
+
+	result := make(types.Txs, 0, cmtmath.MinInt(limit, cmtmath.MaxInt(txs.Len()-offset, 0)))
+	i := 0
+	for e := txs.Front(); e != nil && len(result) < limit; e = e.Next() {
+		if i >= offset {
+			result = append(result, e.Value.(*mempoolTx).tx)
+		}
+		i++
+	}
+	return result, nil
+}
+
 // Lock() must be help by the caller during execution.
 func (mem *CListMempool) FlushAppConn() error {
 	err := mem.proxyAppConn.Flush(context.TODO())
@@ -318,6 +440,25 @@ func (mem *CListMempool) Flush() {
 	for lane := range mem.lanes {
 		mem.removeAllTxs(lane)
 	}
+
+	if mem.journal != nil {
+		if err := mem.journal.compact(nil); err != nil {
+			mem.logger.Error("Failed to reset mempool journal", "err", err)
+		}
+	}
+}
+
+// txLane returns the lane of the transaction with the given key, if it is in
+// the mempool.
+func (mem *CListMempool) txLane(txKey types.TxKey) (LaneID, bool) {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	elem, ok := mem.txsMap[txKey]
+	if !ok {
+		return "", false
+	}
+	return elem.Value.(*mempoolTx).lane, true
 }
 
 func (mem *CListMempool) Contains(txKey types.TxKey) bool {
@@ -360,6 +501,13 @@ func (mem *CListMempool) CheckTx(tx types.Tx, sender nodekey.ID) (*abcicli.ReqRe
 		return nil, ErrAppConnMempool{Err: err}
 	}
 
+	// Reject the tx before adding it to the cache, so that it can still be
+	// received from other peers.
+	if err := mem.checkPeerQuota(sender, txSize); err != nil {
+		mem.metrics.RejectedTxs.Add(1)
+		return nil, err
+	}
+
 	if added := mem.addToCache(tx); !added {
 		mem.metrics.AlreadyReceivedTxs.Add(1)
 		// Record a new sender for a tx we've already seen.
@@ -387,6 +535,22 @@ func (mem *CListMempool) CheckTx(tx types.Tx, sender nodekey.ID) (*abcicli.ReqRe
 	return reqRes, nil
 }
 
+// txLaneFromResponse returns the lane for a tx given the lane ID returned by
+// the application in CheckTx, which is empty for the default lane.
+func (mem *CListMempool) txLaneFromResponse(laneID string) (LaneID, error) {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	if laneID == "" {
+		return mem.defaultLane, nil
+	}
+	lane := LaneID(laneID)
+	if _, ok := mem.lanes[lane]; !ok {
+		return lane, ErrLaneNotFound{laneID: lane}
+	}
+	return lane, nil
+}
+
 // handleCheckTxResponse handles CheckTx responses for transactions validated for the first time.
 //
 //   - sender optionally holds the ID of the peer that sent the transaction, if any.
@@ -407,6 +571,14 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender nodekey.ID) f
 			postCheckErr = mem.postCheck(tx, res)
 		}
 
+		// If the app returned a non-empty lane, use it; otherwise use the default lane.
+		// The lane may not exist if the app has just changed its lanes and
+		// the mempool has not been updated yet.
+		lane, laneErr := mem.txLaneFromResponse(res.LaneId)
+		if laneErr != nil && postCheckErr == nil {
+			postCheckErr = laneErr
+		}
+
 		// If tx is invalid, remove it from the cache.
 		if res.Code != abci.CodeTypeOK || postCheckErr != nil {
 			mem.tryRemoveFromCache(tx)
@@ -418,26 +590,36 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender nodekey.ID) f
 			)
 			mem.metrics.FailedTxs.Add(1)
 
+			err := ErrInvalidTx
 			if postCheckErr != nil {
-				return postCheckErr
+				err = postCheckErr
+			}
+			if mem.onTxRejected != nil {
+				mem.onTxRejected(tx, lane, res.Code, err)
 			}
-			return ErrInvalidTx
+			return err
 		}
 
-		// If the app returned a non-empty lane, use it; otherwise use the default lane.
-		lane := mem.defaultLane
-		if res.LaneId != "" {
-			if _, ok := mem.lanes[lane]; !ok {
-				panic(ErrLaneNotFound{laneID: lane})
+		// The app may indicate that tx supersedes a tx in the mempool.
+		replaced, err := mem.replacedTx(tx, res.ReplacedTxKey)
+		if err != nil {
+			mem.tryRemoveFromCache(tx)
+			mem.logger.Debug("Rejected transaction", "tx", log.NewLazyHash(tx), "err", err)
+			mem.metrics.FailedTxs.Add(1)
+			if mem.onTxRejected != nil {
+				mem.onTxRejected(tx, lane, res.Code, err)
 			}
-			lane = LaneID(res.LaneId)
+			return err
 		}
 
-		if err := mem.isLaneFull(len(tx), lane); err != nil {
+		if err := mem.isLaneFull(len(tx), lane, replaced); err != nil {
 			mem.forceRemoveFromCache(tx) // lane might have space later
 			// use debug level to avoid spamming logs when traffic is high
 			mem.logger.Debug(err.Error())
 			mem.metrics.RejectedTxs.Add(1)
+			if mem.onTxRejected != nil {
+				mem.onTxRejected(tx, lane, res.Code, err)
+			}
 			return err
 		}
 
@@ -453,13 +635,39 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender nodekey.ID) f
 			return ErrTxInMempool
 		}
 
-		// Add tx to mempool and notify that new txs are available.
-		mem.addTx(tx, res.GasWanted, sender, lane)
+		if err := mem.checkQuotas(sender, res.TxSender, len(tx), replaced); err != nil {
+			mem.forceRemoveFromCache(tx) // tx might fit in the quota later
+			// use debug level to avoid spamming logs when traffic is high
+			mem.logger.Debug(err.Error())
+			mem.metrics.RejectedTxs.Add(1)
+			if mem.onTxRejected != nil {
+				mem.onTxRejected(tx, lane, res.Code, err)
+			}
+			return err
+		}
+
+		// Add tx to mempool, in place of the tx it replaces if any, and notify
+		// that new txs are available.
+		if mem.addTx(tx, res.GasWanted, sender, res.TxSender, lane, replaced) {
+			// Keep the replaced tx in the cache so that it is not added again
+			// when received from peers that haven't seen the new tx yet.
+			mem.cache.Push(replaced.tx)
+			mem.metrics.ReplacedTxs.Add(1)
+			if replaced.lane != lane {
+				mem.updateSizeMetrics(replaced.lane)
+			}
+			if mem.onTxReplaced != nil {
+				mem.onTxReplaced(replaced.tx, tx, lane)
+			}
+		}
 		mem.notifyTxsAvailable()
 
 		if mem.onNewTx != nil {
 			mem.onNewTx(tx)
 		}
+		if mem.onTxFromPeerAdded != nil && sender != noSender {
+			mem.onTxFromPeerAdded(sender)
+		}
 
 		mem.updateSizeMetrics(lane)
 
@@ -467,12 +675,59 @@ func (mem *CListMempool) handleCheckTxResponse(tx types.Tx, sender nodekey.ID) f
 	}
 }
 
+// replacedTx returns the entry of the tx in the mempool with the given key,
+// which tx replaces, or nil if key is empty or the tx is not in the mempool.
+func (mem *CListMempool) replacedTx(tx types.Tx, key []byte) (*mempoolTx, error) {
+	if len(key) == 0 {
+		return nil, nil
+	}
+	if len(key) != types.TxKeySize {
+		return nil, ErrInvalidReplacedTxKey
+	}
+	txKey := types.TxKey(key)
+	if txKey == tx.Key() {
+		return nil, nil
+	}
+
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	elem, ok := mem.txsMap[txKey]
+	if !ok {
+		return nil, nil
+	}
+	return elem.Value.(*mempoolTx), nil
+}
+
+// addTx adds tx to the mempool. If replaced is not nil and still in the
+// mempool, it is removed in the same critical section, so that no one can
+// observe both txs or none of them, and addTx returns true.
+//
+// The new tx is not put in the place of the replaced tx, which may be in
+// another lane, but appended to its lane like any new tx: it is reaped after
+// the txs added before it, and reaches the iterators that are already past the
+// replaced tx, so that it is gossiped to all peers.
+//
 // Called from:
 //   - handleCheckTxResponse (lock not held) if tx is valid
-func (mem *CListMempool) addTx(tx types.Tx, gasWanted int64, sender nodekey.ID, lane LaneID) {
+func (mem *CListMempool) addTx(
+	tx types.Tx,
+	gasWanted int64,
+	sender nodekey.ID,
+	appSender string,
+	lane LaneID,
+	replaced *mempoolTx,
+) (isReplaced bool) {
 	mem.txsMtx.Lock()
 	defer mem.txsMtx.Unlock()
 
+	if replaced != nil {
+		if elem, ok := mem.txsMap[replaced.tx.Key()]; ok {
+			mem.removeTx(elem)
+			isReplaced = true
+		}
+	}
+
 	// Get lane's clist.
 	txs, ok := mem.lanes[lane]
 	if !ok {
@@ -492,15 +747,22 @@ func (mem *CListMempool) addTx(tx types.Tx, gasWanted int64, sender nodekey.ID,
 		gasWanted: gasWanted,
 		lane:      lane,
 		seq:       mem.addTxSeq,
+		timestamp: cmttime.Now(),
+		quotaPeer: sender,
+		appSender: appSender,
 	}
 	_ = memTx.addSender(sender)
 	e := txs.PushBack(memTx)
+	if mem.journal != nil {
+		mem.journal.recordAdd(tx, lane)
+	}
 
 	// Update auxiliary variables.
 	mem.txsMap[tx.Key()] = e
 	mem.txsBytes += int64(len(tx))
 	mem.numTxs++
 	mem.laneBytes[lane] += int64(len(tx))
+	mem.quotas.add(memTx)
 
 	// Notify iterators there's a new transaction.
 	close(mem.addTxCh)
@@ -516,6 +778,7 @@ func (mem *CListMempool) addTx(tx types.Tx, gasWanted int64, sender nodekey.ID,
 		"height", mem.height.Load(),
 		"total", mem.numTxs,
 	)
+	return isReplaced
 }
 
 // RemoveTxByKey removes a transaction from the mempool by its TxKey index.
@@ -531,20 +794,30 @@ func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
 		return ErrTxNotFound
 	}
 
+	mem.removeTx(elem)
+	return nil
+}
+
+// removeTx removes the tx in elem from its lane. The caller must hold txsMtx.
+func (mem *CListMempool) removeTx(elem *clist.CElement) {
 	memTx := elem.Value.(*mempoolTx)
 
 	label := string(memTx.lane)
-	mem.metrics.TxLifeSpan.With("lane", label).Observe(float64(memTx.timestamp.Sub(time.Now().UTC())))
+	mem.metrics.TxLifeSpan.With("lane", label).Observe(float64(cmttime.Since(memTx.timestamp).Milliseconds()))
 
 	// Remove tx from lane.
 	mem.lanes[memTx.lane].Remove(elem)
 	elem.DetachPrev()
+	if mem.journal != nil {
+		mem.journal.recordRemove(memTx.tx, memTx.lane)
+	}
 
 	// Update auxiliary variables.
-	delete(mem.txsMap, txKey)
+	delete(mem.txsMap, memTx.tx.Key())
 	mem.txsBytes -= int64(len(memTx.tx))
 	mem.numTxs--
 	mem.laneBytes[memTx.lane] -= int64(len(memTx.tx))
+	mem.quotas.remove(memTx)
 
 	mem.logger.Debug(
 		"Removed transaction",
@@ -553,7 +826,6 @@ func (mem *CListMempool) RemoveTxByKey(txKey types.TxKey) error {
 		"height", mem.height.Load(),
 		"total", mem.numTxs,
 	)
-	return nil
 }
 
 func (mem *CListMempool) isFull(txSize int) error {
@@ -575,12 +847,50 @@ func (mem *CListMempool) isFull(txSize int) error {
 	return nil
 }
 
-func (mem *CListMempool) isLaneFull(txSize int, lane LaneID) error {
+// checkPeerQuota returns an error if a tx of the given size received from
+// sender would exceed the peer's quota.
+func (mem *CListMempool) checkPeerQuota(sender nodekey.ID, txSize int) error {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	return mem.quotas.checkPeer(sender, txSize)
+}
+
+// checkQuotas returns an error if a tx of the given size would exceed the
+// quota of the peer that sent it or of its app-defined sender. The tx it
+// replaces, if any, is not counted, as it is about to be removed.
+func (mem *CListMempool) checkQuotas(sender nodekey.ID, appSender string, txSize int, replaced *mempoolTx) error {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	if replaced != nil {
+		if _, ok := mem.txsMap[replaced.tx.Key()]; ok {
+			mem.quotas.remove(replaced)
+			defer mem.quotas.add(replaced)
+		}
+	}
+
+	if err := mem.quotas.checkPeer(sender, txSize); err != nil {
+		return err
+	}
+	return mem.quotas.checkSender(appSender, txSize)
+}
+
+// isLaneFull returns an error if there is no room in lane for a tx of the
+// given size, taking into account the room freed by the tx it replaces, if any.
+func (mem *CListMempool) isLaneFull(txSize int, lane LaneID, replaced *mempoolTx) error {
 	laneTxs, laneBytes := mem.LaneSizes(lane)
+	if replaced != nil && replaced.lane == lane {
+		laneTxs--
+		laneBytes -= int64(len(replaced.tx))
+	}
 
 	// The mempool is partitioned evenly across all lanes.
-	laneTxsCapacity := mem.config.Size / len(mem.sortedLanes)
-	laneBytesCapacity := mem.config.MaxTxsBytes / int64(len(mem.sortedLanes))
+	mem.txsMtx.RLock()
+	numLanes := len(mem.sortedLanes)
+	mem.txsMtx.RUnlock()
+	laneTxsCapacity := mem.config.Size / numLanes
+	laneBytesCapacity := mem.config.MaxTxsBytes / int64(numLanes)
 
 	if laneTxs > laneTxsCapacity || int64(txSize)+laneBytes > laneBytesCapacity {
 		return ErrLaneIsFull{
@@ -630,6 +940,11 @@ func (mem *CListMempool) handleRecheckTxResponse(tx types.Tx) func(res *abci.Res
 		if (res.Code != abci.CodeTypeOK) || postCheckErr != nil {
 			// Tx became invalidated due to newly committed block.
 			mem.logger.Debug("Tx is no longer valid", "tx", log.NewLazyHash(tx), "res", res, "postCheckErr", postCheckErr)
+			lane, ok := mem.txLane(tx.Key())
+			if !ok {
+				mem.logger.Debug("Transaction could not be removed from mempool", "err", ErrTxNotFound)
+				return ErrTxNotFound
+			}
 			if err := mem.RemoveTxByKey(tx.Key()); err != nil {
 				mem.logger.Debug("Transaction could not be removed from mempool", "err", err)
 				return err
@@ -637,17 +952,17 @@ func (mem *CListMempool) handleRecheckTxResponse(tx types.Tx) func(res *abci.Res
 
 			// update metrics
 			mem.metrics.EvictedTxs.Add(1)
-			if elem, ok := mem.txsMap[tx.Key()]; ok {
-				mem.updateSizeMetrics(elem.Value.(*mempoolTx).lane)
-			} else {
-				mem.logger.Error("Cannot update metrics", "err", ErrTxNotFound)
-			}
+			mem.updateSizeMetrics(lane)
 
 			mem.tryRemoveFromCache(tx)
+			err := ErrInvalidTx
 			if postCheckErr != nil {
-				return postCheckErr
+				err = postCheckErr
 			}
-			return ErrInvalidTx
+			if mem.onTxRecheckFailed != nil {
+				mem.onTxRecheckFailed(tx, lane, res.Code, err)
+			}
+			return err
 		}
 
 		return nil
@@ -795,6 +1110,9 @@ func (mem *CListMempool) Update(
 		}
 	}
 
+	// Remove txs that stayed in the mempool for longer than the configured TTL.
+	mem.purgeExpiredTxs(height)
+
 	// Recheck txs left in the mempool to remove them if they became invalid in the new state.
 	if mem.config.Recheck {
 		mem.recheckTxs()
@@ -810,9 +1128,224 @@ func (mem *CListMempool) Update(
 		mem.updateSizeMetrics(lane)
 	}
 
+	if mem.journal != nil {
+		mem.syncJournal()
+	}
+
 	return nil
 }
 
+// UpdateLanes implements LanesUpdater. It replaces the lanes of the mempool
+// and their priorities. Txs in the lanes that are kept stay where they are,
+// while txs in removed lanes are moved, in order of arrival, to the end of the
+// new default lane. No tx is removed from the mempool.
+//
+// Lock() must be help by the caller during execution.
+func (mem *CListMempool) UpdateLanes(lanesInfo *LanesInfo) {
+	lanesInfo = orDefaultLanes(lanesInfo)
+	sortedLanes := sortLanes(lanesInfo)
+
+	mem.txsMtx.Lock()
+	mem.addTxChMtx.Lock()
+
+	oldLanes := mem.lanes
+	mem.lanes = make(map[LaneID]*clist.CList, len(sortedLanes))
+	for _, lane := range sortedLanes {
+		if txs, ok := oldLanes[lane.id]; ok {
+			mem.lanes[lane.id] = txs
+		} else {
+			mem.lanes[lane.id] = clist.New()
+		}
+	}
+	mem.defaultLane = lanesInfo.defaultLane
+	mem.sortedLanes = sortedLanes
+	mem.lanesVersion++
+
+	// Collect the txs of the removed lanes.
+	removedLanes := make([]LaneID, 0)
+	moved := make([]*clist.CElement, 0)
+	for id, txs := range oldLanes {
+		if _, ok := mem.lanes[id]; ok {
+			continue
+		}
+		removedLanes = append(removedLanes, id)
+		for e := txs.Front(); e != nil; e = e.Next() {
+			moved = append(moved, e)
+		}
+	}
+	slices.SortFunc(moved, func(a, b *clist.CElement) int {
+		return cmp.Compare(a.Value.(*mempoolTx).seq, b.Value.(*mempoolTx).seq)
+	})
+
+	// Move them to the default lane, with new sequence numbers so that
+	// blocking iterators don't miss them.
+	defaultTxs := mem.lanes[mem.defaultLane]
+	for _, e := range moved {
+		memTx := e.Value.(*mempoolTx)
+		oldLanes[memTx.lane].Remove(e)
+		e.DetachPrev()
+
+		mem.addTxSeq++
+		memTx.lane = mem.defaultLane
+		memTx.seq = mem.addTxSeq
+		mem.txsMap[memTx.tx.Key()] = defaultTxs.PushBack(memTx)
+		mem.laneBytes[mem.defaultLane] += int64(len(memTx.tx))
+	}
+	if len(moved) > 0 {
+		mem.addTxLaneSeqs[mem.defaultLane] = mem.addTxSeq
+	}
+	for _, id := range removedLanes {
+		delete(mem.laneBytes, id)
+		delete(mem.addTxLaneSeqs, id)
+	}
+
+	// Notify iterators, so that they pick up the new lanes.
+	close(mem.addTxCh)
+	mem.addTxCh = make(chan struct{})
+
+	mem.addTxChMtx.Unlock()
+	mem.txsMtx.Unlock()
+
+	// Update metrics.
+	for _, id := range removedLanes {
+		mem.metrics.LaneSize.With("lane", string(id)).Set(0)
+		mem.metrics.LaneBytes.With("lane", string(id)).Set(0)
+	}
+	for _, lane := range sortedLanes {
+		mem.updateSizeMetrics(lane.id)
+	}
+
+	mem.logger.Info(
+		"Updated mempool lanes",
+		"lanes", len(sortedLanes),
+		"default", lanesInfo.defaultLane,
+		"removed", removedLanes,
+		"moved-txs", len(moved),
+	)
+}
+
+// syncJournal writes the journal to disk, compacting it first if it has grown
+// too much compared to the mempool size.
+// Called from:
+//   - Update (updateMtx held)
+func (mem *CListMempool) syncJournal() {
+	if mem.journal.shouldCompact(mem.Size()) {
+		if err := mem.compactJournal(); err != nil {
+			mem.logger.Error("Failed to compact mempool journal", "err", err)
+		}
+		return
+	}
+	if err := mem.journal.sync(); err != nil {
+		mem.logger.Error("Failed to sync mempool journal", "err", err)
+	}
+}
+
+// compactJournal replaces the contents of the journal with the txs currently
+// in the mempool, in order of arrival.
+func (mem *CListMempool) compactJournal() error {
+	// Hold the lock until the journal is compacted, so that no tx is added or
+	// removed in the meantime.
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	memTxs := make([]*mempoolTx, 0, mem.numTxs)
+	for _, lane := range mem.sortedLanes {
+		for e := mem.lanes[lane.id].Front(); e != nil; e = e.Next() {
+			memTxs = append(memTxs, e.Value.(*mempoolTx))
+		}
+	}
+	slices.SortFunc(memTxs, func(a, b *mempoolTx) int { return cmp.Compare(a.seq, b.seq) })
+
+	entries := make([]journalEntry, 0, len(memTxs))
+	for _, memTx := range memTxs {
+		entries = append(entries, journalEntry{tx: memTx.tx, lane: memTx.lane})
+	}
+	return mem.journal.compact(entries)
+}
+
+// replayJournal implements gossipMempool. It checks again, with CheckTx, the
+// txs that are in the journal and adds the valid ones to the mempool. The
+// journal is then compacted.
+func (mem *CListMempool) replayJournal() error {
+	if mem.journal == nil {
+		return nil
+	}
+
+	entries, err := mem.journal.readEntries()
+	if err != nil {
+		return err
+	}
+	mem.logger.Info("Replaying mempool journal", "num-txs", len(entries))
+
+	reqResps := make([]*abcicli.ReqRes, 0, len(entries))
+	for _, e := range entries {
+		reqRes, err := mem.CheckTx(e.tx, noSender)
+		if err != nil {
+			mem.logger.Debug("Could not replay tx from journal", "tx", log.NewLazyHash(e.tx), "lane", e.lane, "err", err)
+			continue
+		}
+		reqResps = append(reqResps, reqRes)
+	}
+	// Wait until all txs are processed before compacting the journal.
+	for _, reqRes := range reqResps {
+		reqRes.Wait()
+	}
+	mem.logger.Info("Replayed mempool journal", "num-txs", len(entries), "size", mem.Size())
+
+	return mem.compactJournal()
+}
+
+// purgeExpiredTxs removes from all lanes the txs whose TTL has expired at the
+// given height. Expired txs are also removed from the cache so that they can be
+// resubmitted.
+// Called from:
+//   - Update (updateMtx held)
+func (mem *CListMempool) purgeExpiredTxs(height int64) {
+	if mem.config.TTLNumBlocks == 0 && mem.config.TTLDuration == 0 {
+		return
+	}
+
+	now := cmttime.Now()
+	expired := make([]*mempoolTx, 0)
+	mem.txsMtx.RLock()
+	for _, lane := range mem.sortedLanes {
+		for e := mem.lanes[lane.id].Front(); e != nil; e = e.Next() {
+			memTx := e.Value.(*mempoolTx)
+			if isExpired(mem.config, memTx, height, now) {
+				expired = append(expired, memTx)
+			}
+		}
+	}
+	mem.txsMtx.RUnlock()
+
+	for _, memTx := range expired {
+		if err := mem.RemoveTxByKey(memTx.tx.Key()); err != nil {
+			continue
+		}
+		mem.forceRemoveFromCache(memTx.tx)
+		mem.metrics.ExpiredTxs.With("lane", string(memTx.lane)).Add(1)
+		mem.logger.Debug("Expired transaction", "tx", log.NewLazyHash(memTx.tx), "lane", memTx.lane, "height", height)
+		if mem.onTxExpired != nil {
+			mem.onTxExpired(memTx.tx, memTx.lane, height)
+		}
+	}
+}
+
+// newGossipIterator implements gossipMempool.
+func (mem *CListMempool) newGossipIterator(ctx context.Context, name string) Iterator {
+	return NewBlockingIterator(ctx, mem, name)
+}
+
+// getMetrics implements gossipMempool.
+func (mem *CListMempool) getMetrics() *Metrics {
+	return mem.metrics
+}
+
+// inCache implements gossipMempool.
+func (mem *CListMempool) inCache(txKey types.TxKey) bool {
+	return mem.cache.HasKey(txKey)
+}
+
 // updateSizeMetrics updates the size-related metrics of a given lane.
 func (mem *CListMempool) updateSizeMetrics(laneID LaneID) {
 	laneTxs, laneBytes := mem.LaneSizes(laneID)
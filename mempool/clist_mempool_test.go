@@ -8,6 +8,7 @@ import (
 	mrand "math/rand"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/p2p/nodekey"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
 )
@@ -302,6 +304,47 @@ func TestMempoolAddTxLane(t *testing.T) {
 	}
 }
 
+func TestMempoolLaneTxs(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := test.ResetTestRoot("mempool_test")
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	require.Equal(t, []LaneID{"val", "foo", defaultLane, "bar"}, mp.Lanes())
+
+	expected := make(map[LaneID]types.Txs)
+	for i := 0; i < 100; i++ {
+		tx := kvstore.NewTxFromID(i)
+		rr, err := mp.CheckTx(tx, noSender)
+		require.NoError(t, err)
+		rr.Wait()
+		lane := kvstoreAssignLane(i)
+		expected[lane] = append(expected[lane], tx)
+	}
+
+	for _, lane := range []LaneID{"foo", defaultLane, "bar"} {
+		txs, err := mp.LaneTxs(lane, 0, 1000)
+		require.NoError(t, err)
+		require.Equal(t, expected[lane], txs, "lane %s", lane)
+
+		txs, err = mp.LaneTxs(lane, 2, 3)
+		require.NoError(t, err)
+		require.Equal(t, expected[lane][2:5], txs, "lane %s", lane)
+
+		txs, err = mp.LaneTxs(lane, 1000, 10)
+		require.NoError(t, err)
+		require.Empty(t, txs)
+	}
+
+	txs, err := mp.LaneTxs("val", 0, 10)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	_, err = mp.LaneTxs("unknown", 0, 10)
+	require.ErrorAs(t, err, &ErrLaneNotFound{})
+}
+
 func kvstoreAssignLane(key int) LaneID {
 	lane := defaultLane // 3
 	if key%11 == 0 {
@@ -312,6 +355,72 @@ func kvstoreAssignLane(key int) LaneID {
 	return LaneID(lane)
 }
 
+func TestMempoolUpdateLanes(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := test.ResetTestRoot("mempool_test")
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	expected := make(map[LaneID]types.Txs)
+	for i := 0; i < 100; i++ {
+		tx := kvstore.NewTxFromID(i)
+		rr, err := mp.CheckTx(tx, noSender)
+		require.NoError(t, err)
+		rr.Wait()
+		lane := kvstoreAssignLane(i)
+		expected[lane] = append(expected[lane], tx)
+	}
+
+	// Keep lane foo with a lower priority, remove the others and add a new
+	// default lane.
+	lanesInfo, err := BuildLanesInfo(map[string]uint32{"foo": 1, "new": 5}, "new")
+	require.NoError(t, err)
+	mp.Lock()
+	mp.UpdateLanes(lanesInfo)
+	mp.Unlock()
+
+	require.Equal(t, []LaneID{"new", "foo"}, mp.Lanes())
+	require.Equal(t, 100, mp.Size())
+
+	txs, err := mp.LaneTxs("foo", 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, expected["foo"], txs)
+
+	// Txs from removed lanes are moved to the default lane in order of arrival.
+	var moved types.Txs
+	var movedBytes int64
+	for i := 0; i < 100; i++ {
+		if kvstoreAssignLane(i) != "foo" {
+			moved = append(moved, kvstore.NewTxFromID(i))
+			movedBytes += int64(len(moved[len(moved)-1]))
+		}
+	}
+	txs, err = mp.LaneTxs("new", 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, moved, txs)
+	numTxs, numBytes := mp.LaneSizes("new")
+	require.Equal(t, len(moved), numTxs)
+	require.Equal(t, movedBytes, numBytes)
+
+	_, err = mp.LaneTxs(defaultLane, 0, 100)
+	require.ErrorAs(t, err, &ErrLaneNotFound{})
+	require.Len(t, mp.ReapMaxTxs(-1), 100)
+
+	// Txs assigned by the app to a removed lane are rejected.
+	tx := kvstore.NewTxFromID(100)
+	require.NotEqual(t, LaneID("foo"), kvstoreAssignLane(100))
+	rr, err := mp.CheckTx(tx, noSender)
+	require.NoError(t, err)
+	rr.Wait()
+	require.False(t, mp.Contains(types.Tx(tx).Key()))
+
+	// Committed txs are removed from their new lane.
+	doUpdate(t, mp, 1, moved[:10])
+	numTxs, _ = mp.LaneSizes("new")
+	require.Equal(t, len(moved)-10, numTxs)
+}
+
 func TestMempoolUpdate(t *testing.T) {
 	app := kvstore.NewInMemoryApplication()
 	cc := proxy.NewLocalClientCreator(app)
@@ -353,6 +462,262 @@ func TestMempoolUpdate(t *testing.T) {
 	}
 }
 
+func TestMempoolTTL(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.TTLNumBlocks = 2
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	type expiredTx struct {
+		lane   LaneID
+		height int64
+	}
+	expired := make(map[types.TxKey]expiredTx)
+	mp.onTxExpired = func(tx types.Tx, lane LaneID, height int64) {
+		expired[tx.Key()] = expiredTx{lane, height}
+	}
+
+	// Txs in all lanes expire after TTLNumBlocks blocks.
+	txs1 := addTxs(t, mp, 0, 20)
+	doUpdate(t, mp, 1, nil)
+	txs2 := addTxs(t, mp, 20, 10)
+	doUpdate(t, mp, 2, nil)
+	require.Equal(t, 30, mp.Size())
+	require.Empty(t, expired)
+
+	doUpdate(t, mp, 3, nil)
+	require.Equal(t, len(txs2), mp.Size())
+	require.Len(t, expired, len(txs1))
+	for i, tx := range txs1 {
+		require.Equal(t, expiredTx{kvstoreAssignLane(i), 3}, expired[tx.Key()])
+		require.False(t, mp.Contains(tx.Key()))
+	}
+
+	// Expired txs are removed from the cache so they can be resubmitted.
+	_, err := mp.CheckTx(txs1[0], "")
+	require.NoError(t, err)
+
+	// Txs also expire once they stay longer than TTLDuration in the mempool.
+	mp.config.TTLNumBlocks = 0
+	mp.config.TTLDuration = 10 * time.Millisecond
+	time.Sleep(20 * time.Millisecond)
+	doUpdate(t, mp, 4, nil)
+	require.Zero(t, mp.Size())
+	require.Zero(t, mp.SizeBytes())
+	require.Len(t, expired, len(txs1)+len(txs2))
+}
+
+func TestMempoolPeerQuota(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.MaxTxsPerPeer = 2
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+
+	txs := make(types.Txs, 0, 4)
+	for i := 0; i < 4; i++ {
+		txs = append(txs, kvstore.NewTxFromID(i))
+	}
+	for _, tx := range txs[:2] {
+		_, err := mp.CheckTx(tx, "peer1")
+		require.NoError(t, err)
+	}
+	_, err := mp.CheckTx(txs[2], "peer1")
+	require.ErrorAs(t, err, &ErrPeerQuotaExceeded{})
+
+	// The rejected tx is not in the cache, so it can be received from another
+	// peer. Txs received via RPC are not affected by the quota.
+	_, err = mp.CheckTx(txs[2], "peer2")
+	require.NoError(t, err)
+	_, err = mp.CheckTx(txs[3], noSender)
+	require.NoError(t, err)
+	require.Equal(t, 4, mp.Size())
+
+	// Once its txs are removed from the mempool, the peer can send more txs.
+	doUpdate(t, mp, 1, txs[:1])
+	_, err = mp.CheckTx(kvstore.NewTxFromID(4), "peer1")
+	require.NoError(t, err)
+	_, err = mp.CheckTx(kvstore.NewTxFromID(5), "peer1")
+	require.ErrorAs(t, err, &ErrPeerQuotaExceeded{})
+
+	// The quota also limits the total size of the txs of a peer.
+	mp.config.MaxTxsPerPeer = 0
+	mp.config.MaxTxsBytesPerPeer = int64(len(txs[2]) + 1)
+	_, err = mp.CheckTx(kvstore.NewTxFromID(6), "peer2")
+	require.ErrorAs(t, err, &ErrPeerQuotaExceeded{})
+
+	mp.Flush()
+	_, err = mp.CheckTx(kvstore.NewTxFromID(6), "peer2")
+	require.NoError(t, err)
+}
+
+func TestMempoolSenderQuota(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.MaxTxsPerSender = 2
+	mp, cleanup := newMempoolWithAppAndConfig(proxy.NewLocalClientCreator(newPriorityApp()), cfg)
+	defer cleanup()
+
+	errs := checkPriorityTxs(t, mp, "a/1/alice/0", "b/1/alice/1", "c/1/alice/2", "d/1/bob/0", "e/1")
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.ErrorAs(t, errs[2], &ErrSenderQuotaExceeded{})
+	require.NoError(t, errs[3])
+	require.NoError(t, errs[4])
+	require.Equal(t, 4, mp.Size())
+
+	// The rejected tx can be resubmitted once the sender is below its quota.
+	doUpdate(t, mp, 1, []types.Tx{types.Tx("a/1/alice/0")})
+	errs = checkPriorityTxs(t, mp, "c/1/alice/2")
+	require.NoError(t, errs[0])
+}
+
+func TestMempoolRemovedTxCallbacks(t *testing.T) {
+	app := newPriorityApp()
+	mp, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(app))
+	defer cleanup()
+
+	var rejected, evicted, recheckFailed []string
+	mp.onTxRejected = func(tx types.Tx, lane LaneID, code uint32, reason error) {
+		require.Equal(t, mp.defaultLane, lane)
+		require.Equal(t, uint32(1), code)
+		require.ErrorIs(t, reason, ErrInvalidTx)
+		rejected = append(rejected, string(tx))
+	}
+	mp.onTxEvicted = func(tx types.Tx, lane LaneID, reason error) {
+		require.Equal(t, mp.defaultLane, lane)
+		require.ErrorIs(t, reason, ErrMempoolFlushed)
+		evicted = append(evicted, string(tx))
+	}
+	mp.onTxRecheckFailed = func(tx types.Tx, lane LaneID, code uint32, reason error) {
+		require.Equal(t, mp.defaultLane, lane)
+		require.Equal(t, uint32(1), code)
+		require.ErrorIs(t, reason, ErrInvalidTx)
+		recheckFailed = append(recheckFailed, string(tx))
+	}
+
+	errs := checkPriorityTxs(t, mp, "a/1", "b/1", "c")
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.ErrorIs(t, errs[2], ErrInvalidTx)
+	require.Equal(t, []string{"c"}, rejected)
+
+	app.mtx.Lock()
+	app.invalid["b"] = true
+	app.mtx.Unlock()
+	doUpdate(t, mp, 1, nil)
+	require.Equal(t, []string{"b/1"}, recheckFailed)
+	require.Equal(t, 1, mp.Size())
+
+	mp.Flush()
+	require.Equal(t, []string{"a/1"}, evicted)
+	require.Equal(t, []string{"c"}, rejected)
+	require.Equal(t, []string{"b/1"}, recheckFailed)
+}
+
+func TestMempoolTxFromPeerAddedCallback(t *testing.T) {
+	app := newPriorityApp()
+	mp, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(app))
+	defer cleanup()
+
+	var senders []nodekey.ID
+	mp.setTxFromPeerAddedCallback(func(sender nodekey.ID) {
+		senders = append(senders, sender)
+	})
+
+	for _, tx := range []string{"a/1", "b", "c/1"} {
+		rr, err := mp.CheckTx(types.Tx(tx), "peer")
+		require.NoError(t, err)
+		rr.Wait()
+	}
+	rr, err := mp.CheckTx(types.Tx("d/1"), noSender)
+	require.NoError(t, err)
+	rr.Wait()
+
+	// Only the valid txs received from a peer are reported.
+	require.Equal(t, []nodekey.ID{"peer", "peer"}, senders)
+}
+
+// replaceApp is an application that accepts all txs, and that indicates that a
+// tx "<id>/<replaced>" replaces the tx "<replaced>".
+type replaceApp struct {
+	abci.BaseApplication
+}
+
+func (replaceApp) CheckTx(_ context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	res := &abci.CheckTxResponse{Code: abci.CodeTypeOK, GasWanted: 1}
+	if _, replaced, ok := strings.Cut(string(req.Tx), "/"); ok {
+		key := types.Tx(replaced).Key()
+		res.ReplacedTxKey = key[:]
+		if replaced == "malformed" {
+			res.ReplacedTxKey = key[:8]
+		}
+	}
+	return res, nil
+}
+
+func TestMempoolReplaceTx(t *testing.T) {
+	mp, cleanup := newMempoolWithApp(proxy.NewLocalClientCreator(replaceApp{}))
+	defer cleanup()
+
+	var replaced [][2]string
+	mp.onTxReplaced = func(oldTx, newTx types.Tx, lane LaneID) {
+		require.Equal(t, mp.defaultLane, lane)
+		replaced = append(replaced, [2]string{string(oldTx), string(newTx)})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	iter := mp.newGossipIterator(ctx, "peer")
+
+	errs := checkPriorityTxs(t, mp, "a", "b")
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	for _, tx := range []string{"a", "b"} {
+		require.Equal(t, types.Tx(tx), (<-iter.WaitNextCh()).Tx())
+	}
+
+	errs = checkPriorityTxs(t, mp, "c/a")
+	require.NoError(t, errs[0])
+	require.Equal(t, [][2]string{{"a", "c/a"}}, replaced)
+	require.Equal(t, 2, mp.Size())
+	require.Equal(t, int64(len("b")+len("c/a")), mp.SizeBytes())
+	require.Nil(t, mp.GetTxByHash(types.Tx("a").Hash()))
+	// The new tx is appended to the lane, so it is reaped after the txs added
+	// before it, and iterators that are past the replaced tx still reach it.
+	requireTxs(t, []string{"b", "c/a"}, mp.ReapMaxTxs(-1))
+	select {
+	case entry := <-iter.WaitNextCh():
+		require.Equal(t, types.Tx("c/a"), entry.Tx())
+	case <-time.After(time.Second):
+		t.Fatal("replacing tx not iterated")
+	}
+
+	// The replaced tx stays in the cache.
+	_, err := mp.CheckTx(types.Tx("a"), noSender)
+	require.ErrorIs(t, err, ErrTxInCache)
+
+	// A tx replacing a tx not in the mempool is added as a new tx.
+	errs = checkPriorityTxs(t, mp, "d/unknown")
+	require.NoError(t, errs[0])
+	require.Equal(t, 3, mp.Size())
+	require.Len(t, replaced, 1)
+
+	// A tx with a malformed replaced key is rejected.
+	errs = checkPriorityTxs(t, mp, "e/malformed")
+	require.ErrorIs(t, errs[0], ErrInvalidReplacedTxKey)
+	require.Equal(t, 3, mp.Size())
+
+	// A replacement can itself be replaced.
+	errs = checkPriorityTxs(t, mp, "f/c/a")
+	require.NoError(t, errs[0])
+	require.Equal(t, [][2]string{{"a", "c/a"}, {"c/a", "f/c/a"}}, replaced)
+	requireTxs(t, []string{"b", "d/unknown", "f/c/a"}, mp.ReapMaxTxs(-1))
+}
+
 func TestMempoolBuildLanesInfo(t *testing.T) {
 	emptyMap := make(map[string]uint32)
 	_, err := BuildLanesInfo(emptyMap, "")
@@ -3,6 +3,8 @@ package mempool
 import (
 	"errors"
 	"fmt"
+
+	"github.com/cometbft/cometbft/p2p/nodekey"
 )
 
 // ErrTxNotFound is returned to the client if tx is not found in mempool.
@@ -31,6 +33,24 @@ var ErrLateRecheckResponse = errors.New("rechecking has finished; discard late r
 // rechecking is still in progress after a new block was committed.
 var ErrRecheckFull = errors.New("mempool is still rechecking after a new committed block, so it is considered as full")
 
+// ErrMempoolFlushed is the reason given for the transactions removed from the
+// mempool when it is flushed.
+var ErrMempoolFlushed = errors.New("mempool was flushed")
+
+// ErrTxEvictedForPriority is the reason given for the transactions removed from
+// the mempool to make room for a transaction with a higher priority.
+var ErrTxEvictedForPriority = errors.New("evicted to make room for a tx with higher priority")
+
+// ErrInvalidReplacedTxKey is returned when the application indicates in
+// CheckTx that a transaction replaces another one, but the key of the replaced
+// transaction is malformed.
+var ErrInvalidReplacedTxKey = errors.New("invalid key of replaced tx")
+
+// ErrReplacedTxMismatch is returned by the priority mempool when a transaction
+// replaces a transaction with another sender or nonce, which could leave a gap
+// in the nonces of a sender.
+var ErrReplacedTxMismatch = errors.New("replaced tx has another sender or nonce")
+
 // ErrTxTooLarge defines an error when a transaction is too big to be sent in a
 // message to other peers.
 type ErrTxTooLarge struct {
@@ -82,6 +102,50 @@ func (e ErrLaneIsFull) Error() string {
 	)
 }
 
+// ErrPeerQuotaExceeded is returned when a transaction received from a peer is
+// rejected because the peer has reached the maximum number of transactions, or
+// bytes, that a single peer can contribute to the mempool.
+type ErrPeerQuotaExceeded struct {
+	Peer     nodekey.ID
+	NumTxs   int
+	MaxTxs   int
+	Bytes    int64
+	MaxBytes int64
+}
+
+func (e ErrPeerQuotaExceeded) Error() string {
+	return fmt.Sprintf(
+		"peer %s exceeded its mempool quota: number of txs %d (max: %d), total bytes %d (max: %d)",
+		e.Peer,
+		e.NumTxs,
+		e.MaxTxs,
+		e.Bytes,
+		e.MaxBytes,
+	)
+}
+
+// ErrSenderQuotaExceeded is returned when a transaction is rejected because
+// the mempool already has the maximum number of transactions, or bytes,
+// allowed for its sender, as returned by the application in CheckTx.
+type ErrSenderQuotaExceeded struct {
+	Sender   string
+	NumTxs   int
+	MaxTxs   int
+	Bytes    int64
+	MaxBytes int64
+}
+
+func (e ErrSenderQuotaExceeded) Error() string {
+	return fmt.Sprintf(
+		"sender %s exceeded its mempool quota: number of txs %d (max: %d), total bytes %d (max: %d)",
+		e.Sender,
+		e.NumTxs,
+		e.MaxTxs,
+		e.Bytes,
+		e.MaxBytes,
+	)
+}
+
 // ErrPreCheck defines an error where a transaction fails a pre-check.
 type ErrPreCheck struct {
 	Err error
@@ -155,3 +219,14 @@ type ErrLaneNotFound struct {
 func (e ErrLaneNotFound) Error() string {
 	return fmt.Sprintf("lane %s not found", e.laneID)
 }
+
+// ErrTxNonceInUse is returned when a transaction has the same sender and nonce
+// as another transaction already in the mempool.
+type ErrTxNonceInUse struct {
+	Sender string
+	Nonce  uint64
+}
+
+func (e ErrTxNonceInUse) Error() string {
+	return fmt.Sprintf("sender %s already has a tx with nonce %d in the mempool", e.Sender, e.Nonce)
+}
@@ -97,15 +97,20 @@ func (iter *NonBlockingIterator) Next() Entry {
 // BlockingIterator implements a blocking version of the WRR iterator,
 // meaning that when no transaction is available, it will wait until a new one
 // is added to the mempool.
-// Unlike `NonBlockingIterator`, this iterator is expected to work with an evolving mempool.
+// Unlike `NonBlockingIterator`, this iterator is expected to work with an evolving mempool,
+// including changes to its lanes.
 type BlockingIterator struct {
 	IWRRIterator
-	ctx  context.Context
-	mp   *CListMempool
-	name string // for debugging
+	ctx          context.Context
+	mp           *CListMempool
+	lanesVersion int64  // version of the mempool lanes in sortedLanes
+	name         string // for debugging
 }
 
 func NewBlockingIterator(ctx context.Context, mem *CListMempool, name string) Iterator {
+	mem.addTxChMtx.RLock()
+	defer mem.addTxChMtx.RUnlock()
+
 	iter := IWRRIterator{
 		sortedLanes: mem.sortedLanes,
 		cursors:     make(map[LaneID]*clist.CElement, len(mem.sortedLanes)),
@@ -115,6 +120,7 @@ func NewBlockingIterator(ctx context.Context, mem *CListMempool, name string) It
 		IWRRIterator: iter,
 		ctx:          ctx,
 		mp:           mem,
+		lanesVersion: mem.lanesVersion,
 		name:         name,
 	}
 }
@@ -162,6 +168,10 @@ func (iter *BlockingIterator) pickLane() (lane, chan struct{}) {
 	iter.mp.addTxChMtx.RLock()
 	defer iter.mp.addTxChMtx.RUnlock()
 
+	if iter.lanesVersion != iter.mp.lanesVersion {
+		iter.refreshLanes()
+	}
+
 	// Start from the last accessed lane.
 	currLane := iter.sortedLanes[iter.laneIndex]
 
@@ -196,12 +206,36 @@ func (iter *BlockingIterator) pickLane() (lane, chan struct{}) {
 	}
 }
 
+// refreshLanes sets the lanes to iterate on after the mempool lanes have been
+// replaced. It restarts the WRR round and drops the cursors of removed lanes.
+// The caller must hold addTxChMtx.
+func (iter *BlockingIterator) refreshLanes() {
+	iter.sortedLanes = iter.mp.sortedLanes
+	iter.lanesVersion = iter.mp.lanesVersion
+	iter.laneIndex = 0
+	iter.round = 1
+	for laneID := range iter.cursors {
+		if _, ok := iter.mp.lanes[laneID]; !ok {
+			delete(iter.cursors, laneID)
+		}
+	}
+}
+
 // In classical WRR, the iterator cycles over the lanes. When a lane is selected, Next returns an
 // entry from the selected lane. On subsequent calls, Next will return the next entries from the
 // same lane until `lane` entries are accessed or the lane is empty, where `lane` is the priority.
 // The next time, Next will select the successive lane with lower priority.
 // next returns the next entry from the given lane and updates WRR variables.
 func (iter *BlockingIterator) next(laneID LaneID) *clist.CElement {
+	iter.mp.addTxChMtx.RLock()
+	txs, ok := iter.mp.lanes[laneID]
+	iter.mp.addTxChMtx.RUnlock()
+	if !ok {
+		// The lane was removed after being picked.
+		delete(iter.cursors, laneID)
+		return nil
+	}
+
 	// Load the last accessed entry in the lane and set the next one.
 	var next *clist.CElement
 
@@ -212,7 +246,7 @@ func (iter *BlockingIterator) next(laneID LaneID) *clist.CElement {
 	} else {
 		// We are at the beginning of the iteration or the saved entry got removed. Pick the first
 		// entry in the lane if it's available (don't wait for it); if not, Front will return nil.
-		next = iter.mp.lanes[laneID].Front()
+		next = txs.Front()
 	}
 
 	// Update auxiliary variables.
@@ -228,3 +262,63 @@ func (iter *BlockingIterator) next(laneID LaneID) *clist.CElement {
 
 	return next
 }
+
+// arrivalIterator is a blocking iterator over the entries of a PriorityMempool,
+// which returns them in the order in which they were added.
+type arrivalIterator struct {
+	ctx     context.Context
+	txs     *clist.CList
+	cursor  *clist.CElement // last accessed entry
+	lastSeq int64           // sequence number of the last returned entry
+}
+
+// WaitNextCh returns a channel to wait for the next available entry. The
+// channel will be explicitly closed when the context is done.
+//
+// Unsafe for concurrent use by multiple goroutines.
+func (iter *arrivalIterator) WaitNextCh() <-chan Entry {
+	ch := make(chan Entry)
+	go func() {
+		defer close(ch)
+
+		for {
+			var next *clist.CElement
+			if iter.cursor == nil {
+				// We are at the beginning of the iteration, or the last
+				// accessed entry was the tail and got removed.
+				select {
+				case <-iter.txs.WaitChan():
+				case <-iter.ctx.Done():
+					return
+				}
+				next = iter.txs.Front()
+			} else {
+				select {
+				case <-iter.cursor.NextWaitChan():
+				case <-iter.ctx.Done():
+					return
+				}
+				next = iter.cursor.Next()
+			}
+			iter.cursor = next
+			if next == nil || next.Removed() {
+				continue
+			}
+
+			// Entries are sorted by sequence number, so when restarting from
+			// the front of the list we skip those that were already returned.
+			memTx := next.Value.(*priorityTx)
+			if memTx.seq <= iter.lastSeq {
+				continue
+			}
+			iter.lastSeq = memTx.seq
+
+			select {
+			case ch <- memTx:
+			case <-iter.ctx.Done():
+			}
+			return
+		}
+	}()
+	return ch
+}
@@ -298,6 +298,48 @@ func TestBlockingIteratorsConsumeAllTxs(t *testing.T) {
 	}
 }
 
+func TestBlockingIteratorUpdateLanes(t *testing.T) {
+	const numTxs = 200
+
+	cc := proxy.NewLocalClientCreator(kvstore.NewInMemoryApplication())
+	mp, cleanup := newMempoolWithApp(cc)
+	defer cleanup()
+
+	_ = addTxs(t, mp, 0, numTxs/2)
+
+	// Consume some txs before the lanes change.
+	iter := NewBlockingIterator(context.Background(), mp, "test")
+	seen := make(map[types.TxKey]struct{})
+	for i := 0; i < numTxs/4; i++ {
+		entry := <-iter.WaitNextCh()
+		require.NotNil(t, entry)
+		seen[entry.Tx().Key()] = struct{}{}
+	}
+
+	lanesInfo, err := BuildLanesInfo(map[string]uint32{"foo": 3, "bar": 1}, "bar")
+	require.NoError(t, err)
+	mp.Lock()
+	mp.UpdateLanes(lanesInfo)
+	mp.Unlock()
+
+	// Txs moved from removed lanes may be returned again, but no tx is missed.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for len(seen) < numTxs/2 {
+			entry := <-iter.WaitNextCh()
+			if entry != nil {
+				seen[entry.Tx().Key()] = struct{}{}
+			}
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for iterator to return all txs")
+	}
+}
+
 // Confirms that the transactions are returned in the same order.
 // Note that for the cases with equal priorities the actual order
 // will depend on the way we iterate over the map of lanes.
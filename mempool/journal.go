@@ -0,0 +1,268 @@
+package mempool
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"path/filepath"
+
+	auto "github.com/cometbft/cometbft/internal/autofile"
+	cmtos "github.com/cometbft/cometbft/internal/os"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	journalOpAdd    = byte(0x01)
+	journalOpRemove = byte(0x02)
+
+	// maxJournalRecordSize is an upper bound on the size of a record, only
+	// used to detect corrupted data.
+	maxJournalRecordSize = 1 << 30
+
+	// minJournalRecordsToCompact is the minimum number of records written to
+	// the journal since the last compaction before it is compacted again.
+	minJournalRecordsToCompact = 10000
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// Journal is an on-disk log of the txs added to and removed from the mempool.
+// It is used to restore the txs that were in the mempool when the node
+// restarts.
+//
+// Each record has the following format:
+//
+//	crc32c (4 bytes) | length (4 bytes) | op (1 byte) | lane length (uvarint) | lane | payload
+//
+// where the payload is the tx for additions, and the tx key for removals.
+// Records are buffered and synced to disk after each block, so the txs added
+// since the last block may be lost if the node crashes.
+type Journal struct {
+	mtx        cmtsync.Mutex
+	group      *auto.Group
+	numRecords int // records written since the last compaction
+
+	logger log.Logger
+}
+
+// journalEntry is a tx in the journal.
+type journalEntry struct {
+	tx   types.Tx
+	lane LaneID
+}
+
+// OpenJournal opens, or creates if it does not exist, the journal stored in
+// dir.
+func OpenJournal(dir string) (*Journal, error) {
+	if err := cmtos.EnsureDir(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to ensure mempool journal directory is in place: %w", err)
+	}
+	// The journal is compacted from time to time, so old files must not be
+	// removed because of the size of the group, and the head is only rotated
+	// by compactions.
+	group, err := auto.OpenGroup(filepath.Join(dir, "journal"),
+		auto.GroupHeadSizeLimit(0), auto.GroupTotalSizeLimit(0))
+	if err != nil {
+		return nil, err
+	}
+	if err := group.Start(); err != nil {
+		return nil, err
+	}
+	return &Journal{group: group, logger: log.NewNopLogger()}, nil
+}
+
+// SetLogger sets the Logger.
+func (j *Journal) SetLogger(l log.Logger) {
+	j.logger = l
+	j.group.SetLogger(l)
+}
+
+// Close flushes the pending records to disk and closes the journal.
+func (j *Journal) Close() error {
+	if err := j.group.Stop(); err != nil {
+		return err
+	}
+	j.group.Wait()
+	j.group.Close()
+	return nil
+}
+
+// recordAdd records that tx was added to the given lane.
+func (j *Journal) recordAdd(tx types.Tx, lane LaneID) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	if err := j.write(journalOpAdd, lane, tx); err != nil {
+		j.logger.Error("Failed to record added tx in mempool journal", "tx", log.NewLazyHash(tx), "err", err)
+	}
+}
+
+// recordRemove records that tx was removed from the given lane.
+func (j *Journal) recordRemove(tx types.Tx, lane LaneID) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	key := tx.Key()
+	if err := j.write(journalOpRemove, lane, key[:]); err != nil {
+		j.logger.Error("Failed to record removed tx in mempool journal", "tx", log.NewLazyHash(tx), "err", err)
+	}
+}
+
+// write appends a record to the journal. The caller must hold mtx.
+func (j *Journal) write(op byte, lane LaneID, payload []byte) error {
+	body := make([]byte, 0, 1+binary.MaxVarintLen64+len(lane)+len(payload))
+	body = append(body, op)
+	body = binary.AppendUvarint(body, uint64(len(lane)))
+	body = append(body, lane...)
+	body = append(body, payload...)
+
+	record := make([]byte, 8, 8+len(body))
+	binary.BigEndian.PutUint32(record[0:4], crc32.Checksum(body, crc32c))
+	binary.BigEndian.PutUint32(record[4:8], uint32(len(body)))
+	record = append(record, body...)
+
+	if _, err := j.group.Write(record); err != nil {
+		return err
+	}
+	j.numRecords++
+	return nil
+}
+
+// sync writes the buffered records to disk.
+func (j *Journal) sync() error {
+	return j.group.FlushAndSync()
+}
+
+// shouldCompact returns true iff the journal has grown enough, compared to the
+// given number of txs in the mempool, to be compacted.
+func (j *Journal) shouldCompact(numTxs int) bool {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	return j.numRecords >= minJournalRecordsToCompact && j.numRecords > 2*numTxs
+}
+
+// compact replaces the contents of the journal with the given txs. The
+// records written afterwards are appended to them.
+func (j *Journal) compact(entries []journalEntry) error {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	// Write the txs to a new head, so that the old files are only removed
+	// once the txs are safely on disk. The head may be rotated again while
+	// they are written, so only the files before the new head are removed.
+	j.group.RotateFile()
+	snapshotIndex := j.group.MaxIndex()
+	j.numRecords = 0
+	for _, e := range entries {
+		if err := j.write(journalOpAdd, e.lane, e.tx); err != nil {
+			return err
+		}
+	}
+	if err := j.group.FlushAndSync(); err != nil {
+		return err
+	}
+	return j.group.RemoveFilesBefore(snapshotIndex)
+}
+
+// readEntries returns the txs that were added and not removed, in the order in
+// which they were added. If the journal is corrupted, for example because the
+// node crashed while writing a record, only the txs in the records before the
+// corrupted one are returned.
+func (j *Journal) readEntries() ([]journalEntry, error) {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+
+	if err := j.group.FlushAndSync(); err != nil {
+		return nil, err
+	}
+	gr, err := j.group.NewReader(j.group.MinIndex())
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+
+	entries := make([]journalEntry, 0)
+	positions := make(map[types.TxKey]int) // index of each tx in entries
+	for {
+		op, lane, payload, err := readJournalRecord(gr)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			j.logger.Error("Stopped reading corrupted mempool journal", "err", err)
+			break
+		}
+
+		switch op {
+		case journalOpAdd:
+			tx := types.Tx(payload)
+			if _, ok := positions[tx.Key()]; !ok {
+				positions[tx.Key()] = len(entries)
+				entries = append(entries, journalEntry{tx: tx, lane: lane})
+			}
+		case journalOpRemove:
+			key := types.TxKey(payload)
+			if i, ok := positions[key]; ok {
+				entries[i].tx = nil
+				delete(positions, key)
+			}
+		}
+	}
+
+	surviving := make([]journalEntry, 0, len(positions))
+	for _, e := range entries {
+		if e.tx != nil {
+			surviving = append(surviving, e)
+		}
+	}
+	return surviving, nil
+}
+
+// readJournalRecord reads the next record from r. It returns io.EOF if there
+// are no more records.
+func readJournalRecord(r io.Reader) (op byte, lane LaneID, payload []byte, err error) {
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.EOF) {
+			return 0, "", nil, io.EOF
+		}
+		return 0, "", nil, fmt.Errorf("failed to read record header: %w", err)
+	}
+	crc := binary.BigEndian.Uint32(header[0:4])
+	length := binary.BigEndian.Uint32(header[4:8])
+	if length == 0 || length > maxJournalRecordSize {
+		return 0, "", nil, fmt.Errorf("invalid record length: %d", length)
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, "", nil, fmt.Errorf("failed to read record: %w", err)
+	}
+	if actual := crc32.Checksum(body, crc32c); actual != crc {
+		return 0, "", nil, fmt.Errorf("checksums do not match: read: %v, actual: %v", crc, actual)
+	}
+
+	op = body[0]
+	laneLen, n := binary.Uvarint(body[1:])
+	if n <= 0 || uint64(len(body)-1-n) < laneLen {
+		return 0, "", nil, errors.New("invalid lane length")
+	}
+	laneStart := 1 + n
+	lane = LaneID(body[laneStart : laneStart+int(laneLen)])
+	payload = body[laneStart+int(laneLen):]
+	switch op {
+	case journalOpAdd:
+	case journalOpRemove:
+		if len(payload) != len(types.TxKey{}) {
+			return 0, "", nil, fmt.Errorf("invalid tx key size: %d", len(payload))
+		}
+	default:
+		return 0, "", nil, fmt.Errorf("unknown record type: %d", op)
+	}
+	return op, lane, payload, nil
+}
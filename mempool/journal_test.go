@@ -0,0 +1,183 @@
+package mempool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func openTestJournal(t *testing.T, dir string) *Journal {
+	t.Helper()
+	journal, err := OpenJournal(dir)
+	require.NoError(t, err)
+	return journal
+}
+
+func requireJournalEntries(t *testing.T, journal *Journal, expected ...journalEntry) {
+	t.Helper()
+	entries, err := journal.readEntries()
+	require.NoError(t, err)
+	require.Equal(t, len(expected), len(entries))
+	for i, e := range expected {
+		require.Equal(t, e.tx, entries[i].tx)
+		require.Equal(t, e.lane, entries[i].lane)
+	}
+}
+
+func TestJournalReadEntries(t *testing.T) {
+	dir := t.TempDir()
+	journal := openTestJournal(t, dir)
+
+	a := journalEntry{types.Tx("a"), "foo"}
+	b := journalEntry{types.Tx("b"), "bar"}
+	c := journalEntry{types.Tx("c"), "foo"}
+	journal.recordAdd(a.tx, a.lane)
+	journal.recordAdd(b.tx, b.lane)
+	journal.recordAdd(c.tx, c.lane)
+	journal.recordRemove(b.tx, b.lane)
+	journal.recordRemove(types.Tx("unknown"), "foo")
+	requireJournalEntries(t, journal, a, c)
+
+	// A tx added again after being removed goes after the others.
+	journal.recordAdd(b.tx, b.lane)
+	journal.recordRemove(a.tx, a.lane)
+	require.NoError(t, journal.Close())
+
+	journal = openTestJournal(t, dir)
+	defer journal.Close()
+	requireJournalEntries(t, journal, c, b)
+}
+
+func TestJournalCorrupted(t *testing.T) {
+	dir := t.TempDir()
+	journal := openTestJournal(t, dir)
+
+	a := journalEntry{types.Tx("a"), "foo"}
+	journal.recordAdd(a.tx, a.lane)
+	journal.recordAdd(types.Tx("b"), "foo")
+	require.NoError(t, journal.Close())
+
+	// Simulate a crash while writing the last record.
+	path := filepath.Join(dir, "journal")
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(path, info.Size()-1))
+
+	journal = openTestJournal(t, dir)
+	defer journal.Close()
+	requireJournalEntries(t, journal, a)
+}
+
+func TestJournalCompact(t *testing.T) {
+	dir := t.TempDir()
+	journal := openTestJournal(t, dir)
+
+	for i := 0; i < 10; i++ {
+		tx := kvstore.NewTxFromID(i)
+		journal.recordAdd(tx, "foo")
+		journal.recordRemove(tx, "foo")
+	}
+	a := journalEntry{types.Tx("a"), "foo"}
+	require.NoError(t, journal.compact([]journalEntry{a}))
+	require.Equal(t, 1, journal.numRecords)
+
+	b := journalEntry{types.Tx("b"), "bar"}
+	journal.recordAdd(b.tx, b.lane)
+	require.NoError(t, journal.Close())
+
+	// Only the head file is left.
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	journal = openTestJournal(t, dir)
+	defer journal.Close()
+	requireJournalEntries(t, journal, a, b)
+}
+
+func TestJournalCompactWithRotation(t *testing.T) {
+	dir := t.TempDir()
+	journal := openTestJournal(t, dir)
+
+	entries := make([]journalEntry, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		tx := append(kvstore.NewTxFromID(i), make([]byte, 10_000)...)
+		entries = append(entries, journalEntry{tx, "foo"})
+	}
+
+	// Rotate the head while the txs are written.
+	done := make(chan struct{})
+	rotating := make(chan struct{})
+	rotated := make(chan struct{})
+	go func() {
+		defer close(rotated)
+		journal.group.RotateFile()
+		close(rotating)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				journal.group.RotateFile()
+			}
+		}
+	}()
+	<-rotating
+	err := journal.compact(entries)
+	close(done)
+	<-rotated
+	require.NoError(t, err)
+	require.NoError(t, journal.Close())
+
+	journal = openTestJournal(t, dir)
+	defer journal.Close()
+	requireJournalEntries(t, journal, entries...)
+}
+
+func TestMempoolJournalReplay(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	cc := proxy.NewLocalClientCreator(app)
+	cfg := test.ResetTestRoot("mempool_test")
+	dir := t.TempDir()
+
+	journal := openTestJournal(t, dir)
+	mp, cleanup := newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+	mp.journal = journal
+
+	txs := addTxs(t, mp, 0, 10)
+	doUpdate(t, mp, 1, txs[:4])
+	require.Equal(t, 6, mp.Size())
+	require.NoError(t, journal.Close())
+
+	// A new mempool restores the txs that were not committed.
+	journal = openTestJournal(t, dir)
+	defer journal.Close()
+	mp, cleanup = newMempoolWithAppAndConfig(cc, cfg)
+	defer cleanup()
+	mp.journal = journal
+	require.Zero(t, mp.Size())
+
+	require.NoError(t, mp.replayJournal())
+	require.Equal(t, 6, mp.Size())
+	for _, tx := range txs[4:] {
+		require.True(t, mp.Contains(tx.Key()))
+	}
+
+	// The journal was compacted.
+	require.Equal(t, 6, journal.numRecords)
+	entries, err := journal.readEntries()
+	require.NoError(t, err)
+	require.Len(t, entries, 6)
+
+	// Flushing the mempool also resets the journal.
+	mp.Flush()
+	requireJournalEntries(t, journal)
+}
@@ -13,6 +13,10 @@ import (
 const (
 	MempoolChannel = byte(0x30)
 
+	// MempoolAnnounceChannel is used by the pull-based gossip protocol to
+	// announce and request txs by their keys.
+	MempoolAnnounceChannel = byte(0x31)
+
 	// PeerCatchupSleepIntervalMS defines how much time to sleep if a peer is behind.
 	PeerCatchupSleepIntervalMS = 100
 )
@@ -106,6 +110,16 @@ type Mempool interface {
 	SizeBytes() int64
 }
 
+// LanesUpdater is implemented by mempools whose lanes can be replaced while
+// the node is running, as requested by the application in FinalizeBlock.
+type LanesUpdater interface {
+	// UpdateLanes replaces the lanes and their priorities, keeping the txs
+	// already in the mempool.
+	//
+	// NOTE: Lock/Unlock must be managed by the caller.
+	UpdateLanes(lanesInfo *LanesInfo)
+}
+
 // PreCheckFunc is an optional filter executed before CheckTx and rejects
 // transaction if false is returned. An example would be to ensure that a
 // transaction doesn't exceeded the block size.
@@ -116,6 +130,31 @@ type PreCheckFunc func(types.Tx) error
 // transaction doesn't require more gas than available for the block.
 type PostCheckFunc func(types.Tx, *abci.CheckTxResponse) error
 
+// TxExpiredCallback is an optional function executed when a transaction is
+// removed from the mempool because it stayed there longer than the configured
+// TTL. height is the height of the block after which the tx expired.
+type TxExpiredCallback func(tx types.Tx, lane LaneID, height int64)
+
+// TxRejectedCallback is an optional function executed when a transaction is not
+// added to the mempool after CheckTx, either because it is invalid or because
+// there is no room for it. code is the code returned by CheckTx.
+type TxRejectedCallback func(tx types.Tx, lane LaneID, code uint32, reason error)
+
+// TxEvictedCallback is an optional function executed when a valid transaction
+// is removed from the mempool before being committed, for example to make room
+// for other transactions.
+type TxEvictedCallback func(tx types.Tx, lane LaneID, reason error)
+
+// TxRecheckFailedCallback is an optional function executed when a transaction
+// is removed from the mempool because it became invalid when rechecked after a
+// block was committed. code is the code returned by CheckTx.
+type TxRecheckFailedCallback func(tx types.Tx, lane LaneID, code uint32, reason error)
+
+// TxReplacedCallback is an optional function executed when a transaction in
+// the mempool is replaced by newTx, as requested by the application in CheckTx.
+// lane is the lane of newTx.
+type TxReplacedCallback func(oldTx, newTx types.Tx, lane LaneID)
+
 // PreCheckMaxBytes checks that the size of the transaction is smaller or equal
 // to the expected maxBytes.
 func PreCheckMaxBytes(maxBytes int64) PreCheckFunc {
@@ -5,6 +5,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/p2p/nodekey"
 	"github.com/cometbft/cometbft/types"
 )
@@ -18,6 +19,9 @@ type mempoolTx struct {
 	seq       int64
 	timestamp time.Time // time when entry was created
 
+	quotaPeer nodekey.ID // peer whose quota the tx counts towards, if any
+	appSender string     // sender returned by the application in CheckTx, if any
+
 	// ids of peers who've sent us this tx (as a map for quick lookups).
 	// senders: PeerID -> struct{}
 	senders sync.Map
@@ -50,3 +54,12 @@ func (memTx *mempoolTx) addSender(peerID nodekey.ID) bool {
 	}
 	return false
 }
+
+// isExpired returns true iff the entry has been in the mempool for longer than
+// any of the TTLs set in cfg, at the given height and time.
+func isExpired(cfg *config.MempoolConfig, memTx *mempoolTx, height int64, now time.Time) bool {
+	if cfg.TTLNumBlocks > 0 && height-memTx.Height() > cfg.TTLNumBlocks {
+		return true
+	}
+	return cfg.TTLDuration > 0 && now.Sub(memTx.timestamp) > cfg.TTLDuration
+}
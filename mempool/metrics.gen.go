@@ -72,6 +72,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "evicted_txs",
 			Help:      "Number of evicted transactions.",
 		}, labels).With(labelsAndValues...),
+		ReplacedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "replaced_txs",
+			Help:      "Number of replaced transactions.",
+		}, labels).With(labelsAndValues...),
+		ExpiredTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "expired_txs",
+			Help:      "Number of expired transactions.",
+		}, append(labels, "lane")).With(labelsAndValues...),
 		RecheckTimes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -120,6 +132,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "transactions_received",
 			Help:      "Number of transactions received",
 		}, labels).With(labelsAndValues...),
+		TxKeysAnnounced: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "tx_keys_announced",
+			Help:      "Number of tx keys announced to peers (pull-based gossip).",
+		}, labels).With(labelsAndValues...),
+		TxsRequested: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "txs_requested",
+			Help:      "Number of txs requested to peers after being announced (pull-based gossip).",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -134,6 +158,8 @@ func NopMetrics() *Metrics {
 		FailedTxs:                 discard.NewCounter(),
 		RejectedTxs:               discard.NewCounter(),
 		EvictedTxs:                discard.NewCounter(),
+		ReplacedTxs:               discard.NewCounter(),
+		ExpiredTxs:                discard.NewCounter(),
 		RecheckTimes:              discard.NewCounter(),
 		AlreadyReceivedTxs:        discard.NewCounter(),
 		ActiveOutboundConnections: discard.NewGauge(),
@@ -142,5 +168,7 @@ func NopMetrics() *Metrics {
 		BytesReceived:             discard.NewGauge(),
 		TransactionsSent:          discard.NewGauge(),
 		TransactionsReceived:      discard.NewGauge(),
+		TxKeysAnnounced:           discard.NewCounter(),
+		TxsRequested:              discard.NewCounter(),
 	}
 }
@@ -53,10 +53,24 @@ type Metrics struct {
 
 	// EvictedTxs defines the number of evicted transactions. These are valid
 	// transactions that passed CheckTx and make it into the mempool but later
-	// became invalid.
+	// became invalid, or were evicted from a full priority mempool to make room
+	// for transactions with a higher priority.
 	// metrics:Number of evicted transactions.
 	EvictedTxs metrics.Counter
 
+	// ReplacedTxs defines the number of transactions removed from the mempool
+	// because the application indicated in CheckTx that a new transaction
+	// supersedes them.
+	// metrics:Number of replaced transactions.
+	ReplacedTxs metrics.Counter
+
+	// ExpiredTxs defines the number of expired transactions. These are valid
+	// transactions that were removed from the mempool because they stayed
+	// there longer than the configured TTL (see ttl_duration and
+	// ttl_num_blocks).
+	// metrics:Number of expired transactions.
+	ExpiredTxs metrics.Counter `metrics_labels:"lane"`
+
 	// Number of times transactions are rechecked in the mempool.
 	RecheckTimes metrics.Counter
 
@@ -82,4 +96,11 @@ type Metrics struct {
 
 	//Number of transactions received
 	TransactionsReceived metrics.Gauge
+
+	// Number of tx keys announced to peers (pull-based gossip).
+	TxKeysAnnounced metrics.Counter
+
+	// Number of txs requested to peers after being announced (pull-based
+	// gossip).
+	TxsRequested metrics.Counter
 }
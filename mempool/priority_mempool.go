@@ -0,0 +1,1247 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/clist"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p/nodekey"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// PriorityMempool is an in-memory pool for transactions that orders them by
+// the priority assigned by the application in its CheckTx responses.
+// Transactions with a higher priority are reaped first. Transactions sharing
+// the same application-defined sender are grouped together and are always
+// reaped in increasing nonce order, regardless of their individual priority.
+//
+// When the mempool is full, entries with the lowest priority are evicted to
+// make room for a new transaction with a higher priority. Lanes are not
+// supported; the lane returned by the application is ignored.
+type PriorityMempool struct {
+	height atomic.Int64 // the last block Update()'d to
+
+	// notify listeners (ie. consensus) when txs are available
+	notifiedTxsAvailable atomic.Bool
+	txsAvailable         chan struct{} // fires once for each height, when the mempool is not empty
+	onNewTx              func(types.Tx)
+	onTxFromPeerAdded    func(nodekey.ID)
+	onTxExpired          TxExpiredCallback
+	onTxRejected         TxRejectedCallback
+	onTxEvicted          TxEvictedCallback
+	onTxRecheckFailed    TxRecheckFailedCallback
+	onTxReplaced         TxReplacedCallback
+
+	config *config.MempoolConfig
+
+	// Exclusive mutex for Update method to prevent concurrent execution of
+	// CheckTx or ReapMaxBytesMaxGas(ReapMaxTxs) methods.
+	updateMtx cmtsync.RWMutex
+	preCheck  PreCheckFunc
+	postCheck PostCheckFunc
+
+	proxyAppConn proxy.AppConnMempool
+
+	// Keeps track of the rechecking process; nil when not rechecking.
+	recheck atomic.Pointer[priorityRecheck]
+
+	// Data in the following variables must to be kept in sync and updated atomically.
+	txsMtx   cmtsync.RWMutex
+	txs      *clist.CList                    // all (valid) txs, in order of arrival
+	txsMap   map[types.TxKey]*clist.CElement // for quick access to the mempool entry of a given tx
+	senders  map[string][]*priorityTx        // txs of each sender, sorted by nonce
+	txsBytes int64                           // total size of mempool, in bytes
+	seq      int64                           // sequence number of the last added tx
+
+	// Txs without sender and the first tx of each sender, in reap order.
+	reapable *priorityTxHeap
+	// Txs without sender and the last tx of each sender, in eviction order.
+	evictable *priorityTxHeap
+
+	// Keep a cache of already-seen txs.
+	// This reduces the pressure on the proxyApp.
+	cache TxCache
+
+	logger  log.Logger
+	metrics *Metrics
+}
+
+var _ Mempool = &PriorityMempool{}
+
+// PriorityMempoolOption sets an optional parameter on the mempool.
+type PriorityMempoolOption func(*PriorityMempool)
+
+// priorityTx is an entry in the PriorityMempool.
+type priorityTx struct {
+	mempoolTx
+	priority int64  // as returned by the application in CheckTx
+	sender   string // application-defined sender; empty if the tx is not grouped
+	nonce    uint64 // position of the tx among the txs of the same sender
+
+	reapIdx  int // position in the reapable heap, if there
+	evictIdx int // position in the evictable heap, if there
+}
+
+// higherPriority returns true iff memTx should be reaped before other,
+// assuming they have different senders.
+func (memTx *priorityTx) higherPriority(other *priorityTx) bool {
+	if memTx.priority != other.priority {
+		return memTx.priority > other.priority
+	}
+	return memTx.seq < other.seq
+}
+
+// reapOrder orders entries by decreasing priority, with ties broken by order
+// of arrival.
+func reapOrder(a, b *priorityTx) bool { return a.higherPriority(b) }
+
+// evictOrder orders entries by increasing priority, with ties broken by
+// reverse order of arrival, so that the newest entry is evicted first.
+func evictOrder(a, b *priorityTx) bool { return b.higherPriority(a) }
+
+// NewPriorityMempool returns a new priority mempool with the given
+// configuration and connection to an application.
+func NewPriorityMempool(
+	cfg *config.MempoolConfig,
+	proxyAppConn proxy.AppConnMempool,
+	height int64,
+	options ...PriorityMempoolOption,
+) *PriorityMempool {
+	mp := &PriorityMempool{
+		config:       cfg,
+		proxyAppConn: proxyAppConn,
+		txs:          clist.New(),
+		txsMap:       make(map[types.TxKey]*clist.CElement),
+		senders:      make(map[string][]*priorityTx),
+		reapable:     newPriorityTxIndex(reapOrder, func(memTx *priorityTx) *int { return &memTx.reapIdx }),
+		evictable:    newPriorityTxIndex(evictOrder, func(memTx *priorityTx) *int { return &memTx.evictIdx }),
+		logger:       log.NewNopLogger(),
+		metrics:      NopMetrics(),
+	}
+	mp.height.Store(height)
+
+	if cfg.CacheSize > 0 {
+		mp.cache = NewLRUTxCache(cfg.CacheSize)
+	} else {
+		mp.cache = NopTxCache{}
+	}
+
+	for _, option := range options {
+		option(mp)
+	}
+
+	return mp
+}
+
+// WithPriorityPreCheck sets a filter for the mempool to reject a tx if f(tx)
+// returns false. This is ran before CheckTx. Only applies to the first created
+// block. After that, Update overwrites the existing value.
+func WithPriorityPreCheck(f PreCheckFunc) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.preCheck = f }
+}
+
+// WithPriorityPostCheck sets a filter for the mempool to reject a tx if f(tx)
+// returns false. This is ran after CheckTx. Only applies to the first created
+// block. After that, Update overwrites the existing value.
+func WithPriorityPostCheck(f PostCheckFunc) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.postCheck = f }
+}
+
+// WithPriorityMetrics sets the metrics.
+func WithPriorityMetrics(metrics *Metrics) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.metrics = metrics }
+}
+
+// WithPriorityNewTxCallback sets a callback function to be executed when a new
+// transaction is added to the mempool.
+func WithPriorityNewTxCallback(cb func(types.Tx)) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.onNewTx = cb }
+}
+
+// WithPriorityTxExpiredCallback sets a callback function to be executed when a
+// transaction is removed from the mempool because its TTL expired.
+func WithPriorityTxExpiredCallback(cb TxExpiredCallback) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.onTxExpired = cb }
+}
+
+// WithPriorityTxRejectedCallback sets a callback function to be executed when
+// a transaction is rejected after CheckTx.
+func WithPriorityTxRejectedCallback(cb TxRejectedCallback) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.onTxRejected = cb }
+}
+
+// WithPriorityTxEvictedCallback sets a callback function to be executed when a
+// valid transaction is removed from the mempool before being committed.
+func WithPriorityTxEvictedCallback(cb TxEvictedCallback) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.onTxEvicted = cb }
+}
+
+// WithPriorityTxRecheckFailedCallback sets a callback function to be executed
+// when a transaction is removed from the mempool because it failed a recheck.
+func WithPriorityTxRecheckFailedCallback(cb TxRecheckFailedCallback) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.onTxRecheckFailed = cb }
+}
+
+// WithPriorityTxReplacedCallback sets a callback function to be executed when
+// a transaction is replaced by another one, as requested by the application.
+func WithPriorityTxReplacedCallback(cb TxReplacedCallback) PriorityMempoolOption {
+	return func(mem *PriorityMempool) { mem.onTxReplaced = cb }
+}
+
+// NOTE: not thread safe - should only be called once, on startup.
+func (mem *PriorityMempool) EnableTxsAvailable() {
+	mem.txsAvailable = make(chan struct{}, 1)
+}
+
+// SetLogger sets the Logger.
+func (mem *PriorityMempool) SetLogger(l log.Logger) {
+	mem.logger = l
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) Lock() {
+	mem.updateMtx.Lock()
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) Unlock() {
+	mem.updateMtx.Unlock()
+}
+
+// PreUpdate does nothing: rechecking always finishes before Update returns.
+func (*PriorityMempool) PreUpdate() {}
+
+// Size returns the number of transactions in the mempool.
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) Size() int {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	return mem.txs.Len()
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) SizeBytes() int64 {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	return mem.txsBytes
+}
+
+// Lanes returns the only lane of the mempool, as lanes are not supported.
+func (*PriorityMempool) Lanes() []LaneID {
+	return []LaneID{defaultLane}
+}
+
+// LaneSizes returns the number of transactions in the mempool and their total
+// size in bytes. It panics if lane is not the default lane.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) LaneSizes(lane LaneID) (numTxs int, bytes int64) {
+	if lane != defaultLane {
+		panic(ErrLaneNotFound{laneID: lane})
+	}
+
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	return mem.txs.Len(), mem.txsBytes
+}
+
+// LaneTxs returns up to limit transactions, in the order in which they would
+// be reaped, skipping the first offset ones. It returns an error if lane is
+// not the default lane.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) LaneTxs(lane LaneID, offset, limit int) (types.Txs, error) {
+	if lane != defaultLane {
+		return nil, ErrLaneNotFound{laneID: lane}
+	}
+
+	txs := make(types.Txs, 0)
+	mem.reap(func(memTx *priorityTx) bool {
+		if len(txs) >= limit {
+			return false
+		}
+		if offset > 0 {
+			offset--
+		} else {
+			txs = append(txs, memTx.tx)
+		}
+		return true
+	})
+	return txs, nil
+}
+
+// Lock() must be help by the caller during execution.
+func (mem *PriorityMempool) FlushAppConn() error {
+	err := mem.proxyAppConn.Flush(context.TODO())
+	if err != nil {
+		return ErrFlushAppConn{Err: err}
+	}
+
+	return nil
+}
+
+// XXX: Unsafe! Calling Flush may leave mempool in inconsistent state.
+func (mem *PriorityMempool) Flush() {
+	mem.updateMtx.Lock()
+	defer mem.updateMtx.Unlock()
+
+	mem.cache.Reset()
+
+	mem.txsMtx.Lock()
+	removed := make([]types.Tx, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		mem.txs.Remove(e)
+		e.DetachPrev()
+		removed = append(removed, e.Value.(*priorityTx).tx)
+	}
+	mem.txsMap = make(map[types.TxKey]*clist.CElement)
+	mem.senders = make(map[string][]*priorityTx)
+	mem.reapable.reset()
+	mem.evictable.reset()
+	mem.txsBytes = 0
+	mem.txsMtx.Unlock()
+
+	if mem.onTxEvicted != nil {
+		for _, tx := range removed {
+			mem.onTxEvicted(tx, defaultLane, ErrMempoolFlushed)
+		}
+	}
+}
+
+func (mem *PriorityMempool) Contains(txKey types.TxKey) bool {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	_, ok := mem.txsMap[txKey]
+	return ok
+}
+
+// GetTxByHash returns the types.Tx with the given hash if found in the mempool, otherwise returns nil.
+func (mem *PriorityMempool) GetTxByHash(hash []byte) types.Tx {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	if elem, ok := mem.txsMap[types.TxKey(hash)]; ok {
+		return elem.Value.(*priorityTx).tx
+	}
+	return nil
+}
+
+// setTxFromPeerAddedCallback sets the function called with the sender of every
+// tx received from a peer that is added to the mempool. It must be called
+// before the mempool receives txs.
+func (mem *PriorityMempool) setTxFromPeerAddedCallback(cb func(sender nodekey.ID)) {
+	mem.onTxFromPeerAdded = cb
+}
+
+// addSender adds a peer ID to the list of senders on the entry corresponding to
+// tx, identified by its key.
+func (mem *PriorityMempool) addSender(txKey types.TxKey, sender nodekey.ID) error {
+	if sender == noSender {
+		return nil
+	}
+
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	elem, ok := mem.txsMap[txKey]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	if found := elem.Value.(*priorityTx).addSender(sender); found {
+		// It should not be possible to receive twice a tx from the same sender.
+		return ErrTxAlreadyReceivedFromSender
+	}
+	return nil
+}
+
+// It blocks if we're waiting on Update() or Reap().
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) CheckTx(tx types.Tx, sender nodekey.ID) (*abcicli.ReqRes, error) {
+	mem.updateMtx.RLock()
+	// use defer to unlock mutex because application (*local client*) might panic
+	defer mem.updateMtx.RUnlock()
+
+	txSize := len(tx)
+
+	// Whether the tx fits in a full mempool depends on its priority, which is
+	// only known after CheckTx. Here we only discard txs that could never fit.
+	if int64(txSize) > mem.config.MaxTxsBytes {
+		mem.metrics.RejectedTxs.Add(1)
+		return nil, ErrMempoolIsFull{
+			NumTxs:      mem.Size(),
+			MaxTxs:      mem.config.Size,
+			TxsBytes:    mem.SizeBytes(),
+			MaxTxsBytes: mem.config.MaxTxsBytes,
+		}
+	}
+
+	if txSize > mem.config.MaxTxBytes {
+		return nil, ErrTxTooLarge{
+			Max:    mem.config.MaxTxBytes,
+			Actual: txSize,
+		}
+	}
+
+	if mem.preCheck != nil {
+		if err := mem.preCheck(tx); err != nil {
+			return nil, ErrPreCheck{Err: err}
+		}
+	}
+
+	// NOTE: proxyAppConn may error if tx buffer is full
+	if err := mem.proxyAppConn.Error(); err != nil {
+		return nil, ErrAppConnMempool{Err: err}
+	}
+
+	if added := mem.cache.Push(tx); !added {
+		mem.metrics.AlreadyReceivedTxs.Add(1)
+		// Record a new sender for a tx we've already seen. Note it's possible
+		// a tx is still in the cache but no longer in the mempool, so we only
+		// record the sender for txs still in the mempool.
+		if err := mem.addSender(tx.Key(), sender); err != nil {
+			mem.logger.Error("Could not add sender to tx", "tx", log.NewLazyHash(tx), "sender", sender, "err", err)
+		}
+		return nil, ErrTxInCache
+	}
+
+	reqRes, err := mem.proxyAppConn.CheckTxAsync(context.TODO(), &abci.CheckTxRequest{
+		Tx:   tx,
+		Type: abci.CHECK_TX_TYPE_CHECK,
+	})
+	if err != nil {
+		panic(fmt.Errorf("CheckTx request for tx %s failed: %w", tx.Hash(), err))
+	}
+	reqRes.SetCallback(mem.handleCheckTxResponse(tx, sender))
+
+	return reqRes, nil
+}
+
+// tryRemoveFromCache removes a transaction from the cache in case it can be
+// added to the mempool at a later stage (probably when the transaction becomes
+// valid).
+func (mem *PriorityMempool) tryRemoveFromCache(tx types.Tx) {
+	if !mem.config.KeepInvalidTxsInCache {
+		mem.cache.Remove(tx)
+	}
+}
+
+// handleCheckTxResponse handles CheckTx responses for transactions validated for the first time.
+//
+//   - sender optionally holds the ID of the peer that sent the transaction, if any.
+func (mem *PriorityMempool) handleCheckTxResponse(tx types.Tx, sender nodekey.ID) func(res *abci.Response) error {
+	return func(r *abci.Response) error {
+		res := r.GetCheckTx()
+		if res == nil {
+			panic(fmt.Sprintf("unexpected response value %v not of type CheckTx", r))
+		}
+
+		var postCheckErr error
+		if mem.postCheck != nil {
+			postCheckErr = mem.postCheck(tx, res)
+		}
+
+		// If tx is invalid, remove it from the cache.
+		if res.Code != abci.CodeTypeOK || postCheckErr != nil {
+			mem.tryRemoveFromCache(tx)
+			mem.logger.Debug(
+				"Rejected invalid transaction",
+				"tx", log.NewLazyHash(tx),
+				"res", res,
+				"err", postCheckErr,
+			)
+			mem.metrics.FailedTxs.Add(1)
+
+			err := ErrInvalidTx
+			if postCheckErr != nil {
+				err = postCheckErr
+			}
+			if mem.onTxRejected != nil {
+				mem.onTxRejected(tx, defaultLane, res.Code, err)
+			}
+			return err
+		}
+
+		// The app may indicate that tx supersedes a tx in the mempool.
+		var replacedKey types.TxKey
+		switch len(res.ReplacedTxKey) {
+		case 0:
+		case types.TxKeySize:
+			replacedKey = types.TxKey(res.ReplacedTxKey)
+		default:
+			mem.tryRemoveFromCache(tx)
+			mem.logger.Debug("Rejected transaction", "tx", log.NewLazyHash(tx), "err", ErrInvalidReplacedTxKey)
+			mem.metrics.FailedTxs.Add(1)
+			if mem.onTxRejected != nil {
+				mem.onTxRejected(tx, defaultLane, res.Code, ErrInvalidReplacedTxKey)
+			}
+			return ErrInvalidReplacedTxKey
+		}
+
+		memTx := &priorityTx{
+			mempoolTx: mempoolTx{
+				tx:        tx,
+				height:    mem.height.Load(),
+				gasWanted: res.GasWanted,
+				timestamp: cmttime.Now(),
+			},
+			priority: res.TxPriority,
+			sender:   res.TxSender,
+			nonce:    res.TxNonce,
+		}
+		_ = memTx.addSender(sender)
+
+		evicted, replaced, err := mem.addTx(memTx, replacedKey)
+		if err != nil {
+			if errors.Is(err, ErrTxInMempool) {
+				if err := mem.addSender(tx.Key(), sender); err != nil {
+					mem.logger.Error("Could not add sender to tx", "tx", tx.Hash(), "sender", sender, "err", err)
+				}
+			} else {
+				// The tx may fit at a later stage.
+				mem.cache.Remove(tx)
+				if mem.onTxRejected != nil {
+					mem.onTxRejected(tx, defaultLane, res.Code, err)
+				}
+			}
+			mem.metrics.RejectedTxs.Add(1)
+			// use debug level to avoid spamming logs when traffic is high
+			mem.logger.Debug("Reject tx", "tx", log.NewLazyHash(tx), "height", mem.height.Load(), "err", err)
+			return err
+		}
+
+		for _, evictedTx := range evicted {
+			// Evicted txs are still valid, so they may be added again later.
+			mem.cache.Remove(evictedTx.tx)
+			mem.metrics.EvictedTxs.Add(1)
+			mem.logger.Debug(
+				"Evicted transaction to make room for a tx with higher priority",
+				"tx", log.NewLazyHash(evictedTx.tx),
+				"priority", evictedTx.priority,
+				"new-tx", log.NewLazyHash(tx),
+				"new-priority", memTx.priority,
+			)
+			if mem.onTxEvicted != nil {
+				mem.onTxEvicted(evictedTx.tx, defaultLane, ErrTxEvictedForPriority)
+			}
+		}
+
+		if replaced != nil {
+			// Keep the replaced tx in the cache so that it is not added again
+			// when received from peers that haven't seen the new tx yet.
+			mem.cache.Push(replaced.tx)
+			mem.metrics.ReplacedTxs.Add(1)
+			if mem.onTxReplaced != nil {
+				mem.onTxReplaced(replaced.tx, tx, defaultLane)
+			}
+		}
+
+		mem.notifyTxsAvailable()
+
+		if mem.onNewTx != nil {
+			mem.onNewTx(tx)
+		}
+		if mem.onTxFromPeerAdded != nil && sender != noSender {
+			mem.onTxFromPeerAdded(sender)
+		}
+
+		mem.updateSizeMetrics()
+
+		return nil
+	}
+}
+
+// addTx adds a validated transaction to the mempool, evicting entries with
+// lower priority if the mempool is full. It returns the evicted entries.
+//
+// If the tx with the key replacedKey is in the mempool, it is removed in the
+// same critical section and returned. It must have the same sender and nonce
+// as the new tx, which is added like any new tx: as the replaced tx, it is
+// reaped in the order of its priority and nonce.
+//
+// Called from:
+//   - handleCheckTxResponse (lock not held) if tx is valid
+func (mem *PriorityMempool) addTx(
+	memTx *priorityTx,
+	replacedKey types.TxKey,
+) (evicted []*priorityTx, replaced *priorityTx, err error) {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	// Check that tx is not already in the mempool. This can happen when the
+	// cache overflows.
+	txKey := memTx.tx.Key()
+	if _, ok := mem.txsMap[txKey]; ok {
+		return nil, nil, ErrTxInMempool
+	}
+
+	if elem, ok := mem.txsMap[replacedKey]; ok {
+		replaced = elem.Value.(*priorityTx)
+		if replaced.sender != memTx.sender || (memTx.sender != "" && replaced.nonce != memTx.nonce) {
+			return nil, nil, ErrReplacedTxMismatch
+		}
+	}
+
+	if memTx.sender != "" {
+		if i, found := mem.findNonce(memTx.sender, memTx.nonce); found && mem.senders[memTx.sender][i] != replaced {
+			return nil, nil, ErrTxNonceInUse{Sender: memTx.sender, Nonce: memTx.nonce}
+		}
+	}
+
+	evicted, ok := mem.evictionCandidates(memTx, replaced)
+	if !ok {
+		return nil, nil, ErrMempoolIsFull{
+			NumTxs:      mem.txs.Len(),
+			MaxTxs:      mem.config.Size,
+			TxsBytes:    mem.txsBytes,
+			MaxTxsBytes: mem.config.MaxTxsBytes,
+		}
+	}
+	for _, evictedTx := range evicted {
+		mem.removeTx(evictedTx.tx.Key())
+	}
+	if replaced != nil {
+		mem.removeTx(replacedKey)
+	}
+
+	mem.seq++
+	memTx.seq = mem.seq
+	e := mem.txs.PushBack(memTx)
+	mem.txsMap[txKey] = e
+	mem.txsBytes += int64(len(memTx.tx))
+
+	if memTx.sender != "" {
+		i, _ := mem.findNonce(memTx.sender, memTx.nonce)
+		mem.senders[memTx.sender] = slices.Insert(mem.senders[memTx.sender], i, memTx)
+	}
+	mem.indexTx(memTx)
+
+	// Update metrics.
+	mem.metrics.TxSizeBytes.Observe(float64(len(memTx.tx)))
+
+	mem.logger.Debug(
+		"Added transaction",
+		"tx", log.NewLazyHash(memTx.tx),
+		"priority", memTx.priority,
+		"height", mem.height.Load(),
+		"total", mem.txs.Len(),
+	)
+	return evicted, replaced, nil
+}
+
+// findNonce searches for the given nonce among the txs of sender. It returns
+// the position where it is or would be inserted, and whether it was found.
+//
+// The caller must hold txsMtx.
+func (mem *PriorityMempool) findNonce(sender string, nonce uint64) (int, bool) {
+	return slices.BinarySearchFunc(mem.senders[sender], nonce, func(memTx *priorityTx, nonce uint64) int {
+		switch {
+		case memTx.nonce < nonce:
+			return -1
+		case memTx.nonce > nonce:
+			return 1
+		}
+		return 0
+	})
+}
+
+// evictionCandidates returns the entries that need to be evicted, in order,
+// for newTx to fit in the mempool. It returns false if newTx does not fit even
+// after evicting all entries with a lower priority.
+//
+// Only the entry with the highest nonce of each sender can be evicted, so that
+// no gaps are created in the sequence of nonces. For the same reason, entries
+// with the same sender as newTx are never evicted.
+//
+// If replaced is not nil, it is removed along with the evicted entries, so its
+// space is available to newTx.
+//
+// The caller must hold txsMtx.
+func (mem *PriorityMempool) evictionCandidates(newTx, replaced *priorityTx) ([]*priorityTx, bool) {
+	numTxs, txsBytes := mem.txs.Len(), mem.txsBytes
+	if replaced != nil {
+		numTxs--
+		txsBytes -= int64(len(replaced.tx))
+	}
+	fits := func() bool {
+		return numTxs < mem.config.Size && int64(len(newTx.tx))+txsBytes <= mem.config.MaxTxsBytes
+	}
+	if fits() {
+		return nil, true
+	}
+
+	// Visit the evictable entries in eviction order without modifying the
+	// evictable heap: the frontier starts at its root, and each entry visited
+	// adds its children in the heap. Once the last entry of a sender is
+	// evicted, the previous one becomes evictable, so it's added too.
+	frontier := &priorityTxHeap{less: evictOrder}
+	if mem.evictable.Len() > 0 {
+		heap.Push(frontier, mem.evictable.txs[0])
+	}
+
+	var candidates []*priorityTx
+	for !fits() {
+		if frontier.Len() == 0 {
+			return nil, false
+		}
+		victim := heap.Pop(frontier).(*priorityTx)
+		if victim.priority >= newTx.priority {
+			return nil, false
+		}
+		if i, ok := mem.evictable.index(victim); ok {
+			for _, child := range []int{2*i + 1, 2*i + 2} {
+				if child < mem.evictable.Len() {
+					heap.Push(frontier, mem.evictable.txs[child])
+				}
+			}
+		}
+		if victim == replaced {
+			continue
+		}
+		if victim.sender != "" {
+			if victim.sender == newTx.sender {
+				continue
+			}
+			if i, _ := mem.findNonce(victim.sender, victim.nonce); i > 0 {
+				heap.Push(frontier, mem.senders[victim.sender][i-1])
+			}
+		}
+
+		candidates = append(candidates, victim)
+		numTxs--
+		txsBytes -= int64(len(victim.tx))
+	}
+	return candidates, true
+}
+
+// indexTx adds a new entry to the reapable and evictable heaps, replacing the
+// entry it displaces as the first or last tx of its sender. The entry must
+// already be in senders.
+//
+// The caller must hold txsMtx.
+func (mem *PriorityMempool) indexTx(memTx *priorityTx) {
+	if memTx.sender == "" {
+		heap.Push(mem.reapable, memTx)
+		heap.Push(mem.evictable, memTx)
+		return
+	}
+	txs := mem.senders[memTx.sender]
+	if txs[0] == memTx {
+		if len(txs) > 1 {
+			mem.reapable.remove(txs[1])
+		}
+		heap.Push(mem.reapable, memTx)
+	}
+	if last := len(txs) - 1; txs[last] == memTx {
+		if last > 0 {
+			mem.evictable.remove(txs[last-1])
+		}
+		heap.Push(mem.evictable, memTx)
+	}
+}
+
+// unindexTx removes an entry from the reapable and evictable heaps, replacing
+// it by the next first or last tx of its sender. The entry must still be in
+// senders.
+//
+// The caller must hold txsMtx.
+func (mem *PriorityMempool) unindexTx(memTx *priorityTx) {
+	if memTx.sender == "" {
+		mem.reapable.remove(memTx)
+		mem.evictable.remove(memTx)
+		return
+	}
+	txs := mem.senders[memTx.sender]
+	if txs[0] == memTx {
+		mem.reapable.remove(memTx)
+		if len(txs) > 1 {
+			heap.Push(mem.reapable, txs[1])
+		}
+	}
+	if last := len(txs) - 1; txs[last] == memTx {
+		mem.evictable.remove(memTx)
+		if last > 0 {
+			heap.Push(mem.evictable, txs[last-1])
+		}
+	}
+}
+
+// reindexTx restores the order of the reapable and evictable heaps after the
+// priority of an entry changed.
+//
+// The caller must hold txsMtx.
+func (mem *PriorityMempool) reindexTx(memTx *priorityTx) {
+	if i, ok := mem.reapable.index(memTx); ok {
+		heap.Fix(mem.reapable, i)
+	}
+	if i, ok := mem.evictable.index(memTx); ok {
+		heap.Fix(mem.evictable, i)
+	}
+}
+
+// RemoveTxByKey removes a transaction from the mempool by its TxKey index.
+// Called from:
+//   - Update (updateMtx held) if tx was committed
+//   - handleRecheckTxResponse (updateMtx not held) if tx was invalidated
+func (mem *PriorityMempool) RemoveTxByKey(txKey types.TxKey) error {
+	mem.txsMtx.Lock()
+	defer mem.txsMtx.Unlock()
+
+	return mem.removeTx(txKey)
+}
+
+// removeTx removes a transaction from the mempool.
+//
+// The caller must hold txsMtx.
+func (mem *PriorityMempool) removeTx(txKey types.TxKey) error {
+	elem, ok := mem.txsMap[txKey]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	memTx := elem.Value.(*priorityTx)
+
+	mem.txs.Remove(elem)
+	elem.DetachPrev()
+
+	delete(mem.txsMap, txKey)
+	mem.txsBytes -= int64(len(memTx.tx))
+
+	mem.unindexTx(memTx)
+	if memTx.sender != "" {
+		if i, found := mem.findNonce(memTx.sender, memTx.nonce); found {
+			mem.senders[memTx.sender] = slices.Delete(mem.senders[memTx.sender], i, i+1)
+		}
+		if len(mem.senders[memTx.sender]) == 0 {
+			delete(mem.senders, memTx.sender)
+		}
+	}
+
+	mem.logger.Debug(
+		"Removed transaction",
+		"tx", log.NewLazyHash(memTx.tx),
+		"height", mem.height.Load(),
+		"total", mem.txs.Len(),
+	)
+	return nil
+}
+
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) TxsAvailable() <-chan struct{} {
+	return mem.txsAvailable
+}
+
+func (mem *PriorityMempool) notifyTxsAvailable() {
+	if mem.Size() == 0 {
+		panic("notified txs available but mempool is empty!")
+	}
+	if mem.txsAvailable != nil && mem.notifiedTxsAvailable.CompareAndSwap(false, true) {
+		// channel cap is 1, so this will send once
+		select {
+		case mem.txsAvailable <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// reap calls fn on the entries in the order in which they should be reaped,
+// until it returns false: by decreasing priority, with ties broken by order of
+// arrival. The txs of a same sender are visited in increasing nonce order,
+// once the first of them is selected by its priority.
+func (mem *PriorityMempool) reap(fn func(memTx *priorityTx) bool) {
+	mem.txsMtx.RLock()
+	defer mem.txsMtx.RUnlock()
+
+	// The heap contains the txs without sender and the next tx of each sender.
+	// A copy of the reapable heap is already ordered, so it needs no init.
+	h := &priorityTxHeap{txs: slices.Clone(mem.reapable.txs), less: reapOrder}
+	for h.Len() > 0 {
+		memTx := heap.Pop(h).(*priorityTx)
+		if !fn(memTx) {
+			return
+		}
+		if memTx.sender == "" {
+			continue
+		}
+		txs := mem.senders[memTx.sender]
+		if i, _ := mem.findNonce(memTx.sender, memTx.nonce); i+1 < len(txs) {
+			heap.Push(h, txs[i+1])
+		}
+	}
+}
+
+// ReapMaxBytesMaxGas returns the transactions with the highest priority, up to
+// maxBytes and maxGas.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	var (
+		totalGas    int64
+		runningSize int64
+	)
+
+	txs := make([]types.Tx, 0)
+	mem.reap(func(memTx *priorityTx) bool {
+		dataSize := types.ComputeProtoSizeForTxs([]types.Tx{memTx.tx})
+
+		// Check total size requirement
+		if maxBytes > -1 && runningSize+dataSize > maxBytes {
+			return false
+		}
+		runningSize += dataSize
+
+		// Check total gas requirement.
+		// If maxGas is negative, skip this check.
+		newTotalGas := totalGas + memTx.gasWanted
+		if maxGas > -1 && newTotalGas > maxGas {
+			return false
+		}
+		totalGas = newTotalGas
+
+		txs = append(txs, memTx.tx)
+		return true
+	})
+	return txs
+}
+
+// ReapMaxTxs returns up to max transactions with the highest priority.
+//
+// Safe for concurrent use by multiple goroutines.
+func (mem *PriorityMempool) ReapMaxTxs(max int) types.Txs {
+	mem.updateMtx.RLock()
+	defer mem.updateMtx.RUnlock()
+
+	txs := make([]types.Tx, 0)
+	mem.reap(func(memTx *priorityTx) bool {
+		if max >= 0 && len(txs) >= max {
+			return false
+		}
+		txs = append(txs, memTx.tx)
+		return true
+	})
+	return txs
+}
+
+// Lock() must be help by the caller during execution.
+func (mem *PriorityMempool) Update(
+	height int64,
+	txs types.Txs,
+	txResults []*abci.ExecTxResult,
+	preCheck PreCheckFunc,
+	postCheck PostCheckFunc,
+) error {
+	mem.logger.Debug("Update", "height", height, "len(txs)", len(txs))
+
+	// Set height
+	mem.height.Store(height)
+	mem.notifiedTxsAvailable.Store(false)
+
+	if preCheck != nil {
+		mem.preCheck = preCheck
+	}
+	if postCheck != nil {
+		mem.postCheck = postCheck
+	}
+
+	for i, tx := range txs {
+		if txResults[i].Code == abci.CodeTypeOK {
+			// Add valid committed tx to the cache (if missing).
+			_ = mem.cache.Push(tx)
+		} else {
+			mem.tryRemoveFromCache(tx)
+		}
+
+		// Remove committed tx from the mempool.
+		if err := mem.RemoveTxByKey(tx.Key()); err != nil {
+			mem.logger.Debug("Committed transaction not in local mempool (not an error)",
+				"tx", log.NewLazyHash(tx),
+				"error", err.Error())
+		}
+	}
+
+	// Remove txs that stayed in the mempool for longer than the configured TTL.
+	mem.purgeExpiredTxs(height)
+
+	// Recheck txs left in the mempool to remove them if they became invalid in the new state.
+	if mem.config.Recheck {
+		mem.recheckTxs()
+	}
+
+	// Notify if there are still txs left in the mempool.
+	if mem.Size() > 0 {
+		mem.notifyTxsAvailable()
+	}
+
+	mem.updateSizeMetrics()
+
+	return nil
+}
+
+// purgeExpiredTxs removes the txs whose TTL has expired at the given height.
+// Expired txs are also removed from the cache so that they can be resubmitted.
+// Called from:
+//   - Update (updateMtx held)
+func (mem *PriorityMempool) purgeExpiredTxs(height int64) {
+	if mem.config.TTLNumBlocks == 0 && mem.config.TTLDuration == 0 {
+		return
+	}
+
+	now := cmttime.Now()
+	expired := make([]*priorityTx, 0)
+	mem.txsMtx.Lock()
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		memTx := e.Value.(*priorityTx)
+		if isExpired(mem.config, &memTx.mempoolTx, height, now) {
+			expired = append(expired, memTx)
+		}
+	}
+	for _, memTx := range expired {
+		_ = mem.removeTx(memTx.tx.Key())
+	}
+	mem.txsMtx.Unlock()
+
+	for _, memTx := range expired {
+		mem.cache.Remove(memTx.tx)
+		mem.metrics.ExpiredTxs.With("lane", defaultLane).Add(1)
+		mem.logger.Debug("Expired transaction", "tx", log.NewLazyHash(memTx.tx), "height", height)
+		if mem.onTxExpired != nil {
+			mem.onTxExpired(memTx.tx, defaultLane, height)
+		}
+	}
+}
+
+// updateSizeMetrics updates the size-related metrics.
+func (mem *PriorityMempool) updateSizeMetrics() {
+	mem.metrics.Size.Set(float64(mem.Size()))
+	mem.metrics.SizeBytes.Set(float64(mem.SizeBytes()))
+}
+
+// priorityRecheck keeps track of the responses pending in a rechecking process.
+type priorityRecheck struct {
+	numPendingTxs atomic.Int64
+	doneCh        chan struct{} // closed when all responses have been received
+}
+
+// responseReceived registers that a pending response has been received.
+func (rc *priorityRecheck) responseReceived() {
+	if rc.numPendingTxs.Add(-1) == 0 {
+		close(rc.doneCh)
+	}
+}
+
+// recheckTxs sends all transactions in the mempool to the app for
+// re-validation. When the function returns, all recheck responses from the app
+// have been processed, or the recheck timeout has expired.
+func (mem *PriorityMempool) recheckTxs() {
+	mem.logger.Debug("Recheck txs", "height", mem.height.Load(), "num-txs", mem.Size())
+
+	if mem.Size() <= 0 {
+		return
+	}
+
+	defer func(start time.Time) {
+		mem.metrics.RecheckDurationSeconds.Set(cmttime.Since(start).Seconds())
+	}(cmttime.Now())
+
+	rc := &priorityRecheck{doneCh: make(chan struct{})}
+	// Count one extra pending response until all requests have been sent, so
+	// that doneCh is not closed before.
+	rc.numPendingTxs.Store(1)
+	mem.recheck.Store(rc)
+	defer mem.recheck.Store(nil)
+
+	mem.txsMtx.RLock()
+	txs := make([]types.Tx, 0, mem.txs.Len())
+	for e := mem.txs.Front(); e != nil; e = e.Next() {
+		txs = append(txs, e.Value.(*priorityTx).tx)
+	}
+	mem.txsMtx.RUnlock()
+
+	for _, tx := range txs {
+		rc.numPendingTxs.Add(1)
+
+		// Send CheckTx request to the app to re-validate transaction.
+		resReq, err := mem.proxyAppConn.CheckTxAsync(context.TODO(), &abci.CheckTxRequest{
+			Tx:   tx,
+			Type: abci.CHECK_TX_TYPE_RECHECK,
+		})
+		if err != nil {
+			panic(fmt.Errorf("(re-)CheckTx request for tx %s failed: %w", tx.Hash(), err))
+		}
+		resReq.SetCallback(mem.handleRecheckTxResponse(tx, rc))
+	}
+	rc.responseReceived()
+
+	// Flush any pending asynchronous recheck requests to process.
+	mem.proxyAppConn.Flush(context.TODO())
+
+	// Give some time to finish processing the responses; then finish the
+	// rechecking process, even if not all txs were rechecked.
+	select {
+	case <-time.After(mem.config.RecheckTimeout):
+		mem.logger.Error("Timed out waiting for recheck responses")
+	case <-rc.doneCh:
+	}
+
+	if n := rc.numPendingTxs.Load(); n > 0 {
+		mem.logger.Error("Not all txs were rechecked", "not-rechecked", n)
+	}
+
+	mem.logger.Debug("Done rechecking", "height", mem.height.Load(), "num-txs", mem.Size())
+}
+
+// handleRecheckTxResponse handles CheckTx responses for transactions in the
+// mempool that need to be revalidated after a mempool update. A valid response
+// may update the priority of the transaction.
+func (mem *PriorityMempool) handleRecheckTxResponse(tx types.Tx, rc *priorityRecheck) func(res *abci.Response) error {
+	return func(r *abci.Response) error {
+		res := r.GetCheckTx()
+		if res == nil {
+			panic(fmt.Sprintf("unexpected response value %v not of type CheckTx", r))
+		}
+
+		// Check whether the rechecking process has finished.
+		if mem.recheck.Load() != rc {
+			mem.logger.Error("Failed to recheck tx", "tx", log.NewLazyHash(tx), "err", ErrLateRecheckResponse)
+			return ErrLateRecheckResponse
+		}
+		defer rc.responseReceived()
+		mem.metrics.RecheckTimes.Add(1)
+
+		var postCheckErr error
+		if mem.postCheck != nil {
+			postCheckErr = mem.postCheck(tx, res)
+		}
+
+		// If tx is invalid, remove it from the mempool and the cache.
+		if (res.Code != abci.CodeTypeOK) || postCheckErr != nil {
+			// Tx became invalidated due to newly committed block.
+			mem.logger.Debug("Tx is no longer valid", "tx", log.NewLazyHash(tx), "res", res, "postCheckErr", postCheckErr)
+			if err := mem.RemoveTxByKey(tx.Key()); err != nil {
+				mem.logger.Debug("Transaction could not be removed from mempool", "err", err)
+				return err
+			}
+			mem.metrics.EvictedTxs.Add(1)
+
+			mem.tryRemoveFromCache(tx)
+			err := ErrInvalidTx
+			if postCheckErr != nil {
+				err = postCheckErr
+			}
+			if mem.onTxRecheckFailed != nil {
+				mem.onTxRecheckFailed(tx, defaultLane, res.Code, err)
+			}
+			return err
+		}
+
+		// The priority of the tx may have changed in the new state.
+		mem.txsMtx.Lock()
+		if elem, ok := mem.txsMap[tx.Key()]; ok {
+			memTx := elem.Value.(*priorityTx)
+			memTx.priority = res.TxPriority
+			mem.reindexTx(memTx)
+		}
+		mem.txsMtx.Unlock()
+
+		return nil
+	}
+}
+
+// newGossipIterator implements gossipMempool. Transactions are gossiped in
+// order of arrival.
+func (mem *PriorityMempool) newGossipIterator(ctx context.Context, _ string) Iterator {
+	return &arrivalIterator{ctx: ctx, txs: mem.txs}
+}
+
+// getMetrics implements gossipMempool.
+func (mem *PriorityMempool) getMetrics() *Metrics {
+	return mem.metrics
+}
+
+// inCache implements gossipMempool.
+func (mem *PriorityMempool) inCache(txKey types.TxKey) bool {
+	return mem.cache.HasKey(txKey)
+}
+
+// replayJournal implements gossipMempool. The priority mempool does not support
+// a journal, so there is nothing to replay.
+func (*PriorityMempool) replayJournal() error {
+	return nil
+}
+
+// priorityTxHeap is a heap of entries ordered by less. If pos is not nil, it
+// keeps track of the position of each entry in the heap, so that any of them
+// can be removed or fixed in O(log n).
+type priorityTxHeap struct {
+	txs  []*priorityTx
+	less func(a, b *priorityTx) bool
+	pos  func(memTx *priorityTx) *int
+}
+
+func newPriorityTxIndex(less func(a, b *priorityTx) bool, pos func(memTx *priorityTx) *int) *priorityTxHeap {
+	return &priorityTxHeap{less: less, pos: pos}
+}
+
+func (h *priorityTxHeap) Len() int           { return len(h.txs) }
+func (h *priorityTxHeap) Less(i, j int) bool { return h.less(h.txs[i], h.txs[j]) }
+
+func (h *priorityTxHeap) Swap(i, j int) {
+	h.txs[i], h.txs[j] = h.txs[j], h.txs[i]
+	if h.pos != nil {
+		*h.pos(h.txs[i]) = i
+		*h.pos(h.txs[j]) = j
+	}
+}
+
+func (h *priorityTxHeap) Push(x any) {
+	memTx := x.(*priorityTx)
+	if h.pos != nil {
+		*h.pos(memTx) = len(h.txs)
+	}
+	h.txs = append(h.txs, memTx)
+}
+
+func (h *priorityTxHeap) Pop() any {
+	n := len(h.txs)
+	memTx := h.txs[n-1]
+	h.txs[n-1] = nil
+	h.txs = h.txs[:n-1]
+	return memTx
+}
+
+// index returns the position of memTx in the heap, and whether it is there.
+// The heap must keep track of positions.
+func (h *priorityTxHeap) index(memTx *priorityTx) (int, bool) {
+	i := *h.pos(memTx)
+	return i, i >= 0 && i < len(h.txs) && h.txs[i] == memTx
+}
+
+// remove removes memTx from the heap, if there.
+func (h *priorityTxHeap) remove(memTx *priorityTx) {
+	if i, ok := h.index(memTx); ok {
+		heap.Remove(h, i)
+	}
+}
+
+func (h *priorityTxHeap) reset() {
+	h.txs = nil
+}
@@ -0,0 +1,475 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+// priorityApp is an application that assigns to each tx the priority, sender
+// and nonce encoded in the tx as "<id>/<priority>/<sender>/<nonce>", where
+// sender and nonce are optional.
+type priorityApp struct {
+	abci.BaseApplication
+
+	mtx        cmtsync.Mutex
+	invalid    map[string]bool   // ids of txs that are invalid
+	priorities map[string]int64  // ids of txs whose priority changed
+	replaced   map[string]string // ids of txs that replace another tx
+}
+
+func newPriorityApp() *priorityApp {
+	return &priorityApp{
+		invalid:    make(map[string]bool),
+		priorities: make(map[string]int64),
+		replaced:   make(map[string]string),
+	}
+}
+
+func (app *priorityApp) CheckTx(_ context.Context, req *abci.CheckTxRequest) (*abci.CheckTxResponse, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	parts := strings.Split(string(req.Tx), "/")
+	if len(parts) < 2 || app.invalid[parts[0]] {
+		return &abci.CheckTxResponse{Code: 1}, nil
+	}
+	priority, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return &abci.CheckTxResponse{Code: 1}, nil
+	}
+	if p, ok := app.priorities[parts[0]]; ok {
+		priority = p
+	}
+	res := &abci.CheckTxResponse{Code: abci.CodeTypeOK, GasWanted: 1, TxPriority: priority}
+	if replaced, ok := app.replaced[parts[0]]; ok {
+		key := types.Tx(replaced).Key()
+		res.ReplacedTxKey = key[:]
+		if replaced == "malformed" {
+			res.ReplacedTxKey = key[:8]
+		}
+	}
+	if len(parts) == 4 {
+		res.TxSender = parts[2]
+		if res.TxNonce, err = strconv.ParseUint(parts[3], 10, 64); err != nil {
+			return &abci.CheckTxResponse{Code: 1}, nil
+		}
+	}
+	return res, nil
+}
+
+func newPriorityMempoolWithApp(t *testing.T, app abci.Application, cfg *config.Config) *PriorityMempool {
+	t.Helper()
+
+	appConnMem, err := proxy.NewLocalClientCreator(app).NewABCIMempoolClient()
+	require.NoError(t, err)
+	appConnMem.SetLogger(log.TestingLogger().With("module", "abci-client", "connection", "mempool"))
+	require.NoError(t, appConnMem.Start())
+	t.Cleanup(func() {
+		_ = appConnMem.Stop()
+		os.RemoveAll(cfg.RootDir)
+	})
+
+	mp := NewPriorityMempool(cfg.Mempool, appConnMem, 0)
+	mp.SetLogger(log.TestingLogger())
+	return mp
+}
+
+// checkPriorityTxs calls CheckTx on each tx and returns the errors returned
+// either by CheckTx or by the response callback.
+func checkPriorityTxs(t *testing.T, mp Mempool, txs ...string) []error {
+	t.Helper()
+	errs := make([]error, 0, len(txs))
+	for _, tx := range txs {
+		rr, err := mp.CheckTx(types.Tx(tx), "")
+		if err == nil {
+			rr.Wait()
+			err = rr.Error()
+		}
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func requireTxs(t *testing.T, expected []string, txs types.Txs) {
+	t.Helper()
+	actual := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		actual = append(actual, string(tx))
+	}
+	require.Equal(t, expected, actual)
+}
+
+func TestPriorityMempoolReapOrder(t *testing.T) {
+	mp := newPriorityMempoolWithApp(t, newPriorityApp(), test.ResetTestRoot("mempool_test"))
+
+	errs := checkPriorityTxs(t, mp,
+		"a/1",
+		"b/5",
+		"c/3",
+		"d/5",
+		// alice's txs must be reaped in nonce order, regardless of their priority.
+		"e/100/alice/1",
+		"f/2/alice/0",
+		"g/4/bob/7",
+	)
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	require.Equal(t, 7, mp.Size())
+
+	expected := []string{"b/5", "d/5", "g/4/bob/7", "c/3", "f/2/alice/0", "e/100/alice/1", "a/1"}
+	requireTxs(t, expected, mp.ReapMaxBytesMaxGas(-1, -1))
+	requireTxs(t, expected[:3], mp.ReapMaxTxs(3))
+	requireTxs(t, expected[:4], mp.ReapMaxBytesMaxGas(-1, 4))
+
+	// A tx with the same sender and nonce as an existing one is rejected.
+	errs = checkPriorityTxs(t, mp, "h/50/alice/0")
+	require.ErrorAs(t, errs[0], &ErrTxNonceInUse{})
+}
+
+func TestPriorityMempoolLaneTxs(t *testing.T) {
+	mp := newPriorityMempoolWithApp(t, newPriorityApp(), test.ResetTestRoot("mempool_test"))
+
+	errs := checkPriorityTxs(t, mp, "a/1", "b/5", "c/3", "d/2")
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []LaneID{defaultLane}, mp.Lanes())
+	numTxs, bytes := mp.LaneSizes(defaultLane)
+	require.Equal(t, 4, numTxs)
+	require.Equal(t, mp.SizeBytes(), bytes)
+
+	txs, err := mp.LaneTxs(defaultLane, 0, 10)
+	require.NoError(t, err)
+	requireTxs(t, []string{"b/5", "c/3", "d/2", "a/1"}, txs)
+
+	txs, err = mp.LaneTxs(defaultLane, 1, 2)
+	require.NoError(t, err)
+	requireTxs(t, []string{"c/3", "d/2"}, txs)
+
+	txs, err = mp.LaneTxs(defaultLane, 10, 2)
+	require.NoError(t, err)
+	require.Empty(t, txs)
+
+	_, err = mp.LaneTxs("foo", 0, 10)
+	require.ErrorAs(t, err, &ErrLaneNotFound{})
+}
+
+func TestPriorityMempoolEviction(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 4
+	mp := newPriorityMempoolWithApp(t, newPriorityApp(), cfg)
+
+	for _, err := range checkPriorityTxs(t, mp, "a/1", "b/2", "c/1/alice/0", "d/3/alice/1") {
+		require.NoError(t, err)
+	}
+
+	// The lowest priority entries are "a" and "c", but "c" cannot be evicted
+	// before "d", which has a higher priority.
+	errs := checkPriorityTxs(t, mp, "e/2")
+	require.NoError(t, errs[0])
+	require.False(t, mp.Contains(types.Tx("a/1").Key()))
+	requireTxs(t, []string{"b/2", "e/2", "c/1/alice/0", "d/3/alice/1"}, mp.ReapMaxTxs(-1))
+
+	// A tx with a priority that is not higher than any evictable entry is
+	// rejected.
+	errs = checkPriorityTxs(t, mp, "f/2")
+	require.ErrorAs(t, errs[0], &ErrMempoolIsFull{})
+	require.Equal(t, 4, mp.Size())
+
+	// Among entries with the same priority, the newest is evicted first.
+	errs = checkPriorityTxs(t, mp, "g/10")
+	require.NoError(t, errs[0])
+	requireTxs(t, []string{"g/10", "b/2", "c/1/alice/0", "d/3/alice/1"}, mp.ReapMaxTxs(-1))
+
+	// Evicted txs are removed from the cache so that they can be resubmitted.
+	errs = checkPriorityTxs(t, mp, "a/1")
+	require.ErrorAs(t, errs[0], &ErrMempoolIsFull{})
+}
+
+func TestPriorityMempoolEvictionIndexes(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 30
+	app := newPriorityApp()
+	mp := newPriorityMempoolWithApp(t, app, cfg)
+
+	senders := []string{"", "", "alice", "bob", "carol"}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 500; i++ {
+		tx := fmt.Sprintf("%d/%d", i, r.Intn(50))
+		if sender := senders[r.Intn(len(senders))]; sender != "" {
+			tx = fmt.Sprintf("%s/%s/%d", tx, sender, r.Intn(20))
+		}
+		_ = checkPriorityTxs(t, mp, tx)
+
+		if i%50 == 49 {
+			// Change the priorities of some txs on recheck.
+			app.mtx.Lock()
+			for j := 0; j < 10; j++ {
+				app.priorities[strconv.Itoa(r.Intn(i))] = int64(r.Intn(50))
+			}
+			app.mtx.Unlock()
+			// Commit some txs, which removes them from the mempool.
+			doUpdate(t, mp, int64(i/50+1), mp.ReapMaxTxs(5))
+		}
+		requirePriorityIndexes(t, mp)
+	}
+}
+
+// requirePriorityIndexes checks that the reapable and evictable heaps hold
+// the txs without sender and the first, respectively last, tx of each sender.
+func requirePriorityIndexes(t *testing.T, mp *PriorityMempool) {
+	t.Helper()
+	mp.txsMtx.RLock()
+	defer mp.txsMtx.RUnlock()
+
+	var reapable, evictable []*priorityTx
+	for e := mp.txs.Front(); e != nil; e = e.Next() {
+		if memTx := e.Value.(*priorityTx); memTx.sender == "" {
+			reapable = append(reapable, memTx)
+			evictable = append(evictable, memTx)
+		}
+	}
+	for _, txs := range mp.senders {
+		reapable = append(reapable, txs[0])
+		evictable = append(evictable, txs[len(txs)-1])
+	}
+	require.ElementsMatch(t, reapable, mp.reapable.txs)
+	require.ElementsMatch(t, evictable, mp.evictable.txs)
+
+	for _, h := range []*priorityTxHeap{mp.reapable, mp.evictable} {
+		for i := range h.txs {
+			j, ok := h.index(h.txs[i])
+			require.True(t, ok)
+			require.Equal(t, i, j)
+			if i > 0 {
+				require.False(t, h.Less(i, (i-1)/2), "heap order violated at %d", i)
+			}
+		}
+	}
+}
+
+func TestPriorityMempoolRemovedTxCallbacks(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 2
+	app := newPriorityApp()
+	mp := newPriorityMempoolWithApp(t, app, cfg)
+
+	rejected := make(map[string]error)
+	evicted := make(map[string]error)
+	var recheckFailed []string
+	mp.onTxRejected = func(tx types.Tx, _ LaneID, _ uint32, reason error) {
+		rejected[string(tx)] = reason
+	}
+	mp.onTxEvicted = func(tx types.Tx, _ LaneID, reason error) {
+		evicted[string(tx)] = reason
+	}
+	mp.onTxRecheckFailed = func(tx types.Tx, lane LaneID, code uint32, reason error) {
+		require.Equal(t, LaneID(defaultLane), lane)
+		require.Equal(t, uint32(1), code)
+		require.ErrorIs(t, reason, ErrInvalidTx)
+		recheckFailed = append(recheckFailed, string(tx))
+	}
+
+	errs := checkPriorityTxs(t, mp, "a/1", "b/2", "c", "d/3", "e/1")
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.ErrorIs(t, errs[2], ErrInvalidTx)
+	require.NoError(t, errs[3])
+	require.ErrorAs(t, errs[4], &ErrMempoolIsFull{})
+	require.Len(t, rejected, 2)
+	require.ErrorIs(t, rejected["c"], ErrInvalidTx)
+	require.ErrorAs(t, rejected["e/1"], &ErrMempoolIsFull{})
+	require.Equal(t, map[string]error{"a/1": ErrTxEvictedForPriority}, evicted)
+
+	app.mtx.Lock()
+	app.invalid["b"] = true
+	app.mtx.Unlock()
+	doUpdate(t, mp, 1, nil)
+	require.Equal(t, []string{"b/2"}, recheckFailed)
+
+	mp.Flush()
+	require.Equal(t, map[string]error{"a/1": ErrTxEvictedForPriority, "d/3": ErrMempoolFlushed}, evicted)
+}
+
+func TestPriorityMempoolReplaceTx(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.Size = 3
+	app := newPriorityApp()
+	mp := newPriorityMempoolWithApp(t, app, cfg)
+
+	var replaced [][2]string
+	mp.onTxReplaced = func(oldTx, newTx types.Tx, lane LaneID) {
+		require.Equal(t, LaneID(defaultLane), lane)
+		replaced = append(replaced, [2]string{string(oldTx), string(newTx)})
+	}
+
+	for _, err := range checkPriorityTxs(t, mp, "a/1", "b/2/alice/0", "c/1/alice/1") {
+		require.NoError(t, err)
+	}
+
+	// A tx replacing a tx with the same sender and nonce takes its place,
+	// even when the mempool is full.
+	app.mtx.Lock()
+	app.replaced["d"] = "b/2/alice/0"
+	app.replaced["e"] = "c/1/alice/1"
+	app.replaced["f"] = "a/1"
+	app.replaced["g"] = "unknown"
+	app.replaced["h"] = "malformed"
+	app.mtx.Unlock()
+	errs := checkPriorityTxs(t, mp, "d/3/alice/0")
+	require.NoError(t, errs[0])
+	require.Equal(t, [][2]string{{"b/2/alice/0", "d/3/alice/0"}}, replaced)
+	requireTxs(t, []string{"d/3/alice/0", "a/1", "c/1/alice/1"}, mp.ReapMaxTxs(-1))
+	requirePriorityIndexes(t, mp)
+
+	// The replaced tx stays in the cache.
+	_, err := mp.CheckTx(types.Tx("b/2/alice/0"), noSender)
+	require.ErrorIs(t, err, ErrTxInCache)
+
+	// A tx with another nonce or sender than the replaced tx is rejected.
+	errs = checkPriorityTxs(t, mp, "e/5/alice/2", "f/5/alice/0")
+	require.ErrorIs(t, errs[0], ErrReplacedTxMismatch)
+	require.ErrorIs(t, errs[1], ErrReplacedTxMismatch)
+	require.Equal(t, 3, mp.Size())
+
+	// A tx replacing a tx not in the mempool is added as a new tx, evicting
+	// another one.
+	errs = checkPriorityTxs(t, mp, "g/5")
+	require.NoError(t, errs[0])
+	require.Len(t, replaced, 1)
+	requireTxs(t, []string{"g/5", "d/3/alice/0", "a/1"}, mp.ReapMaxTxs(-1))
+	requirePriorityIndexes(t, mp)
+
+	// A tx with a malformed replaced key is rejected.
+	errs = checkPriorityTxs(t, mp, "h/5")
+	require.ErrorIs(t, errs[0], ErrInvalidReplacedTxKey)
+	require.Equal(t, 3, mp.Size())
+}
+
+func TestPriorityMempoolUpdate(t *testing.T) {
+	app := newPriorityApp()
+	mp := newPriorityMempoolWithApp(t, app, test.ResetTestRoot("mempool_test"))
+
+	for _, err := range checkPriorityTxs(t, mp, "a/1", "b/2", "c/3", "d/4/alice/0", "e/4/alice/1") {
+		require.NoError(t, err)
+	}
+
+	// On recheck, "b" becomes invalid and "a" gets the highest priority.
+	app.mtx.Lock()
+	app.invalid["b"] = true
+	app.priorities["a"] = 10
+	app.mtx.Unlock()
+
+	doUpdate(t, mp, 1, []types.Tx{types.Tx("d/4/alice/0")})
+	require.Equal(t, 3, mp.Size())
+	requireTxs(t, []string{"a/1", "e/4/alice/1", "c/3"}, mp.ReapMaxTxs(-1))
+
+	// Committed txs stay in the cache.
+	errs := checkPriorityTxs(t, mp, "d/4/alice/0")
+	require.ErrorIs(t, errs[0], ErrTxInCache)
+
+	mp.Flush()
+	require.Zero(t, mp.Size())
+	require.Zero(t, mp.SizeBytes())
+	require.Empty(t, mp.ReapMaxTxs(-1))
+}
+
+func TestPriorityMempoolTTL(t *testing.T) {
+	cfg := test.ResetTestRoot("mempool_test")
+	cfg.Mempool.TTLNumBlocks = 1
+	mp := newPriorityMempoolWithApp(t, newPriorityApp(), cfg)
+
+	var expired []string
+	mp.onTxExpired = func(tx types.Tx, _ LaneID, _ int64) {
+		expired = append(expired, string(tx))
+	}
+
+	for _, err := range checkPriorityTxs(t, mp, "a/1", "b/2/alice/0") {
+		require.NoError(t, err)
+	}
+	doUpdate(t, mp, 1, nil)
+	for _, err := range checkPriorityTxs(t, mp, "c/3", "d/4/alice/1") {
+		require.NoError(t, err)
+	}
+	require.Empty(t, expired)
+
+	doUpdate(t, mp, 2, nil)
+	require.ElementsMatch(t, []string{"a/1", "b/2/alice/0"}, expired)
+	requireTxs(t, []string{"d/4/alice/1", "c/3"}, mp.ReapMaxTxs(-1))
+
+	// Expired txs are removed from the cache so they can be resubmitted.
+	errs := checkPriorityTxs(t, mp, "a/1")
+	require.NoError(t, errs[0])
+}
+
+func TestPriorityMempoolGossipIterator(t *testing.T) {
+	mp := newPriorityMempoolWithApp(t, newPriorityApp(), test.ResetTestRoot("mempool_test"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	iter := mp.newGossipIterator(ctx, "test")
+
+	// Entries are iterated in order of arrival, including those added while
+	// waiting.
+	txs := make([]string, 0, 10)
+	for i := 0; i < 10; i++ {
+		txs = append(txs, fmt.Sprintf("%d/%d", i, 10-i))
+	}
+	go func() {
+		for _, err := range checkPriorityTxs(t, mp, txs...) {
+			require.NoError(t, err)
+		}
+	}()
+	for _, tx := range txs {
+		select {
+		case entry := <-iter.WaitNextCh():
+			require.NotNil(t, entry)
+			require.Equal(t, tx, string(entry.Tx()))
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for next entry")
+		}
+	}
+
+	// The iterator continues after the last accessed entry, even if it got
+	// removed.
+	require.NoError(t, mp.RemoveTxByKey(types.Tx(txs[9]).Key()))
+	ch := iter.WaitNextCh()
+	for _, err := range checkPriorityTxs(t, mp, "10/1") {
+		require.NoError(t, err)
+	}
+	select {
+	case entry := <-ch:
+		require.NotNil(t, entry)
+		require.Equal(t, "10/1", string(entry.Tx()))
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for next entry")
+	}
+
+	// Waiting stops when the context is done.
+	ch = iter.WaitNextCh()
+	cancel()
+	select {
+	case entry := <-ch:
+		require.Nil(t, entry)
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the iterator to stop")
+	}
+}
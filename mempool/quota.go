@@ -0,0 +1,131 @@
+package mempool
+
+import (
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p/nodekey"
+)
+
+// txsUsage is the number of txs, and their total size in bytes, contributed to
+// the mempool by a peer or a sender.
+type txsUsage struct {
+	numTxs int
+	bytes  int64
+}
+
+// exceeds returns true iff adding a tx of the given size would exceed any of
+// the given limits, where zero means no limit.
+func (u txsUsage) exceeds(txSize int, maxTxs int, maxBytes int64) bool {
+	return (maxTxs > 0 && u.numTxs >= maxTxs) ||
+		(maxBytes > 0 && u.bytes+int64(txSize) > maxBytes)
+}
+
+// txsQuotas keeps track of the txs contributed to the mempool by each peer and
+// by each sender defined by the application, to enforce the quotas set in the
+// config. It is not thread-safe.
+type txsQuotas struct {
+	config *config.MempoolConfig
+
+	peers   map[nodekey.ID]txsUsage
+	senders map[string]txsUsage
+}
+
+func newTxsQuotas(cfg *config.MempoolConfig) *txsQuotas {
+	return &txsQuotas{
+		config:  cfg,
+		peers:   make(map[nodekey.ID]txsUsage),
+		senders: make(map[string]txsUsage),
+	}
+}
+
+func (q *txsQuotas) peerQuotaEnabled() bool {
+	return q.config.MaxTxsPerPeer > 0 || q.config.MaxTxsBytesPerPeer > 0
+}
+
+func (q *txsQuotas) senderQuotaEnabled() bool {
+	return q.config.MaxTxsPerSender > 0 || q.config.MaxTxsBytesPerSender > 0
+}
+
+// checkPeer returns an error if adding a tx of the given size received from
+// peer would exceed the peer's quota. Txs without a peer are always accepted.
+func (q *txsQuotas) checkPeer(peer nodekey.ID, txSize int) error {
+	if peer == noSender || !q.peerQuotaEnabled() {
+		return nil
+	}
+	usage := q.peers[peer]
+	if usage.exceeds(txSize, q.config.MaxTxsPerPeer, q.config.MaxTxsBytesPerPeer) {
+		return ErrPeerQuotaExceeded{
+			Peer:     peer,
+			NumTxs:   usage.numTxs,
+			MaxTxs:   q.config.MaxTxsPerPeer,
+			Bytes:    usage.bytes,
+			MaxBytes: q.config.MaxTxsBytesPerPeer,
+		}
+	}
+	return nil
+}
+
+// checkSender returns an error if adding a tx of the given size with the given
+// app-defined sender would exceed the sender's quota. Txs without a sender are
+// always accepted.
+func (q *txsQuotas) checkSender(sender string, txSize int) error {
+	if sender == "" || !q.senderQuotaEnabled() {
+		return nil
+	}
+	usage := q.senders[sender]
+	if usage.exceeds(txSize, q.config.MaxTxsPerSender, q.config.MaxTxsBytesPerSender) {
+		return ErrSenderQuotaExceeded{
+			Sender:   sender,
+			NumTxs:   usage.numTxs,
+			MaxTxs:   q.config.MaxTxsPerSender,
+			Bytes:    usage.bytes,
+			MaxBytes: q.config.MaxTxsBytesPerSender,
+		}
+	}
+	return nil
+}
+
+// add counts memTx towards the quotas of its peer and its sender.
+func (q *txsQuotas) add(memTx *mempoolTx) {
+	size := int64(len(memTx.tx))
+	if memTx.quotaPeer != noSender && q.peerQuotaEnabled() {
+		usage := q.peers[memTx.quotaPeer]
+		usage.numTxs++
+		usage.bytes += size
+		q.peers[memTx.quotaPeer] = usage
+	}
+	if memTx.appSender != "" && q.senderQuotaEnabled() {
+		usage := q.senders[memTx.appSender]
+		usage.numTxs++
+		usage.bytes += size
+		q.senders[memTx.appSender] = usage
+	}
+}
+
+// remove stops counting memTx towards the quotas of its peer and its sender.
+func (q *txsQuotas) remove(memTx *mempoolTx) {
+	size := int64(len(memTx.tx))
+	if usage, ok := q.peers[memTx.quotaPeer]; ok {
+		usage.numTxs--
+		usage.bytes -= size
+		if usage.numTxs <= 0 {
+			delete(q.peers, memTx.quotaPeer)
+		} else {
+			q.peers[memTx.quotaPeer] = usage
+		}
+	}
+	if usage, ok := q.senders[memTx.appSender]; ok {
+		usage.numTxs--
+		usage.bytes -= size
+		if usage.numTxs <= 0 {
+			delete(q.senders, memTx.appSender)
+		} else {
+			q.senders[memTx.appSender] = usage
+		}
+	}
+}
+
+// reset forgets all the txs counted so far.
+func (q *txsQuotas) reset() {
+	q.peers = make(map[nodekey.ID]txsUsage)
+	q.senders = make(map[string]txsUsage)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync/atomic"
 	"time"
 
@@ -13,18 +14,39 @@ import (
 	protomem "github.com/cometbft/cometbft/api/cometbft/mempool/v1"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/nodekey"
 	tcpconn "github.com/cometbft/cometbft/p2p/transport/tcp/conn"
 	"github.com/cometbft/cometbft/types"
 )
 
+const (
+	// maxAnnouncedTxKeys is the maximum number of tx keys in a HaveTxs or
+	// WantTxs message.
+	maxAnnouncedTxKeys = 256
+
+	// announceInterval is the maximum time the key of a new tx waits to be
+	// announced to a peer, while a batch of keys is being filled.
+	announceInterval = 20 * time.Millisecond
+
+	// txRequestTimeout is the time to wait for a requested tx before it is
+	// requested again, to another peer that announced it.
+	txRequestTimeout = 2 * time.Second
+
+	// maxQuotaViolations is the number of txs over its quota that a peer can
+	// send within quotaViolationsWindow before being disconnected.
+	maxQuotaViolations    = 1000
+	quotaViolationsWindow = time.Minute
+)
+
 // Reactor handles mempool tx broadcasting amongst peers.
 // It maintains a map from peer ID to counter, to prevent gossiping txs to the
 // peers you received it from.
 type Reactor struct {
 	p2p.BaseReactor
 	config  *cfg.MempoolConfig
-	mempool *CListMempool
+	mempool gossipMempool
 
 	waitSync   atomic.Bool
 	waitSyncCh chan struct{} // for signaling when to start receiving and sending txs
@@ -34,16 +56,156 @@ type Reactor struct {
 	// connections for different groups of peers.
 	activePersistentPeersSemaphore    *semaphore.Weighted
 	activeNonPersistentPeersSemaphore *semaphore.Weighted
+
+	// Txs requested to peers with the pull-based gossip protocol, by key. Used
+	// to avoid requesting the same tx to multiple peers at once.
+	requestedTxsMtx cmtsync.Mutex
+	requestedTxs    map[types.TxKey]*txRequest
+
+	// State of the pull-based gossip protocol with each peer to which txs are
+	// announced.
+	pullPeersMtx cmtsync.Mutex
+	pullPeers    map[nodekey.ID]*pullPeer
+
+	// Number of txs received from each peer that exceeded its quota, in the
+	// current window.
+	quotaViolationsMtx cmtsync.Mutex
+	quotaViolations    map[nodekey.ID]*quotaViolations
+}
+
+// txRequest is a request for a tx to one of the peers that announced it.
+type txRequest struct {
+	requestedAt time.Time
+	// The other peers that announced the tx, in order, to which it is
+	// requested if the request times out.
+	announcers []nodekey.ID
+}
+
+// pullPeer is the state of the pull-based gossip protocol with a peer to which
+// txs are announced. The peer can only request the txs announced to it, and
+// each of them only once.
+type pullPeer struct {
+	mtx        cmtsync.Mutex
+	announced  map[types.TxKey]struct{} // announced to the peer and not requested yet
+	lastPruned time.Time
+
+	// Keys of the txs requested by the peer, sent by announceTxsRoutine, which
+	// is notified on requestedCh.
+	requested   []types.TxKey
+	requestedCh chan struct{}
+}
+
+func newPullPeer() *pullPeer {
+	return &pullPeer{
+		announced:   make(map[types.TxKey]struct{}),
+		requestedCh: make(chan struct{}, 1),
+	}
+}
+
+// announce records that the txs with the given keys were announced to the
+// peer. The keys of the txs that left the mempool are forgotten, at most once
+// per txRequestTimeout, so that the keys the peer never requests don't
+// accumulate.
+func (pp *pullPeer) announce(keys []types.TxKey, contains func(types.TxKey) bool, now time.Time) {
+	pp.mtx.Lock()
+	defer pp.mtx.Unlock()
+
+	if now.Sub(pp.lastPruned) >= txRequestTimeout {
+		for key := range pp.announced {
+			if !contains(key) {
+				delete(pp.announced, key)
+			}
+		}
+		pp.lastPruned = now
+	}
+	for _, key := range keys {
+		pp.announced[key] = struct{}{}
+	}
+}
+
+// request queues the txs with the given keys to be sent to the peer, if they
+// were announced to it and not requested yet. The queue is bounded by the
+// number of announced txs.
+func (pp *pullPeer) request(keys []types.TxKey) {
+	pp.mtx.Lock()
+	defer pp.mtx.Unlock()
+
+	for _, key := range keys {
+		if _, ok := pp.announced[key]; !ok {
+			continue
+		}
+		delete(pp.announced, key)
+		pp.requested = append(pp.requested, key)
+	}
+	if len(pp.requested) > 0 {
+		select {
+		case pp.requestedCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// takeRequested returns the keys of the txs to send to the peer, and empties
+// the queue.
+func (pp *pullPeer) takeRequested() []types.TxKey {
+	pp.mtx.Lock()
+	defer pp.mtx.Unlock()
+
+	requested := pp.requested
+	pp.requested = nil
+	return requested
+}
+
+// quotaViolations counts the txs over its quota sent by a peer since the start
+// of a window.
+type quotaViolations struct {
+	count int
+	since time.Time
+}
+
+// gossipMempool is a mempool whose transactions can be gossiped by the Reactor.
+// It is implemented by CListMempool and PriorityMempool.
+type gossipMempool interface {
+	Mempool
+
+	SetLogger(l log.Logger)
+
+	// newGossipIterator returns a blocking iterator over the entries to
+	// broadcast to a peer. The name is used for debugging.
+	newGossipIterator(ctx context.Context, name string) Iterator
+
+	// getMetrics returns the metrics of the mempool, which are also updated by
+	// the Reactor.
+	getMetrics() *Metrics
+
+	// inCache returns true iff the tx with the given key is in the cache.
+	inCache(txKey types.TxKey) bool
+
+	// addSender adds the peer to the list of senders of the given tx.
+	addSender(txKey types.TxKey, sender nodekey.ID) error
+
+	// setTxFromPeerAddedCallback sets the function called with the sender of
+	// every tx received from a peer that passes CheckTx and is added to the
+	// mempool.
+	setTxFromPeerAddedCallback(cb func(sender nodekey.ID))
+
+	// replayJournal adds to the mempool the txs restored from its journal, if
+	// any, after checking them again.
+	replayJournal() error
 }
 
 // NewReactor returns a new Reactor with the given config and mempool.
-func NewReactor(config *cfg.MempoolConfig, mempool *CListMempool, waitSync bool) *Reactor {
+func NewReactor(config *cfg.MempoolConfig, mempool gossipMempool, waitSync bool) *Reactor {
 	memR := &Reactor{
-		config:   config,
-		mempool:  mempool,
-		waitSync: atomic.Bool{},
+		config:          config,
+		mempool:         mempool,
+		waitSync:        atomic.Bool{},
+		requestedTxs:    make(map[types.TxKey]*txRequest),
+		pullPeers:       make(map[nodekey.ID]*pullPeer),
+		quotaViolations: make(map[nodekey.ID]*quotaViolations),
 	}
 	memR.BaseReactor = *p2p.NewBaseReactor("Mempool", memR)
+	mempool.setTxFromPeerAddedCallback(memR.reportUsefulTx)
 	if waitSync {
 		memR.waitSync.Store(true)
 		memR.waitSyncCh = make(chan struct{})
@@ -68,6 +230,15 @@ func (memR *Reactor) OnStart() error {
 	if !memR.config.Broadcast {
 		memR.Logger.Info("Tx broadcasting is disabled")
 	}
+	// When syncing, the journal is replayed once the node catches up, because
+	// txs cannot be checked against an outdated state.
+	if !memR.WaitSync() {
+		if err := memR.mempool.replayJournal(); err != nil {
+			return fmt.Errorf("failed to replay mempool journal: %w", err)
+		}
+	}
+	// Peers may announce txs whatever the protocol used to gossip txs to them.
+	go memR.retryTxRequestsRoutine()
 	return nil
 }
 
@@ -81,6 +252,16 @@ func (memR *Reactor) StreamDescriptors() []p2p.StreamDescriptor {
 		},
 	}
 
+	largestKeys := make([][]byte, maxAnnouncedTxKeys)
+	for i := range largestKeys {
+		largestKeys[i] = make([]byte, len(types.TxKey{}))
+	}
+	announceMsg := protomem.Message{
+		Sum: &protomem.Message_HaveTxs{
+			HaveTxs: &protomem.HaveTxs{TxKeys: largestKeys},
+		},
+	}
+
 	return []p2p.StreamDescriptor{
 		&tcpconn.ChannelDescriptor{
 			ID:                  MempoolChannel,
@@ -88,6 +269,12 @@ func (memR *Reactor) StreamDescriptors() []p2p.StreamDescriptor {
 			RecvMessageCapacity: batchMsg.Size(),
 			MessageTypeI:        &protomem.Message{},
 		},
+		&tcpconn.ChannelDescriptor{
+			ID:                  MempoolAnnounceChannel,
+			Priority:            5,
+			RecvMessageCapacity: announceMsg.Size(),
+			MessageTypeI:        &protomem.Message{},
+		},
 	}
 }
 
@@ -127,13 +314,20 @@ func (memR *Reactor) AddPeer(peer p2p.Peer) {
 				}
 			}
 
-			memR.mempool.metrics.ActiveOutboundConnections.Add(1)
-			defer memR.mempool.metrics.ActiveOutboundConnections.Add(-1)
+			memR.mempool.getMetrics().ActiveOutboundConnections.Add(1)
+			defer memR.mempool.getMetrics().ActiveOutboundConnections.Add(-1)
 			memR.broadcastTxRoutine(peer)
 		}()
 	}
 }
 
+// RemovePeer implements Reactor.
+func (memR *Reactor) RemovePeer(peer p2p.Peer, _ any) {
+	memR.quotaViolationsMtx.Lock()
+	delete(memR.quotaViolations, peer.ID())
+	memR.quotaViolationsMtx.Unlock()
+}
+
 // Receive implements Reactor.
 // It adds any received transactions to the mempool.
 func (memR *Reactor) Receive(e p2p.Envelope) {
@@ -151,9 +345,35 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 			return
 		}
 		for _, txBytes := range protoTxs {
-			memR.mempool.metrics.BytesReceived.Add(float64(len(txBytes)))
+			memR.mempool.getMetrics().BytesReceived.Add(float64(len(txBytes)))
+			memR.requestDone(types.Tx(txBytes).Key())
 			_, _ = memR.TryAddTx(types.Tx(txBytes), e.Src)
-			memR.mempool.metrics.TransactionsReceived.Add(1)
+			memR.mempool.getMetrics().TransactionsReceived.Add(1)
+		}
+
+	case *protomem.HaveTxs:
+		if memR.WaitSync() {
+			memR.Logger.Debug("Ignored message received while syncing", "msg", msg)
+			return
+		}
+		keys, err := txKeysFromProto(msg.GetTxKeys())
+		if err != nil {
+			memR.Switch.StopPeerForError(e.Src, err)
+			return
+		}
+		memR.requestMissingTxs(keys, e.Src)
+
+	case *protomem.WantTxs:
+		keys, err := txKeysFromProto(msg.GetTxKeys())
+		if err != nil {
+			memR.Switch.StopPeerForError(e.Src, err)
+			return
+		}
+		memR.pullPeersMtx.Lock()
+		pp, ok := memR.pullPeers[e.Src.ID()]
+		memR.pullPeersMtx.Unlock()
+		if ok {
+			pp.request(keys)
 		}
 
 	default:
@@ -165,6 +385,169 @@ func (memR *Reactor) Receive(e p2p.Envelope) {
 	// broadcasting happens from go routines per peer
 }
 
+// txKeysFromProto converts a list of tx keys received from a peer, checking
+// their size.
+func txKeysFromProto(protoKeys [][]byte) ([]types.TxKey, error) {
+	if len(protoKeys) == 0 {
+		return nil, errors.New("received empty list of tx keys")
+	}
+	if len(protoKeys) > maxAnnouncedTxKeys {
+		return nil, fmt.Errorf("received %d tx keys, max is %d", len(protoKeys), maxAnnouncedTxKeys)
+	}
+	keys := make([]types.TxKey, 0, len(protoKeys))
+	for _, k := range protoKeys {
+		if len(k) != len(types.TxKey{}) {
+			return nil, fmt.Errorf("invalid tx key size: %d", len(k))
+		}
+		keys = append(keys, types.TxKey(k))
+	}
+	return keys, nil
+}
+
+// requestMissingTxs requests to the peer the txs announced by it that are
+// neither in the mempool nor in the cache, and that are not already requested
+// to another peer. Otherwise, the peer is recorded as an announcer of the tx,
+// to which it is requested if the pending request times out.
+func (memR *Reactor) requestMissingTxs(keys []types.TxKey, peer p2p.Peer) {
+	wanted := make([]types.TxKey, 0, len(keys))
+	now := time.Now()
+
+	memR.requestedTxsMtx.Lock()
+	for _, key := range keys {
+		if memR.mempool.Contains(key) {
+			// Avoid announcing the tx back to the peer.
+			_ = memR.mempool.addSender(key, peer.ID())
+			continue
+		}
+		if memR.mempool.inCache(key) {
+			continue
+		}
+		if req, ok := memR.requestedTxs[key]; ok {
+			if !slices.Contains(req.announcers, peer.ID()) {
+				req.announcers = append(req.announcers, peer.ID())
+			}
+			continue
+		}
+		memR.requestedTxs[key] = &txRequest{requestedAt: now}
+		wanted = append(wanted, key)
+	}
+	memR.requestedTxsMtx.Unlock()
+
+	memR.sendTxRequests(wanted, peer)
+}
+
+// sendTxRequests requests the txs with the given keys to the peer, without
+// blocking. If the request can't be sent, the txs are requested again to the
+// peer right away by retryTxRequestsRoutine.
+func (memR *Reactor) sendTxRequests(keys []types.TxKey, peer p2p.Peer) {
+	for len(keys) > 0 {
+		batch := keys[:min(len(keys), maxAnnouncedTxKeys)]
+		keys = keys[len(batch):]
+
+		wanted := make([][]byte, 0, len(batch))
+		for _, key := range batch {
+			wanted = append(wanted, key[:])
+		}
+		memR.Logger.Debug("Requesting txs to peer", "peer", peer.ID(), "num", len(wanted))
+		if peer.TrySend(p2p.Envelope{
+			ChannelID: MempoolAnnounceChannel,
+			Message:   &protomem.WantTxs{TxKeys: wanted},
+		}) {
+			memR.mempool.getMetrics().TxsRequested.Add(float64(len(wanted)))
+			continue
+		}
+
+		memR.requestedTxsMtx.Lock()
+		for _, key := range batch {
+			if req, ok := memR.requestedTxs[key]; ok {
+				req.requestedAt = time.Time{}
+				req.announcers = append([]nodekey.ID{peer.ID()}, req.announcers...)
+			}
+		}
+		memR.requestedTxsMtx.Unlock()
+	}
+}
+
+// requestDone forgets that the tx with the given key was requested.
+func (memR *Reactor) requestDone(key types.TxKey) {
+	memR.requestedTxsMtx.Lock()
+	defer memR.requestedTxsMtx.Unlock()
+
+	delete(memR.requestedTxs, key)
+}
+
+// retryTxRequestsRoutine periodically requests the txs whose request timed out
+// to the next peer that announced them.
+func (memR *Reactor) retryTxRequestsRoutine() {
+	ticker := time.NewTicker(txRequestTimeout / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			memR.retryTxRequests(now)
+		case <-memR.Quit():
+			return
+		}
+	}
+}
+
+// retryTxRequests requests the txs whose request timed out to the next peer
+// that announced them and is still connected. The requests of the txs that no
+// other peer announced are dropped, so that the txs are requested again if
+// they are announced later.
+func (memR *Reactor) retryTxRequests(now time.Time) {
+	wanted := make(map[p2p.Peer][]types.TxKey)
+
+	memR.requestedTxsMtx.Lock()
+	for key, req := range memR.requestedTxs {
+		if now.Sub(req.requestedAt) < txRequestTimeout {
+			continue
+		}
+		if memR.mempool.Contains(key) || memR.mempool.inCache(key) {
+			delete(memR.requestedTxs, key)
+			continue
+		}
+		var peer p2p.Peer
+		for peer == nil && len(req.announcers) > 0 {
+			peer = memR.Switch.Peers().Get(req.announcers[0])
+			req.announcers = req.announcers[1:]
+		}
+		if peer == nil {
+			delete(memR.requestedTxs, key)
+			continue
+		}
+		req.requestedAt = now
+		wanted[peer] = append(wanted[peer], key)
+	}
+	memR.requestedTxsMtx.Unlock()
+
+	for peer, keys := range wanted {
+		memR.sendTxRequests(keys, peer)
+	}
+}
+
+// sendRequestedTx sends to the peer the requested tx, if it is still in the
+// mempool, in its own message as in the push-based protocol. It is only called
+// by the routine of the peer, so it doesn't block the receipt of messages.
+func (memR *Reactor) sendRequestedTx(key types.TxKey, peer p2p.Peer) {
+	tx := memR.mempool.GetTxByHash(key[:])
+	if tx == nil {
+		// The tx was removed from the mempool since it was announced.
+		return
+	}
+	txMessage := &protomem.Txs{Txs: [][]byte{tx}}
+	if !peer.Send(p2p.Envelope{
+		ChannelID: MempoolChannel,
+		Message:   txMessage,
+	}) {
+		memR.Logger.Debug("Failed sending requested transaction to peer",
+			"tx", log.NewLazySprintf("%X", key), "peer", peer.ID())
+		return
+	}
+	memR.mempool.getMetrics().BytesSent.Add(float64(txMessage.Size()))
+	memR.mempool.getMetrics().TransactionsSent.Add(1)
+}
+
 // TryAddTx attempts to add an incoming transaction to the mempool.
 // When the sender is nil, it means the transaction comes from an RPC endpoint.
 func (memR *Reactor) TryAddTx(tx types.Tx, sender p2p.Peer) (*abcicli.ReqRes, error) {
@@ -181,6 +564,12 @@ func (memR *Reactor) TryAddTx(tx types.Tx, sender p2p.Peer) (*abcicli.ReqRes, er
 		case errors.As(err, &ErrMempoolIsFull{}):
 			// using debug level to avoid flooding when traffic is high
 			memR.Logger.Debug(err.Error())
+		case errors.As(err, &ErrPeerQuotaExceeded{}):
+			// using debug level to avoid flooding when traffic is high
+			memR.Logger.Debug(err.Error())
+			if sender != nil {
+				memR.reportQuotaViolation(sender, err)
+			}
 		default:
 			memR.Logger.Info("Could not check tx", "tx", log.NewLazySprintf("%X", tx.Hash()), "sender", senderID, "err", err)
 		}
@@ -190,12 +579,49 @@ func (memR *Reactor) TryAddTx(tx types.Tx, sender p2p.Peer) (*abcicli.ReqRes, er
 	return reqRes, nil
 }
 
+// reportUsefulTx reports to the switch that the peer sent a tx that was added
+// to the mempool. Txs rejected by the application are not reported: the peer
+// can't tell whether a tx is valid before sending it.
+func (memR *Reactor) reportUsefulTx(sender nodekey.ID) {
+	if peer := memR.Switch.Peers().Get(sender); peer != nil {
+		memR.Switch.ReportBehaviour(peer, p2p.BehaviourUsefulMessage)
+	}
+}
+
+// reportQuotaViolation records that peer sent a tx over its quota, and
+// disconnects it if it does so too often.
+func (memR *Reactor) reportQuotaViolation(peer p2p.Peer, err error) {
+	now := time.Now()
+
+	memR.quotaViolationsMtx.Lock()
+	violations, ok := memR.quotaViolations[peer.ID()]
+	if !ok || now.Sub(violations.since) > quotaViolationsWindow {
+		violations = &quotaViolations{since: now}
+		memR.quotaViolations[peer.ID()] = violations
+	}
+	violations.count++
+	tooMany := violations.count > maxQuotaViolations
+	if tooMany {
+		delete(memR.quotaViolations, peer.ID())
+	}
+	memR.quotaViolationsMtx.Unlock()
+
+	if tooMany {
+		memR.Switch.StopPeerForError(peer, fmt.Errorf("too many txs over quota: %w", err))
+	}
+}
+
 func (memR *Reactor) EnableInOutTxs() {
 	memR.Logger.Info("Enabling inbound and outbound transactions")
 	if !memR.waitSync.CompareAndSwap(true, false) {
 		return
 	}
 
+	// Restore the txs from the journal before they can be broadcast.
+	if err := memR.mempool.replayJournal(); err != nil {
+		memR.Logger.Error("Failed to replay mempool journal", "err", err)
+	}
+
 	// Releases all the blocked broadcastTxRoutine instances.
 	if memR.config.Broadcast {
 		close(memR.waitSyncCh)
@@ -233,7 +659,14 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		}
 	}()
 
-	iter := NewBlockingIterator(ctx, memR.mempool, string(peer.ID()))
+	iter := memR.mempool.newGossipIterator(ctx, string(peer.ID()))
+
+	// Use the pull-based protocol only if the peer also supports it.
+	if memR.config.ExperimentalGossipProtocol == cfg.MempoolGossipProtocolPull && peer.HasChannel(MempoolAnnounceChannel) {
+		memR.announceTxsRoutine(peer, iter)
+		return
+	}
+
 	for {
 		// In case of both next.NextWaitChan() and peer.Quit() are variable at the same time
 		if !memR.IsRunning() || !peer.IsRunning() {
@@ -246,30 +679,8 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 			continue
 		}
 
-		// If we suspect that the peer is lagging behind, at least by more than
-		// one block, we don't send the transaction immediately. This code
-		// reduces the mempool size and the recheck-tx rate of the receiving
-		// node. See [RFC 103] for an analysis on this optimization.
-		//
-		// [RFC 103]: https://github.com/CometBFT/cometbft/blob/main/docs/references/rfc/rfc-103-incoming-txs-when-catching-up.md
-		for {
-			// Make sure the peer's state is up to date. The peer may not have a
-			// state yet. We set it in the consensus reactor, but when we add
-			// peer in Switch, the order we call reactors#AddPeer is different
-			// every time due to us using a map. Sometimes other reactors will
-			// be initialized before the consensus reactor. We should wait a few
-			// milliseconds and retry.
-			peerState, ok := peer.Get(types.PeerStateKey).(PeerState)
-			if ok && peerState.GetHeight()+1 >= entry.Height() {
-				break
-			}
-			select {
-			case <-time.After(PeerCatchupSleepIntervalMS * time.Millisecond):
-			case <-peer.Quit():
-				return
-			case <-memR.Quit():
-				return
-			}
+		if !memR.waitPeerCatchup(peer, entry) {
+			return
 		}
 
 		// NOTE: Transaction batching was disabled due to
@@ -297,16 +708,15 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 			memR.Logger.Debug("Sending transaction to peer",
 				"tx", log.NewLazySprintf("%X", txHash), "peer", peer.ID())
 
-				txMessage := &protomem.Txs{Txs: [][]byte{entry.Tx()}}
-
+			txMessage := &protomem.Txs{Txs: [][]byte{entry.Tx()}}
 
 			success := peer.Send(p2p.Envelope{
 				ChannelID: MempoolChannel,
 				Message:   txMessage,
 			})
 			if success {
-				memR.mempool.metrics.BytesSent.Add(float64(txMessage.Size()))
-				memR.mempool.metrics.TransactionsSent.Add(float64(len(txMessage.Txs)))
+				memR.mempool.getMetrics().BytesSent.Add(float64(txMessage.Size()))
+				memR.mempool.getMetrics().TransactionsSent.Add(float64(len(txMessage.Txs)))
 				break
 			}
 
@@ -323,3 +733,120 @@ func (memR *Reactor) broadcastTxRoutine(peer p2p.Peer) {
 		}
 	}
 }
+
+// announceTxsRoutine announces the keys of new mempool txs to the peer, in
+// batches of up to maxAnnouncedTxKeys keys. A batch is sent when it is full or
+// announceInterval after its first key was added. It also sends the txs the
+// peer requests.
+func (memR *Reactor) announceTxsRoutine(peer p2p.Peer, iter Iterator) {
+	pp := newPullPeer()
+	memR.pullPeersMtx.Lock()
+	memR.pullPeers[peer.ID()] = pp
+	memR.pullPeersMtx.Unlock()
+	defer func() {
+		memR.pullPeersMtx.Lock()
+		// The peer may have reconnected in the meantime.
+		if memR.pullPeers[peer.ID()] == pp {
+			delete(memR.pullPeers, peer.ID())
+		}
+		memR.pullPeersMtx.Unlock()
+	}()
+
+	keys := make([]types.TxKey, 0, maxAnnouncedTxKeys)
+	var flushCh <-chan time.Time
+	nextCh := iter.WaitNextCh()
+	for {
+		if !memR.IsRunning() || !peer.IsRunning() {
+			return
+		}
+
+		select {
+		case entry := <-nextCh:
+			nextCh = iter.WaitNextCh()
+			// If the entry we were looking at got garbage collected (removed), try again.
+			if entry == nil {
+				continue
+			}
+			if !memR.waitPeerCatchup(peer, entry) {
+				return
+			}
+			// Do not announce this transaction if we received it from peer.
+			if entry.IsSender(peer.ID()) {
+				continue
+			}
+			// Nor if it was removed, for instance because it was replaced by
+			// another tx, since it was chosen.
+			key := entry.Tx().Key()
+			if !memR.mempool.Contains(key) {
+				continue
+			}
+			keys = append(keys, key)
+			if len(keys) < maxAnnouncedTxKeys {
+				if flushCh == nil {
+					flushCh = time.After(announceInterval)
+				}
+				continue
+			}
+		case <-pp.requestedCh:
+			for _, key := range pp.takeRequested() {
+				memR.sendRequestedTx(key, peer)
+			}
+			continue
+		case <-flushCh:
+		case <-peer.Quit():
+			return
+		case <-memR.Quit():
+			return
+		}
+
+		if len(keys) > 0 {
+			txKeys := make([][]byte, 0, len(keys))
+			for _, key := range keys {
+				txKeys = append(txKeys, key[:])
+			}
+			// The peer may request the txs as soon as they are announced.
+			pp.announce(keys, memR.mempool.Contains, time.Now())
+			if !peer.Send(p2p.Envelope{
+				ChannelID: MempoolAnnounceChannel,
+				Message:   &protomem.HaveTxs{TxKeys: txKeys},
+			}) {
+				memR.Logger.Debug("Failed announcing transactions to peer", "peer", peer.ID(), "num", len(keys))
+			} else {
+				memR.mempool.getMetrics().TxKeysAnnounced.Add(float64(len(keys)))
+			}
+			keys = make([]types.TxKey, 0, maxAnnouncedTxKeys)
+		}
+		flushCh = nil
+	}
+}
+
+// waitPeerCatchup blocks until the peer is not lagging behind the height at
+// which the entry was validated. It returns false if either the peer or the
+// reactor stopped while waiting.
+func (memR *Reactor) waitPeerCatchup(peer p2p.Peer, entry Entry) bool {
+	// If we suspect that the peer is lagging behind, at least by more than
+	// one block, we don't send the transaction immediately. This code
+	// reduces the mempool size and the recheck-tx rate of the receiving
+	// node. See [RFC 103] for an analysis on this optimization.
+	//
+	// [RFC 103]: https://github.com/CometBFT/cometbft/blob/main/docs/references/rfc/rfc-103-incoming-txs-when-catching-up.md
+	for {
+		// Make sure the peer's state is up to date. The peer may not have a
+		// state yet. We set it in the consensus reactor, but when we add
+		// peer in Switch, the order we call reactors#AddPeer is different
+		// every time due to us using a map. Sometimes other reactors will
+		// be initialized before the consensus reactor. We should wait a few
+		// milliseconds and retry.
+		peerState, ok := peer.Get(types.PeerStateKey).(PeerState)
+		if ok && peerState.GetHeight()+1 >= entry.Height() {
+			return true
+		}
+		select {
+		case <-time.After(PeerCatchupSleepIntervalMS * time.Millisecond):
+		case <-peer.Quit():
+			return false
+		case <-memR.Quit():
+			return false
+		}
+	}
+}
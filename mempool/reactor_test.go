@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"sync"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/fortytw2/leaktest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/abci/example/kvstore"
@@ -16,8 +18,11 @@ import (
 	memproto "github.com/cometbft/cometbft/api/cometbft/mempool/v1"
 	cfg "github.com/cometbft/cometbft/config"
 	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
+	p2pmocks "github.com/cometbft/cometbft/p2p/mocks"
+	"github.com/cometbft/cometbft/p2p/nodekey"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
 )
@@ -62,6 +67,285 @@ func TestReactorBroadcastTxsMessage(t *testing.T) {
 	waitForReactors(t, txs, reactors, checkTxsInMempool)
 }
 
+// Check that the reactor also gossips the transactions of a priority mempool.
+func TestReactorBroadcastTxsPriorityMempool(t *testing.T) {
+	config := cfg.TestConfig()
+	const n = 2
+	reactors := make([]*Reactor, n)
+	for i := 0; i < n; i++ {
+		mp := newPriorityMempoolWithApp(t, kvstore.NewInMemoryApplication(), test.ResetTestRoot("mempool_test"))
+		reactors[i] = NewReactor(config.Mempool, mp, false)
+		reactors[i].SetLogger((*mempoolLogger("info")).With("validator", i))
+	}
+	connectReactors(config, reactors, p2p.Connect2Switches)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	txs := addRandomTxs(t, reactors[0].mempool, numTxs)
+	waitForReactors(t, txs, reactors, checkTxsInMempool)
+}
+
+// Check that txs reach all peers with the pull-based gossip protocol.
+func TestReactorPullGossip(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.ExperimentalGossipProtocol = cfg.MempoolGossipProtocolPull
+	const n = 3
+	reactors, _ := makeAndConnectReactors(config, n, nil)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			peer.Set(types.PeerStateKey, peerState{1})
+		}
+	}
+
+	txs := addRandomTxs(t, reactors[0].mempool, numTxs)
+	waitForReactors(t, txs, reactors, checkTxsInMempool)
+}
+
+// oldReactor is a Reactor that does not support the pull-based gossip
+// protocol.
+type oldReactor struct {
+	*Reactor
+}
+
+func (r oldReactor) StreamDescriptors() []p2p.StreamDescriptor {
+	return r.Reactor.StreamDescriptors()[:1]
+}
+
+// Check that txs are pushed to peers that do not support the pull-based gossip
+// protocol.
+func TestReactorPullGossipFallback(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.ExperimentalGossipProtocol = cfg.MempoolGossipProtocolPull
+	const n = 2
+	reactors := makeReactors(config, n, nil, true)
+	oldConfig := *config.Mempool
+	oldConfig.ExperimentalGossipProtocol = cfg.MempoolGossipProtocolPush
+	reactors[1].config = &oldConfig
+	switches := p2p.MakeSwitches(config.P2P, n, func(i int, s *p2p.Switch) *p2p.Switch {
+		if i == 0 {
+			s.AddReactor("MEMPOOL", reactors[i])
+		} else {
+			s.AddReactor("MEMPOOL", oldReactor{reactors[i]})
+		}
+		return s
+	})
+	for _, s := range switches {
+		s.SetLogger(log.NewNopLogger())
+	}
+	p2p.StartAndConnectSwitches(switches, p2p.Connect2Switches)
+	defer func() {
+		for _, s := range switches {
+			if err := s.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().Copy() {
+			peer.Set(types.PeerStateKey, peerState{1})
+			require.Equal(t, r == reactors[1], peer.HasChannel(MempoolAnnounceChannel))
+		}
+	}
+
+	txs := addTxs(t, reactors[0].mempool, 0, numTxs)
+	waitForReactors(t, txs, reactors, checkTxsInMempool)
+	txs = append(txs, addTxs(t, reactors[1].mempool, numTxs, numTxs)...)
+	waitForReactors(t, txs, reactors, checkTxsInMempool)
+}
+
+func TestReactorPullGossipRequests(t *testing.T) {
+	config := cfg.TestConfig()
+	reactor := makeReactors(config, 1, nil, true)[0]
+
+	txs := addTxs(t, reactor.mempool, 0, 3)
+	waitForNumTxsInMempool(len(txs), reactor.mempool)
+	inMempool, inCache := txs[0].Key(), types.Tx("cached").Key()
+	reactor.mempool.(*CListMempool).cache.Push(types.Tx("cached"))
+	missing := types.Tx("missing").Key()
+
+	isTxsMsg := func(msg any, keys ...types.TxKey) bool {
+		var txKeys [][]byte
+		switch msg := msg.(type) {
+		case *memproto.WantTxs:
+			txKeys = msg.TxKeys
+		case *memproto.Txs:
+			for _, tx := range msg.Txs {
+				key := types.Tx(tx).Key()
+				txKeys = append(txKeys, key[:])
+			}
+		default:
+			return false
+		}
+		if len(txKeys) != len(keys) {
+			return false
+		}
+		for i, key := range keys {
+			if !bytes.Equal(key[:], txKeys[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Only the missing tx is requested to the first peer that announces it.
+	peerA := &p2pmocks.Peer{}
+	peerA.On("ID").Return(nodekey.ID("a"))
+	peerA.On("TrySend", mock.MatchedBy(func(e p2p.Envelope) bool {
+		return e.ChannelID == MempoolAnnounceChannel && isTxsMsg(e.Message, missing)
+	})).Return(true).Once()
+	reactor.requestMissingTxs([]types.TxKey{inMempool, inCache, missing}, peerA)
+	peerA.AssertExpectations(t)
+	require.True(t, reactor.mempool.(*CListMempool).txsMap[inMempool].Value.(*mempoolTx).IsSender("a"))
+
+	// The missing tx is not requested again to another peer while the request
+	// is pending.
+	peerB := &p2pmocks.Peer{}
+	peerB.On("ID").Return(nodekey.ID("b"))
+	reactor.requestMissingTxs([]types.TxKey{missing}, peerB)
+	peerB.AssertNotCalled(t, "TrySend", mock.Anything)
+
+	reactor.requestDone(missing)
+	peerB.On("TrySend", mock.Anything).Return(true).Once()
+	reactor.requestMissingTxs([]types.TxKey{missing}, peerB)
+	peerB.AssertExpectations(t)
+
+	// Only the txs announced to the peer can be requested, each once.
+	pp := newPullPeer()
+	pp.announce([]types.TxKey{txs[1].Key(), txs[2].Key(), missing}, reactor.mempool.Contains, time.Now())
+	pp.request([]types.TxKey{txs[1].Key(), txs[0].Key(), txs[2].Key(), txs[1].Key()})
+	pp.request([]types.TxKey{txs[2].Key()})
+	require.Len(t, pp.requestedCh, 1)
+	require.Equal(t, []types.TxKey{txs[1].Key(), txs[2].Key()}, pp.takeRequested())
+
+	// The announced keys of the txs that left the mempool are forgotten.
+	pp.announce(nil, reactor.mempool.Contains, time.Now().Add(txRequestTimeout))
+	pp.request([]types.TxKey{missing})
+	require.Empty(t, pp.takeRequested())
+
+	// Only the requested txs that are in the mempool are sent, each in its own
+	// message.
+	peerA.On("Send", mock.MatchedBy(func(e p2p.Envelope) bool {
+		return e.ChannelID == MempoolChannel && isTxsMsg(e.Message, txs[1].Key())
+	})).Return(true).Once()
+	reactor.sendRequestedTx(txs[1].Key(), peerA)
+	reactor.sendRequestedTx(missing, peerA)
+	peerA.AssertExpectations(t)
+}
+
+// Check that a tx is requested to the next peer that announced it when a peer
+// doesn't reply to the request.
+func TestReactorPullGossipRetryRequests(t *testing.T) {
+	config := cfg.TestConfig()
+	reactor := makeReactors(config, 1, nil, true)[0]
+	sw := p2p.MakeSwitch(config.P2P, 0, func(_ int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("MEMPOOL", reactor)
+		return s
+	})
+
+	key := types.Tx("missing").Key()
+	isRequest := mock.MatchedBy(func(e p2p.Envelope) bool {
+		msg, ok := e.Message.(*memproto.WantTxs)
+		return ok && e.ChannelID == MempoolAnnounceChannel &&
+			len(msg.TxKeys) == 1 && bytes.Equal(msg.TxKeys[0], key[:])
+	})
+	peers := make([]*p2pmocks.Peer, 0, 4)
+	for _, id := range []nodekey.ID{"a", "b", "c", "d"} {
+		peer := &p2pmocks.Peer{}
+		peer.On("ID").Return(id)
+		peer.On("GetRemovalFailed").Return(false)
+		peers = append(peers, peer)
+	}
+	// Peer c disconnects before the tx is requested to it.
+	for _, peer := range []*p2pmocks.Peer{peers[0], peers[1], peers[3]} {
+		p2p.AddPeerToSwitchPeerSet(sw, peer)
+	}
+
+	// Peer a drops the request, so the tx is requested to b once the request
+	// times out.
+	peers[0].On("TrySend", isRequest).Return(true).Once()
+	for _, peer := range peers {
+		reactor.requestMissingTxs([]types.TxKey{key}, peer)
+	}
+	peers[0].AssertExpectations(t)
+
+	now := time.Now()
+	reactor.retryTxRequests(now)
+	peers[1].AssertNotCalled(t, "TrySend", mock.Anything)
+
+	peers[1].On("TrySend", isRequest).Return(true).Once()
+	now = now.Add(txRequestTimeout)
+	reactor.retryTxRequests(now)
+	peers[1].AssertExpectations(t)
+
+	// If the request can't be sent, it is sent again right away.
+	peers[3].On("TrySend", isRequest).Return(false).Once()
+	now = now.Add(txRequestTimeout)
+	reactor.retryTxRequests(now)
+	peers[3].On("TrySend", isRequest).Return(true).Once()
+	now = now.Add(time.Millisecond)
+	reactor.retryTxRequests(now)
+	peers[3].AssertExpectations(t)
+	peers[2].AssertNotCalled(t, "TrySend", mock.Anything)
+
+	// Once no peer is left to request the tx to, it is forgotten, so that it
+	// is requested to the next peer that announces it.
+	reactor.retryTxRequests(now.Add(txRequestTimeout))
+	reactor.requestedTxsMtx.Lock()
+	require.Empty(t, reactor.requestedTxs)
+	reactor.requestedTxsMtx.Unlock()
+
+	// Once the tx is received, the request is done.
+	peers[0].On("TrySend", isRequest).Return(true).Once()
+	reactor.requestMissingTxs([]types.TxKey{key}, peers[0])
+	reactor.requestMissingTxs([]types.TxKey{key}, peers[1])
+	reactor.requestDone(key)
+	reactor.retryTxRequests(now.Add(3 * txRequestTimeout))
+	peers[0].AssertExpectations(t)
+	peers[1].AssertExpectations(t)
+}
+
+// Check that a peer can't request txs that were not announced to it.
+func TestReactorPullGossipUnannouncedRequest(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.ExperimentalGossipProtocol = cfg.MempoolGossipProtocolPull
+	reactors, _ := makeAndConnectReactors(config, 2, nil)
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+
+	// Txs are not announced to a peer that is lagging behind.
+	txs := addTxs(t, reactors[0].mempool, 0, 1)
+	peer := reactors[0].Switch.Peers().Copy()[0]
+	key := txs[0].Key()
+	reactors[0].Receive(p2p.Envelope{
+		ChannelID: MempoolAnnounceChannel,
+		Src:       peer,
+		Message:   &memproto.WantTxs{TxKeys: [][]byte{key[:]}},
+	})
+	ensureNoTxs(t, reactors[1], 100*time.Millisecond)
+}
+
 // regression test for https://github.com/tendermint/tendermint/issues/5408
 func TestReactorConcurrency(t *testing.T) {
 	config := cfg.TestConfig()
@@ -193,7 +477,7 @@ func TestMempoolReactorSendLaggingPeer(t *testing.T) {
 	}()
 
 	// First reactor is at height 10 and knows that its peer is lagging at height 1.
-	reactors[0].mempool.height.Store(10)
+	reactors[0].mempool.(*CListMempool).height.Store(10)
 	peerID := reactors[1].Switch.NodeInfo().ID()
 	reactors[0].Switch.Peers().Get(peerID).Set(types.PeerStateKey, peerState{1})
 
@@ -226,7 +510,7 @@ func TestMempoolReactorSendRemovedTx(t *testing.T) {
 
 	// First reactor is at height 10 and knows that its peer is lagging at height 1.
 	// We do this to hold sending transactions, giving us time to remove some of them.
-	reactors[0].mempool.height.Store(10)
+	reactors[0].mempool.(*CListMempool).height.Store(10)
 	peerID := reactors[1].Switch.NodeInfo().ID()
 	reactors[0].Switch.Peers().Get(peerID).Set(types.PeerStateKey, peerState{1})
 
@@ -287,6 +571,39 @@ func TestMempoolReactorMaxTxBytes(t *testing.T) {
 	require.Nil(t, reqRes)
 }
 
+func TestMempoolReactorPeerQuota(t *testing.T) {
+	config := cfg.TestConfig()
+	config.Mempool.Broadcast = false
+
+	const n = 2
+	reactors, _ := makeAndConnectReactors(config, n, mempoolLogger("info"))
+	defer func() {
+		for _, r := range reactors {
+			if err := r.Stop(); err != nil {
+				require.NoError(t, err)
+			}
+		}
+	}()
+	reactors[1].mempool.(*CListMempool).config.MaxTxsPerPeer = 1
+
+	peer := reactors[1].Switch.Peers().Copy()[0]
+	txs := newUniqueTxs(maxQuotaViolations + 2)
+	_, err := reactors[1].TryAddTx(txs[0], peer)
+	require.NoError(t, err)
+
+	// The peer is disconnected once it sends too many txs over its quota.
+	for _, tx := range txs[1 : maxQuotaViolations+1] {
+		_, err := reactors[1].TryAddTx(tx, peer)
+		require.ErrorAs(t, err, &ErrPeerQuotaExceeded{})
+	}
+	require.Equal(t, 1, reactors[1].Switch.Peers().Size())
+	_, err = reactors[1].TryAddTx(txs[maxQuotaViolations+1], peer)
+	require.ErrorAs(t, err, &ErrPeerQuotaExceeded{})
+	require.Eventually(t, func() bool {
+		return reactors[1].Switch.Peers().Size() == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
 func TestBroadcastTxForPeerStopsWhenPeerStops(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping test in short mode.")
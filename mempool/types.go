@@ -7,5 +7,7 @@ import (
 
 var (
 	_ types.Wrapper   = &memprotos.Txs{}
+	_ types.Wrapper   = &memprotos.HaveTxs{}
+	_ types.Wrapper   = &memprotos.WantTxs{}
 	_ types.Unwrapper = &memprotos.Message{}
 )
@@ -34,7 +34,7 @@ import (
 	ni "github.com/cometbft/cometbft/p2p/nodeinfo"
 	"github.com/cometbft/cometbft/p2p/nodekey"
 	"github.com/cometbft/cometbft/p2p/pex"
-	"github.com/cometbft/cometbft/p2p/transport/tcp"
+	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	rpccore "github.com/cometbft/cometbft/rpc/core"
 	grpcserver "github.com/cometbft/cometbft/rpc/grpc/server"
@@ -62,7 +62,7 @@ type Node struct {
 	privValidator types.PrivValidator // local node's validator key
 
 	// network
-	transport   *tcp.MultiplexTransport
+	transport   listenTransport
 	sw          *p2p.Switch  // p2p connections
 	addrBook    pex.AddrBook // known peers
 	nodeInfo    ni.NodeInfo
@@ -77,6 +77,7 @@ type Node struct {
 	bcReactor         p2p.Reactor    // for block-syncing
 	mempoolReactor    mempoolReactor // for gossipping transactions
 	mempool           mempl.Mempool
+	mempoolJournal    *mempl.Journal          // restores the mempool on restart (optional)
 	stateSync         bool                    // whether the node should state sync on startup
 	stateSyncReactor  *statesync.Reactor      // for hosting and restoring state sync snapshots
 	stateSyncProvider statesync.StateProvider // provides state data for bootstrapping a node
@@ -92,6 +93,7 @@ type Node struct {
 	indexerService    *txindex.IndexerService
 	prometheusSrv     *http.Server
 	pprofSrv          *http.Server
+	slashingDB        *privval.SlashingProtectionDB // signing history of the FilePV (optional)
 }
 
 type waitSyncP2PReactor interface {
@@ -383,7 +385,19 @@ func NewNodeWithCliParams(ctx context.Context,
 
 	// If an address is provided, listen on the socket for a connection from an
 	// external signing process.
-	if config.PrivValidatorListenAddr != "" {
+	// With several addresses, k-of-n of the external signing processes must
+	// agree on every signature.
+	if laddrs := splitAndTrimEmpty(config.PrivValidatorListenAddr, ",", " "); len(laddrs) > 1 {
+		pvMetrics := privval.NopMetrics()
+		if config.Instrumentation.Prometheus {
+			pvMetrics = privval.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", genDoc.ChainID)
+		}
+		privValidator, err = createAndStartPrivValidatorThresholdClient(
+			laddrs, config.PrivValidatorThreshold, genDoc.ChainID, pvMetrics, logger)
+		if err != nil {
+			return nil, ErrPrivValidatorSocketClient{Err: err}
+		}
+	} else if config.PrivValidatorListenAddr != "" {
 		// FIXME: we should start services inside OnStart
 		privValidator, err = createAndStartPrivValidatorSocketClient(config.PrivValidatorListenAddr, genDoc.ChainID, logger)
 		if err != nil {
@@ -431,7 +445,12 @@ func NewNodeWithCliParams(ctx context.Context,
 	// Blocksync is always active, except if the local node blocks the chain
 	waitSync := !state.Validators.ValidatorBlocksTheChain(localAddr)
 
-	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, eventBus, waitSync, memplMetrics, logger, appInfoResponse)
+	mempoolJournal, err := createMempoolJournal(config, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, eventBus, waitSync, memplMetrics, mempoolJournal, logger, appInfoResponse)
 
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateStore, blockStore, logger)
 	if err != nil {
@@ -494,6 +513,8 @@ func NewNodeWithCliParams(ctx context.Context,
 		*config.StateSync,
 		proxyApp.Snapshot(),
 		proxyApp.Query(),
+		stateStore,
+		blockStore,
 		ssMetrics,
 	)
 	stateSyncReactor.SetLogger(logger.With("module", "statesync"))
@@ -503,7 +524,10 @@ func NewNodeWithCliParams(ctx context.Context,
 		return nil, err
 	}
 
-	transport, peerFilters := createTransport(config, nodeKey, proxyApp)
+	transport, peerFilters, err := createTransport(config, nodeKey, proxyApp)
+	if err != nil {
+		return nil, err
+	}
 
 	p2pLogger := logger.With("module", "p2p")
 	sw := createSwitch(
@@ -563,6 +587,7 @@ func NewNodeWithCliParams(ctx context.Context,
 		bcReactor:        bcReactor,
 		mempoolReactor:   mempoolReactor,
 		mempool:          mempool,
+		mempoolJournal:   mempoolJournal,
 		consensusState:   consensusState,
 		consensusReactor: consensusReactor,
 		stateSyncReactor: stateSyncReactor,
@@ -730,6 +755,18 @@ func (n *Node) OnStop() {
 			n.Logger.Error("problem closing evidencestore", "err", err)
 		}
 	}
+	if n.mempoolJournal != nil {
+		n.Logger.Info("Closing mempool journal")
+		if err := n.mempoolJournal.Close(); err != nil {
+			n.Logger.Error("problem closing mempool journal", "err", err)
+		}
+	}
+	if n.slashingDB != nil {
+		n.Logger.Info("Closing slashing protection database")
+		if err := n.slashingDB.Close(); err != nil {
+			n.Logger.Error("problem closing slashing protection database", "err", err)
+		}
+	}
 }
 
 var (
@@ -771,6 +808,7 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 			StateStore:     n.stateStore,
 			BlockStore:     n.blockStore,
 			EvidencePool:   n.evidencePool,
+			EvidenceIndex:  n.evidencePool,
 			ConsensusState: n.consensusState,
 			P2PPeers:       n.sw,
 			P2PTransport:   n,
@@ -780,6 +818,7 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 			BlockIndexer:     n.blockIndexer,
 			ConsensusReactor: n.consensusReactor,
 			MempoolReactor:   n.mempoolReactor,
+			StateSyncReactor: n.stateSyncReactor,
 			EventBus:         n.eventBus,
 			Mempool:          n.mempool,
 
@@ -912,6 +951,12 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		if n.config.GRPC.BlockResultsService.Enabled {
 			opts = append(opts, grpcserver.WithBlockResultsService(n.blockStore, n.stateStore, n.Logger))
 		}
+		if n.config.GRPC.MempoolService.Enabled {
+			opts = append(opts, grpcserver.WithMempoolService(n.mempoolReactor, n.mempool, n.proxyApp.Query(), n.eventBus, n.Logger))
+		}
+		if n.config.GRPC.EvidenceService.Enabled {
+			opts = append(opts, grpcserver.WithEvidenceService(n.evidencePool, n.blockStore, n.Logger))
+		}
 		go func() {
 			if err := grpcserver.Serve(listener, opts...); err != nil {
 				n.Logger.Error("Error starting gRPC server", "err", err)
@@ -1104,9 +1149,10 @@ func makeNodeInfo(
 		Channels: []byte{
 			bc.BlocksyncChannel,
 			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel,
-			mempl.MempoolChannel,
+			mempl.MempoolChannel, mempl.MempoolAnnounceChannel,
 			evidence.EvidenceChannel,
 			statesync.SnapshotChannel, statesync.ChunkChannel,
+			statesync.LightBlockChannel, statesync.ParamsChannel,
 		},
 		Moniker: config.Moniker,
 		Other: ni.DefaultOther{
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"reflect"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
@@ -35,6 +36,8 @@ import (
 	p2pmock "github.com/cometbft/cometbft/p2p/mock"
 	ni "github.com/cometbft/cometbft/p2p/nodeinfo"
 	"github.com/cometbft/cometbft/p2p/nodekey"
+	"github.com/cometbft/cometbft/p2p/transport/quic"
+	"github.com/cometbft/cometbft/p2p/transport/tcp"
 	"github.com/cometbft/cometbft/p2p/transport/tcp/conn"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
@@ -106,6 +109,21 @@ func TestSplitAndTrimEmpty(t *testing.T) {
 	}
 }
 
+func TestCreateTransport(t *testing.T) {
+	config := test.ResetTestRoot("node_create_transport_test")
+	defer os.RemoveAll(config.RootDir)
+	nodeKey := &nodekey.NodeKey{PrivKey: ed25519.GenPrivKey()}
+
+	transport, _, err := createTransport(config, nodeKey, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &tcp.MultiplexTransport{}, transport)
+
+	config.P2P.Transport = cfg.P2PTransportQUIC
+	transport, _, err = createTransport(config, nodeKey, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &quic.Transport{}, transport)
+}
+
 func TestCompanionInitialHeightSetup(t *testing.T) {
 	config := test.ResetTestRoot("companion_initial_height")
 	defer os.RemoveAll(config.RootDir)
@@ -120,6 +138,23 @@ func TestCompanionInitialHeightSetup(t *testing.T) {
 	require.Equal(t, int64(1), companionRetainHeight)
 }
 
+func TestNodeClosesSlashingProtectionDB(t *testing.T) {
+	config := test.ResetTestRoot("node_slashing_protection_test")
+	defer os.RemoveAll(config.RootDir)
+	// The database is locked while open, unlike with memdb.
+	config.DBBackend = "pebbledb"
+	config.PrivValidatorSlashingProtection = true
+
+	n, err := DefaultNewNode(config, log.TestingLogger(), CliParams{}, nil)
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	require.NoError(t, n.Stop())
+
+	db, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "slashing_protection", Config: config})
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+}
+
 func TestNodeDelayedStart(t *testing.T) {
 	config := test.ResetTestRoot("node_delayed_start_test")
 	defer os.RemoveAll(config.RootDir)
@@ -215,6 +250,45 @@ func TestNodeSetPrivValTCP(t *testing.T) {
 	assert.IsType(t, &privval.RetrySignerClient{}, n.PrivValidator())
 }
 
+func TestNodeSetPrivValThreshold(t *testing.T) {
+	addrs := []string{"tcp://" + testFreeAddr(t), "tcp://" + testFreeAddr(t), "tcp://" + testFreeAddr(t)}
+
+	config := test.ResetTestRoot("node_priv_val_threshold_test")
+	defer os.RemoveAll(config.RootDir)
+	config.BaseConfig.PrivValidatorListenAddr = strings.Join(addrs, ",")
+
+	// Only a majority of the signers is needed.
+	privKey := ed25519.GenPrivKey()
+	for _, addr := range addrs[:2] {
+		dialerEndpoint := privval.NewSignerDialerEndpoint(
+			log.TestingLogger(),
+			privval.DialTCPFn(addr, 100*time.Millisecond, ed25519.GenPrivKey()),
+		)
+		privval.SignerDialerEndpointTimeoutReadWrite(100 * time.Millisecond)(dialerEndpoint)
+
+		signerServer := privval.NewSignerServer(
+			dialerEndpoint,
+			test.DefaultTestChainID,
+			types.NewMockPVWithParams(privKey, false, false),
+		)
+
+		go func() {
+			err := signerServer.Start()
+			if err != nil {
+				panic(err)
+			}
+		}()
+		defer signerServer.Stop() //nolint:errcheck // ignore for tests
+	}
+
+	n, err := DefaultNewNode(config, log.TestingLogger(), CliParams{}, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &privval.ThresholdSignerClient{}, n.PrivValidator())
+	pubKey, err := n.PrivValidator().GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
+}
+
 // address without a protocol must result in error.
 func TestPrivValidatorListenAddrNoProtocol(t *testing.T) {
 	addrNoPrefix := testFreeAddr(t)
@@ -480,7 +554,7 @@ func TestNodeNewNodeCustomReactors(t *testing.T) {
 	cr := p2pmock.NewReactor()
 	cr.Channels = []p2p.StreamDescriptor{
 		&conn.ChannelDescriptor{
-			ID:                  byte(0x31),
+			ID:                  byte(0x99),
 			Priority:            5,
 			SendQueueCapacity:   100,
 			RecvMessageCapacity: 100,
@@ -30,6 +30,7 @@ import (
 	ni "github.com/cometbft/cometbft/p2p/nodeinfo"
 	"github.com/cometbft/cometbft/p2p/nodekey"
 	"github.com/cometbft/cometbft/p2p/pex"
+	"github.com/cometbft/cometbft/p2p/transport/quic"
 	"github.com/cometbft/cometbft/p2p/transport/tcp"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
@@ -123,7 +124,17 @@ func DefaultNewNode(
 		}
 	}
 
-	return NewNodeWithCliParams(context.Background(), config,
+	var slashingProtectionDB *privval.SlashingProtectionDB
+	if config.PrivValidatorSlashingProtection {
+		spDB, err := cfg.DefaultDBProvider(&cfg.DBContext{ID: "slashing_protection", Config: config})
+		if err != nil {
+			return nil, fmt.Errorf("failed to open slashing protection database: %w", err)
+		}
+		slashingProtectionDB = privval.NewSlashingProtectionDB(spDB)
+		pv.SetSlashingProtectionDB(slashingProtectionDB)
+	}
+
+	n, err := NewNodeWithCliParams(context.Background(), config,
 		pv,
 		nodeKey,
 		proxy.DefaultClientCreator(config.ProxyApp, config.ABCI, config.DBDir()),
@@ -133,6 +144,14 @@ func DefaultNewNode(
 		logger,
 		cliParams,
 	)
+	if err != nil {
+		if slashingProtectionDB != nil {
+			_ = slashingProtectionDB.Close()
+		}
+		return nil, err
+	}
+	n.slashingDB = slashingProtectionDB
+	return n, nil
 }
 
 // MetricsProvider returns a consensus, p2p and mempool Metrics.
@@ -273,6 +292,89 @@ func logNodeStartupInfo(state sm.State, pubKey crypto.PubKey, logger, consensusL
 	}
 }
 
+// createMempoolJournal opens the mempool journal, if enabled in the config.
+func createMempoolJournal(config *cfg.Config, logger log.Logger) (*mempl.Journal, error) {
+	if !config.Mempool.WalEnabled() {
+		return nil, nil
+	}
+	journal, err := mempl.OpenJournal(config.Mempool.WalDir())
+	if err != nil {
+		return nil, fmt.Errorf("could not open mempool journal: %w", err)
+	}
+	journal.SetLogger(logger.With("module", "mempool"))
+	return journal, nil
+}
+
+// publishPendingTxs returns true if a PendingTx event must be published for
+// each transaction added to the mempool, which the gRPC mempool service needs
+// to stream new transactions.
+func publishPendingTxs(config *cfg.Config) bool {
+	return config.Mempool.ExperimentalPublishEventPendingTx ||
+		(config.GRPC.ListenAddress != "" && config.GRPC.MempoolService.Enabled)
+}
+
+// publishTxExpired returns a callback that publishes a TxExpired event for
+// each transaction removed from the mempool because its TTL expired.
+func publishTxExpired(eventBus *types.EventBus) mempl.TxExpiredCallback {
+	return func(tx types.Tx, lane mempl.LaneID, height int64) {
+		_ = eventBus.PublishEventTxExpired(types.EventDataTxExpired{
+			Hash:   tx.Hash(),
+			Lane:   string(lane),
+			Height: height,
+		})
+	}
+}
+
+// publishTxRejected returns a callback that publishes a TxRejected event for
+// each transaction rejected by the mempool after CheckTx.
+func publishTxRejected(eventBus *types.EventBus) mempl.TxRejectedCallback {
+	return func(tx types.Tx, lane mempl.LaneID, code uint32, reason error) {
+		_ = eventBus.PublishEventTxRejected(types.EventDataTxRejected{
+			Hash:   tx.Hash(),
+			Lane:   string(lane),
+			Code:   code,
+			Reason: reason.Error(),
+		})
+	}
+}
+
+// publishTxEvicted returns a callback that publishes a TxEvicted event for
+// each valid transaction removed from the mempool before being committed.
+func publishTxEvicted(eventBus *types.EventBus) mempl.TxEvictedCallback {
+	return func(tx types.Tx, lane mempl.LaneID, reason error) {
+		_ = eventBus.PublishEventTxEvicted(types.EventDataTxEvicted{
+			Hash:   tx.Hash(),
+			Lane:   string(lane),
+			Reason: reason.Error(),
+		})
+	}
+}
+
+// publishTxRecheckFailed returns a callback that publishes a TxRecheckFailed
+// event for each transaction removed from the mempool after failing a recheck.
+func publishTxRecheckFailed(eventBus *types.EventBus) mempl.TxRecheckFailedCallback {
+	return func(tx types.Tx, lane mempl.LaneID, code uint32, reason error) {
+		_ = eventBus.PublishEventTxRecheckFailed(types.EventDataTxRecheckFailed{
+			Hash:   tx.Hash(),
+			Lane:   string(lane),
+			Code:   code,
+			Reason: reason.Error(),
+		})
+	}
+}
+
+// publishTxReplaced returns a callback that publishes a TxReplaced event for
+// each transaction in the mempool replaced by a new one.
+func publishTxReplaced(eventBus *types.EventBus) mempl.TxReplacedCallback {
+	return func(oldTx, newTx types.Tx, lane mempl.LaneID) {
+		_ = eventBus.PublishEventTxReplaced(types.EventDataTxReplaced{
+			Hash:    oldTx.Hash(),
+			NewHash: newTx.Hash(),
+			Lane:    string(lane),
+		})
+	}
+}
+
 // createMempoolAndMempoolReactor creates a mempool and a mempool reactor based on the config.
 func createMempoolAndMempoolReactor(
 	config *cfg.Config,
@@ -281,6 +383,7 @@ func createMempoolAndMempoolReactor(
 	eventBus *types.EventBus,
 	waitSync bool,
 	memplMetrics *mempl.Metrics,
+	journal *mempl.Journal,
 	logger log.Logger,
 	appInfoResponse *abci.InfoResponse,
 ) (mempl.Mempool, mempoolReactor) {
@@ -297,8 +400,20 @@ func createMempoolAndMempoolReactor(
 			mempl.WithMetrics(memplMetrics),
 			mempl.WithPreCheck(sm.TxPreCheck(state)),
 			mempl.WithPostCheck(sm.TxPostCheck(state)),
+			mempl.WithTxExpiredCallback(publishTxExpired(eventBus)),
+			mempl.WithTxReplacedCallback(publishTxReplaced(eventBus)),
 		}
-		if config.Mempool.ExperimentalPublishEventPendingTx {
+		if config.Mempool.ExperimentalPublishEventDroppedTx {
+			options = append(options,
+				mempl.WithTxRejectedCallback(publishTxRejected(eventBus)),
+				mempl.WithTxEvictedCallback(publishTxEvicted(eventBus)),
+				mempl.WithTxRecheckFailedCallback(publishTxRecheckFailed(eventBus)),
+			)
+		}
+		if journal != nil {
+			options = append(options, mempl.WithJournal(journal))
+		}
+		if publishPendingTxs(config) {
 			options = append(options, mempl.WithNewTxCallback(func(tx types.Tx) {
 				_ = eventBus.PublishEventPendingTx(types.EventDataPendingTx{
 					Tx: tx,
@@ -323,6 +438,47 @@ func createMempoolAndMempoolReactor(
 		}
 		reactor.SetLogger(logger)
 
+		return mp, reactor
+	case cfg.MempoolTypePriority:
+		logger = logger.With("module", "mempool")
+		options := []mempl.PriorityMempoolOption{
+			mempl.WithPriorityMetrics(memplMetrics),
+			mempl.WithPriorityPreCheck(sm.TxPreCheck(state)),
+			mempl.WithPriorityPostCheck(sm.TxPostCheck(state)),
+			mempl.WithPriorityTxExpiredCallback(publishTxExpired(eventBus)),
+			mempl.WithPriorityTxReplacedCallback(publishTxReplaced(eventBus)),
+		}
+		if config.Mempool.ExperimentalPublishEventDroppedTx {
+			options = append(options,
+				mempl.WithPriorityTxRejectedCallback(publishTxRejected(eventBus)),
+				mempl.WithPriorityTxEvictedCallback(publishTxEvicted(eventBus)),
+				mempl.WithPriorityTxRecheckFailedCallback(publishTxRecheckFailed(eventBus)),
+			)
+		}
+		if publishPendingTxs(config) {
+			options = append(options, mempl.WithPriorityNewTxCallback(func(tx types.Tx) {
+				_ = eventBus.PublishEventPendingTx(types.EventDataPendingTx{
+					Tx: tx,
+				})
+			}))
+		}
+		mp := mempl.NewPriorityMempool(
+			config.Mempool,
+			proxyApp.Mempool(),
+			state.LastBlockHeight,
+			options...,
+		)
+		mp.SetLogger(logger)
+		reactor := mempl.NewReactor(
+			config.Mempool,
+			mp,
+			waitSync,
+		)
+		if config.Consensus.WaitForTxs() {
+			mp.EnableTxsAvailable()
+		}
+		reactor.SetLogger(logger)
+
 		return mp, reactor
 	case cfg.MempoolTypeNop:
 		// Strictly speaking, there's no need to have a `mempl.NopMempoolReactor`, but
@@ -362,7 +518,11 @@ func createBlocksyncReactor(config *cfg.Config,
 ) (bcReactor p2p.Reactor, err error) {
 	switch config.BlockSync.Version {
 	case "v0":
-		bcReactor = blocksync.NewReactor(state.Copy(), blockExec, blockStore, blockSync, localAddr, metrics, offlineStateSyncHeight)
+		var options []blocksync.ReactorOption
+		if dir := config.BlockSync.ArchiveDir(); dir != "" {
+			options = append(options, blocksync.WithArchiveDir(dir))
+		}
+		bcReactor = blocksync.NewReactor(state.Copy(), blockExec, blockStore, blockSync, localAddr, metrics, offlineStateSyncHeight, options...)
 	case "v1", "v2":
 		return nil, fmt.Errorf("block sync version %s has been deprecated. Please use v0", config.BlockSync.Version)
 	default:
@@ -386,6 +546,13 @@ func createConsensusReactor(config *cfg.Config,
 	consensusLogger log.Logger,
 	offlineStateSyncHeight int64,
 ) (*cs.Reactor, *cs.State) {
+	csOptions := []cs.StateOption{
+		cs.StateMetrics(csMetrics),
+		cs.OfflineStateSyncHeight(offlineStateSyncHeight),
+	}
+	if config.Consensus.RoundTraceHeights > 0 {
+		csOptions = append(csOptions, cs.StateRoundTrace(cs.NewRoundTraceRecorder(config.Consensus.RoundTraceHeights)))
+	}
 	consensusState := cs.NewState(
 		config.Consensus,
 		state.Copy(),
@@ -393,8 +560,7 @@ func createConsensusReactor(config *cfg.Config,
 		blockStore,
 		mempool,
 		evidencePool,
-		cs.StateMetrics(csMetrics),
-		cs.OfflineStateSyncHeight(offlineStateSyncHeight),
+		csOptions...,
 	)
 	consensusState.SetLogger(consensusLogger)
 	if privValidator != nil {
@@ -408,17 +574,24 @@ func createConsensusReactor(config *cfg.Config,
 	return consensusReactor, consensusState
 }
 
+// listenTransport is a p2p.Transport that listens for incoming connections.
+type listenTransport interface {
+	p2p.Transport
+	Listen(addr na.NetAddr) error
+	Close() error
+}
+
 func createTransport(
 	config *cfg.Config,
 	nodeKey *nodekey.NodeKey,
 	proxyApp proxy.AppConns,
 ) (
-	*tcp.MultiplexTransport,
+	listenTransport,
 	[]p2p.PeerFilterFunc,
+	error,
 ) {
 	var (
 		mConnConfig = p2p.MConnConfig(config.P2P)
-		transport   = tcp.NewMultiplexTransport(*nodeKey, mConnConfig)
 		connFilters = []tcp.ConnFilterFunc{}
 		peerFilters = []p2p.PeerFilterFunc{}
 	)
@@ -467,13 +640,35 @@ func createTransport(
 		)
 	}
 
-	tcp.MultiplexTransportConnFilters(connFilters...)(transport)
-
 	// Limit the number of incoming connections.
 	max := config.P2P.MaxNumInboundPeers + len(splitAndTrimEmpty(config.P2P.UnconditionalPeerIDs, ",", " "))
-	tcp.MultiplexTransportMaxIncomingConnections(max)(transport)
 
-	return transport, peerFilters
+	if config.P2P.Transport != cfg.P2PTransportQUIC {
+		transport := tcp.NewMultiplexTransport(*nodeKey, mConnConfig)
+		tcp.MultiplexTransportConnFilters(connFilters...)(transport)
+		tcp.MultiplexTransportMaxIncomingConnections(max)(transport)
+		return transport, peerFilters, nil
+	}
+
+	// The QUIC transport falls back to TCP for the peers that don't accept
+	// QUIC connections. Both transports share the same connection set, so that
+	// the filters apply to the connections of either.
+	conns := tcp.NewConnSet()
+	fallback := tcp.NewMultiplexTransport(*nodeKey, mConnConfig)
+	tcp.MultiplexTransportConnFilters(connFilters...)(fallback)
+	tcp.MultiplexTransportConnSet(conns)(fallback)
+	tcp.MultiplexTransportMaxIncomingConnections(max)(fallback)
+
+	transport, err := quic.NewTransport(*nodeKey, mConnConfig,
+		quic.TransportConnFilters(connFilters...),
+		quic.TransportConnSet(conns),
+		quic.TransportMaxIncomingConnections(max),
+		quic.TransportFallback(fallback),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create QUIC transport: %w", err)
+	}
+	return transport, peerFilters, nil
 }
 
 func createSwitch(config *cfg.Config,
@@ -572,7 +767,16 @@ func startStateSync(
 ) error {
 	ssR.Logger.Info("Starting state sync")
 
-	if stateProvider == nil {
+	var providerPeers []nodekey.ID
+	for _, peer := range config.ProviderPeers {
+		id := nodekey.ID(peer)
+		if err := na.ValidateID(id); err != nil {
+			return fmt.Errorf("invalid provider peer %q: %w", peer, err)
+		}
+		providerPeers = append(providerPeers, id)
+	}
+
+	if stateProvider == nil && len(providerPeers) == 0 {
 		var err error
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
@@ -591,6 +795,31 @@ func startStateSync(
 	}
 
 	go func() {
+		if stateProvider == nil {
+			// The provider peers are likely not connected yet, so the light
+			// client is set up in the background, giving them time to connect.
+			var err error
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			stateProvider, err = statesync.NewP2PStateProvider(
+				ctx,
+				state.ChainID, state.Version, state.InitialHeight,
+				providerPeers, ssR, light.TrustOptions{
+					Period: config.TrustPeriod,
+					Height: config.TrustHeight,
+					Hash:   config.TrustHashBytes(),
+				}, ssR.Logger.With("module", "light"),
+				dbKeyLayoutVersion)
+			cancel()
+			if err != nil {
+				ssR.Logger.Error("Failed to set up p2p light client state provider", "err", err)
+				err = bcR.SwitchToBlockSync(state)
+				if err != nil {
+					ssR.Logger.Error("Failed to switch to block sync", "err", err)
+				}
+				return
+			}
+		}
+
 		state, commit, err := ssR.Sync(stateProvider, config.MaxDiscoveryTime)
 		if err != nil {
 			ssR.Logger.Error("State sync failed", "err", err)
@@ -736,6 +965,55 @@ func createAndStartPrivValidatorSocketClient(
 	return pvscWithRetries, nil
 }
 
+// createAndStartPrivValidatorThresholdClient listens on each of listenAddrs
+// for a connection from an external signing process, and returns a
+// PrivValidator requiring threshold of them to agree on every signature. A
+// threshold of 0 means a majority of them.
+func createAndStartPrivValidatorThresholdClient(
+	listenAddrs []string,
+	threshold int,
+	chainID string,
+	metrics *privval.Metrics,
+	logger log.Logger,
+) (types.PrivValidator, error) {
+	if threshold == 0 {
+		threshold = len(listenAddrs)/2 + 1
+	}
+
+	signers := make([]*privval.SignerClient, 0, len(listenAddrs))
+	for _, listenAddr := range listenAddrs {
+		pve, err := privval.NewSignerListener(listenAddr, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start private validator: %w", err)
+		}
+
+		pvsc, err := privval.NewSignerClient(pve, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start private validator: %w", err)
+		}
+		signers = append(signers, pvsc)
+	}
+
+	pvtc, err := privval.NewThresholdSignerClient(signers, threshold, privval.ThresholdSignerClientMetrics(metrics))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start private validator: %w", err)
+	}
+
+	// give the signing processes time to connect before the first request
+	const connectTimeout = 5 * time.Second
+	if err := pvtc.WaitForConnection(connectTimeout); err != nil {
+		return nil, fmt.Errorf("failed to connect to private validators: %w", err)
+	}
+
+	// try to get a pubkey from private validate first time
+	_, err = pvtc.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("can't get pubkey: %w", err)
+	}
+
+	return pvtc, nil
+}
+
 // splitAndTrimEmpty slices s into all subslices separated by sep and returns a
 // slice of the string s with all leading and trailing Unicode code points
 // contained in cutset removed. If sep is empty, SplitAndTrim splits after each
@@ -3,6 +3,7 @@ package p2p
 import (
 	"fmt"
 	"net"
+	"time"
 
 	na "github.com/cometbft/cometbft/p2p/netaddr"
 	"github.com/cometbft/cometbft/p2p/nodekey"
@@ -50,6 +51,28 @@ func (e ErrSwitchAuthenticationFailure) Error() string {
 	)
 }
 
+// ErrPeerScoreTooLow is raised when a peer is disconnected because its score
+// dropped too low.
+type ErrPeerScoreTooLow struct {
+	ID    nodekey.ID
+	Score float64
+}
+
+func (e ErrPeerScoreTooLow) Error() string {
+	return fmt.Sprintf("score of peer %v too low: %.1f", e.ID, e.Score)
+}
+
+// ErrPeerBanned is raised when a peer is disconnected or rejected because it
+// is banned for misbehaving.
+type ErrPeerBanned struct {
+	ID    nodekey.ID
+	Until time.Time
+}
+
+func (e ErrPeerBanned) Error() string {
+	return fmt.Sprintf("peer %v is banned until %v", e.ID, e.Until.Format(time.RFC3339))
+}
+
 // ErrPeerRemoval is raised when attempting to remove a peer results in an error.
 type ErrPeerRemoval struct{}
 
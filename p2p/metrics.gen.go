@@ -50,6 +50,24 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "send_rate_limiter_delay",
 			Help:      "Time in seconds spent sleeping by the send rate limiter",
 		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerScore: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_score",
+			Help:      "Score of a peer, from the behaviours reported by the reactors.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerBehaviours: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_behaviours",
+			Help:      "Number of behaviours of peers reported by the reactors.",
+		}, append(labels, "behaviour")).With(labelsAndValues...),
+		BannedPeers: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "banned_peers",
+			Help:      "Number of peers banned for their low score.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -61,5 +79,8 @@ func NopMetrics() *Metrics {
 		MessageSendBytesTotal:    discard.NewCounter(),
 		RecvRateLimiterDelay:     discard.NewCounter(),
 		SendRateLimiterDelay:     discard.NewCounter(),
+		PeerScore:                discard.NewGauge(),
+		PeerBehaviours:           discard.NewCounter(),
+		BannedPeers:              discard.NewCounter(),
 	}
 }
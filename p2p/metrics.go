@@ -36,6 +36,12 @@ type Metrics struct {
 	RecvRateLimiterDelay metrics.Counter `metrics_labels:"peer_id"`
 	// Time in seconds spent sleeping by the send rate limiter
 	SendRateLimiterDelay metrics.Counter `metrics_labels:"peer_id"`
+	// Score of a peer, from the behaviours reported by the reactors.
+	PeerScore metrics.Gauge `metrics_labels:"peer_id"`
+	// Number of behaviours of peers reported by the reactors.
+	PeerBehaviours metrics.Counter `metrics_labels:"behaviour"`
+	// Number of peers banned for their low score.
+	BannedPeers metrics.Counter
 }
 
 type peerPendingMetricsCache struct {
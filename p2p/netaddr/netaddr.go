@@ -36,16 +36,24 @@ func IDAddrString(id nodekey.ID, protocolHostPort string) string {
 	return fmt.Sprintf("%s@%s", id, hostPort)
 }
 
-// New returns a new address using the provided TCP
-// address. When testing, other net.Addr (except TCP) will result in
-// using 0.0.0.0:0. When normal run, other net.Addr (except TCP) will
+// New returns a new address using the provided TCP or UDP
+// address. When testing, other net.Addr (except TCP and UDP) will result in
+// using 0.0.0.0:0. When normal run, other net.Addr (except TCP and UDP) will
 // panic. Panics if ID is invalid.
 // TODO: socks proxies?
 func New(id nodekey.ID, addr net.Addr) *NetAddr {
-	tcpAddr, ok := addr.(*net.TCPAddr)
-	if !ok {
+	var (
+		ip   net.IP
+		port uint16
+	)
+	switch addr := addr.(type) {
+	case *net.TCPAddr:
+		ip, port = addr.IP, uint16(addr.Port)
+	case *net.UDPAddr: // QUIC connections
+		ip, port = addr.IP, uint16(addr.Port)
+	default:
 		if flag.Lookup("test.v") == nil { // normal run
-			panic(fmt.Sprintf("Only TCPAddrs are supported. Got: %v", addr))
+			panic(fmt.Sprintf("Only TCPAddrs and UDPAddrs are supported. Got: %v", addr))
 		}
 		// in testing
 		netAddr := NewFromIPPort(net.IP("127.0.0.1"), 0)
@@ -57,8 +65,6 @@ func New(id nodekey.ID, addr net.Addr) *NetAddr {
 		panic(fmt.Sprintf("Invalid ID %v: %v (addr: %v)", id, err, addr))
 	}
 
-	ip := tcpAddr.IP
-	port := uint16(tcpAddr.Port)
 	na := NewFromIPPort(ip, port)
 	na.ID = id
 	return na
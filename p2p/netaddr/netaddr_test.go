@@ -42,9 +42,18 @@ func TestNew(t *testing.T) {
 	addr := New("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", tcpAddr)
 	assert.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8080", addr.String())
 
+	// UDP addresses are used by QUIC connections, and are validated like TCP
+	// ones.
+	udpAddr := &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000}
+	assert.Panics(t, func() {
+		New("", udpAddr)
+	})
+	addr = New("deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", udpAddr)
+	assert.Equal(t, "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef@127.0.0.1:8000", addr.String())
+
 	assert.NotPanics(t, func() {
-		New("", &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8000})
-	}, "Calling New with UDPAddr should not panic in testing")
+		New("", &net.UnixAddr{Name: "/tmp/test.sock", Net: "unix"})
+	}, "Calling New with UnixAddr should not panic in testing")
 }
 
 func TestNewFromString(t *testing.T) {
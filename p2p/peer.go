@@ -14,6 +14,7 @@ import (
 	na "github.com/cometbft/cometbft/p2p/netaddr"
 	ni "github.com/cometbft/cometbft/p2p/nodeinfo"
 	"github.com/cometbft/cometbft/p2p/nodekey"
+	"github.com/cometbft/cometbft/p2p/transport/quic"
 	tcpconn "github.com/cometbft/cometbft/p2p/transport/tcp/conn"
 	"github.com/cometbft/cometbft/types"
 )
@@ -109,6 +110,20 @@ func (pc peerConn) RemoteIP() net.IP {
 	return pc.ip
 }
 
+// streamConn sends and receives the messages of the channels of a peer over
+// its connection. It is implemented by tcpconn.MConnection, which multiplexes
+// the channels over the connection, and by quic.StreamConnection, which sends
+// each channel over a stream of its own.
+type streamConn interface {
+	service.Service
+	FlushStop()
+
+	Send(chID byte, msgBytes []byte) bool
+	TrySend(chID byte, msgBytes []byte) bool
+	CanSend(chID byte) bool
+	Status() tcpconn.ConnectionStatus
+}
+
 // peer implements Peer.
 //
 // Before using a peer, you will need to perform a handshake on connection.
@@ -117,7 +132,7 @@ type peer struct {
 
 	// raw peerConn and the multiplex connection
 	peerConn
-	mconn *tcpconn.MConnection
+	mconn streamConn
 
 	// peer's node info and the channel it knows about
 	// channels = nodeInfo.Channels
@@ -413,7 +428,7 @@ func createMConnection(
 	streamDescs []StreamDescriptor,
 	onPeerError func(Peer, any),
 	config tcpconn.MConnConfig,
-) *tcpconn.MConnection {
+) streamConn {
 	onReceive := func(chID byte, msgBytes []byte) {
 		reactor := reactorsByCh[chID]
 		if reactor == nil {
@@ -456,6 +471,9 @@ func createMConnection(
 		tcpDescs = append(tcpDescs, d)
 	}
 
+	if qc, ok := conn.(*quic.Conn); ok {
+		return quic.NewStreamConnection(qc, tcpDescs, onReceive, onError, config)
+	}
 	return tcpconn.NewMConnectionWithConfig(
 		conn,
 		tcpDescs,
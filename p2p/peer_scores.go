@@ -0,0 +1,160 @@
+package p2p
+
+import (
+	"math"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p/nodekey"
+)
+
+const (
+	// Scores halve every peerScoreHalfLife, so that peers recover from
+	// occasional misbehaviours, and can't rely on past good behaviour forever.
+	peerScoreHalfLife = 10 * time.Minute
+
+	// maxPeerScore caps the score of a peer, so that a peer can't build up a
+	// credit of good behaviour to misbehave afterwards.
+	maxPeerScore = 100.0
+
+	// Peers whose score drops to disconnectPeerScore are disconnected, and
+	// banned for peerBanTime if it drops to banPeerScore.
+	disconnectPeerScore = -100.0
+	banPeerScore        = -200.0
+	peerBanTime         = time.Hour
+
+	// maxScoredPeers is the number of scores above which the scores that
+	// decayed to almost zero are dropped.
+	maxScoredPeers = 10000
+)
+
+// PeerBehaviour is a behaviour of a peer, reported by a reactor to the Switch
+// with ReportBehaviour. Each behaviour adds its weight to the score of the
+// peer: negative for misbehaviours, positive otherwise.
+type PeerBehaviour uint8
+
+const (
+	// BehaviourInvalidMessage is a message that failed validation, such as a
+	// block part with an invalid proof.
+	BehaviourInvalidMessage PeerBehaviour = iota + 1
+	// BehaviourSlowResponse is a request the peer didn't respond to in time,
+	// such as a request for a state sync chunk.
+	BehaviourSlowResponse
+	// BehaviourUsefulMessage is a message of use, such as a vote added to the
+	// vote set.
+	BehaviourUsefulMessage
+)
+
+var peerBehaviourWeights = map[PeerBehaviour]float64{
+	BehaviourInvalidMessage: -50,
+	BehaviourSlowResponse:   -10,
+	BehaviourUsefulMessage:  1,
+}
+
+// String returns the name of the behaviour, used as a metrics label.
+func (b PeerBehaviour) String() string {
+	switch b {
+	case BehaviourInvalidMessage:
+		return "invalid_message"
+	case BehaviourSlowResponse:
+		return "slow_response"
+	case BehaviourUsefulMessage:
+		return "useful_message"
+	default:
+		return "unknown"
+	}
+}
+
+type peerScore struct {
+	score       float64
+	updated     time.Time
+	bannedUntil time.Time
+}
+
+// decay decays the score down to the given time.
+func (ps *peerScore) decay(now time.Time) {
+	if elapsed := now.Sub(ps.updated); elapsed > 0 {
+		ps.score *= math.Exp2(-float64(elapsed) / float64(peerScoreHalfLife))
+		ps.updated = now
+	}
+}
+
+// peerScores keeps the decaying scores of peers, by ID, including the peers
+// that are no longer connected, so that a misbehaving peer doesn't get a clean
+// slate by reconnecting.
+type peerScores struct {
+	mtx    cmtsync.Mutex
+	scores map[nodekey.ID]*peerScore
+}
+
+func newPeerScores() *peerScores {
+	return &peerScores{scores: make(map[nodekey.ID]*peerScore)}
+}
+
+// report adds the weight of the behaviour to the score of the peer, and
+// returns the new score.
+func (s *peerScores) report(id nodekey.ID, behaviour PeerBehaviour, now time.Time) float64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	ps, ok := s.scores[id]
+	if !ok {
+		if len(s.scores) >= maxScoredPeers {
+			s.prune(now)
+		}
+		ps = &peerScore{updated: now}
+		s.scores[id] = ps
+	}
+	ps.decay(now)
+	ps.score = math.Min(ps.score+peerBehaviourWeights[behaviour], maxPeerScore)
+	return ps.score
+}
+
+// score returns the score of the peer, zero if it has none.
+func (s *peerScores) score(id nodekey.ID, now time.Time) float64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	ps, ok := s.scores[id]
+	if !ok {
+		return 0
+	}
+	ps.decay(now)
+	return ps.score
+}
+
+// ban bans the peer until the given time.
+func (s *peerScores) ban(id nodekey.ID, until time.Time) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	ps, ok := s.scores[id]
+	if !ok {
+		ps = &peerScore{updated: time.Now()}
+		s.scores[id] = ps
+	}
+	ps.bannedUntil = until
+}
+
+// bannedUntil returns the time until which the peer is banned, if it is.
+func (s *peerScores) bannedUntil(id nodekey.ID, now time.Time) (time.Time, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	ps, ok := s.scores[id]
+	if !ok || !now.Before(ps.bannedUntil) {
+		return time.Time{}, false
+	}
+	return ps.bannedUntil, true
+}
+
+// prune drops the scores that decayed to almost zero of the peers that aren't
+// banned. The caller must hold the lock.
+func (s *peerScores) prune(now time.Time) {
+	for id, ps := range s.scores {
+		ps.decay(now)
+		if math.Abs(ps.score) < 1 && !now.Before(ps.bannedUntil) {
+			delete(s.scores, id)
+		}
+	}
+}
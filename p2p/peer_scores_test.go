@@ -0,0 +1,80 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cometbft/cometbft/p2p/nodekey"
+)
+
+func TestPeerScores_Report(t *testing.T) {
+	var (
+		s   = newPeerScores()
+		id  = nodekey.ID("peer")
+		now = time.Now()
+	)
+
+	assert.Zero(t, s.score(id, now))
+	assert.InDelta(t, -50.0, s.report(id, BehaviourInvalidMessage, now), 1e-9)
+	assert.InDelta(t, -49.0, s.report(id, BehaviourUsefulMessage, now), 1e-9)
+	assert.InDelta(t, -49.0, s.score(id, now), 1e-9)
+
+	// The score halves every half-life.
+	assert.InDelta(t, -24.5, s.score(id, now.Add(peerScoreHalfLife)), 1e-9)
+	assert.InDelta(t, -12.25, s.score(id, now.Add(2*peerScoreHalfLife)), 1e-9)
+}
+
+func TestPeerScores_ReportCapsScore(t *testing.T) {
+	var (
+		s   = newPeerScores()
+		id  = nodekey.ID("peer")
+		now = time.Now()
+	)
+
+	for i := 0; i < 2*int(maxPeerScore); i++ {
+		s.report(id, BehaviourUsefulMessage, now)
+	}
+	assert.InDelta(t, maxPeerScore, s.score(id, now), 1e-9)
+	assert.InDelta(t, maxPeerScore-50, s.report(id, BehaviourInvalidMessage, now), 1e-9)
+}
+
+func TestPeerScores_Ban(t *testing.T) {
+	var (
+		s   = newPeerScores()
+		id  = nodekey.ID("peer")
+		now = time.Now()
+	)
+
+	_, banned := s.bannedUntil(id, now)
+	assert.False(t, banned)
+
+	until := now.Add(peerBanTime)
+	s.ban(id, until)
+	got, banned := s.bannedUntil(id, now)
+	assert.True(t, banned)
+	assert.Equal(t, until, got)
+
+	_, banned = s.bannedUntil(id, until)
+	assert.False(t, banned)
+}
+
+func TestPeerScores_Prune(t *testing.T) {
+	var (
+		s   = newPeerScores()
+		now = time.Now()
+	)
+
+	s.report("decayed", BehaviourUsefulMessage, now)
+	s.report("scored", BehaviourInvalidMessage, now)
+	s.ban("banned", now.Add(peerBanTime))
+
+	s.mtx.Lock()
+	s.prune(now.Add(peerScoreHalfLife))
+	s.mtx.Unlock()
+
+	assert.NotContains(t, s.scores, nodekey.ID("decayed"))
+	assert.Contains(t, s.scores, nodekey.ID("scored"))
+	assert.Contains(t, s.scores, nodekey.ID("banned"))
+}
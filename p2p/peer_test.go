@@ -21,6 +21,7 @@ import (
 	na "github.com/cometbft/cometbft/p2p/netaddr"
 	ni "github.com/cometbft/cometbft/p2p/nodeinfo"
 	"github.com/cometbft/cometbft/p2p/nodekey"
+	"github.com/cometbft/cometbft/p2p/transport/quic"
 	tcpconn "github.com/cometbft/cometbft/p2p/transport/tcp/conn"
 )
 
@@ -78,6 +79,91 @@ func TestPeerSend(t *testing.T) {
 	assert.True(p.Send(Envelope{ChannelID: testCh, Message: &p2p.Message{}}))
 }
 
+func TestPeerSendQUIC(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+
+	// Pick a free UDP port for the listener.
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(err)
+	listenAddr := pc.LocalAddr().String()
+	require.NoError(pc.Close())
+
+	newTransport := func() (*quic.Transport, nodekey.NodeKey) {
+		nk := nodekey.NodeKey{PrivKey: ed25519.GenPrivKey()}
+		tr, err := quic.NewTransport(nk, tcpconn.DefaultMConnConfig())
+		require.NoError(err)
+		return tr, nk
+	}
+	listener, listenerKey := newTransport()
+	addr, err := na.NewFromString(na.IDAddrString(listenerKey.ID(), listenAddr))
+	require.NoError(err)
+	require.NoError(listener.Listen(*addr))
+	t.Cleanup(func() { _ = listener.Close() })
+	dialer, _ := newTransport()
+
+	type accepted struct {
+		conn net.Conn
+		err  error
+	}
+	acceptc := make(chan accepted, 1)
+	go func() {
+		c, _, err := listener.Accept()
+		acceptc <- accepted{c, err}
+	}()
+	dialedConn, err := dialer.Dial(*addr)
+	require.NoError(err)
+	a := <-acceptc
+	require.NoError(a.err)
+
+	// Perform the handshake on both ends, and create the peers.
+	streamDescs := []StreamDescriptor{
+		&tcpconn.ChannelDescriptor{
+			ID:           testCh,
+			Priority:     1,
+			MessageTypeI: &p2p.Message{},
+		},
+	}
+	createPeer := func(conn net.Conn, outbound bool, reactor Reactor) (*peer, error) {
+		ourNodeInfo := testNodeInfo(nodekey.PubKeyToID(ed25519.GenPrivKey().PubKey()), "host_peer")
+		peerNodeInfo, err := handshake(ourNodeInfo, conn, time.Second)
+		if err != nil {
+			return nil, err
+		}
+		pc := newPeerConn(outbound, false, conn, na.New(peerNodeInfo.ID(), conn.RemoteAddr()))
+		p := newPeer(pc, tcpconn.DefaultMConnConfig(), peerNodeInfo, map[byte]Reactor{testCh: reactor},
+			map[byte]proto.Message{testCh: &p2p.Message{}}, streamDescs, func(_ Peer, _ any) {})
+		p.SetLogger(log.TestingLogger())
+		return p, nil
+	}
+	receiverReactor := NewTestReactor(streamDescs, true)
+	receiverc := make(chan *peer, 1)
+	go func() {
+		p, err := createPeer(a.conn, false, receiverReactor)
+		if err != nil {
+			t.Error(err)
+		}
+		receiverc <- p
+	}()
+	sender, err := createPeer(dialedConn, true, NewTestReactor(streamDescs, true))
+	require.NoError(err)
+	receiver := <-receiverc
+	require.NotNil(receiver)
+
+	_, isStreamConn := sender.mconn.(*quic.StreamConnection)
+	require.True(isStreamConn)
+	for _, p := range []*peer{sender, receiver} {
+		require.NoError(p.Start())
+		t.Cleanup(func() { _ = p.Stop() })
+	}
+
+	assert.True(sender.CanSend(testCh))
+	msg := &p2p.PexAddrs{Addrs: []p2p.NetAddress{{ID: "1"}}}
+	assert.True(sender.Send(Envelope{ChannelID: testCh, Message: msg}))
+	assert.Eventually(func() bool {
+		return len(receiverReactor.getMsgs(testCh)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func createOutboundPeerAndPerformHandshake(
 	addr *na.NetAddr,
 	config *config.P2PConfig,
@@ -52,6 +52,10 @@ const (
 
 	// if a peer is marked bad, it will be banned for at least this time period.
 	defaultBanTime = 24 * time.Hour
+
+	// addresses of peers scored below this by the switch are neither dialed
+	// nor shared, until their score decays back above it.
+	minPeerScore = -50.0
 )
 
 // Reactor handles PEX (peer exchange) and ensures that an
@@ -252,7 +256,7 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 			r.lastReceivedRequests.Set(id, time.Now())
 
 			// Send addrs and disconnect
-			r.SendAddrs(e.Src, r.book.GetSelectionWithBias(biasToSelectNewPeers))
+			r.SendAddrs(e.Src, r.withoutLowScores(r.book.GetSelectionWithBias(biasToSelectNewPeers)))
 			go func() {
 				// In a go-routine so it doesn't block .Receive.
 				e.Src.FlushStop()
@@ -265,7 +269,7 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 				r.book.MarkBad(e.Src.SocketAddr(), defaultBanTime)
 				return
 			}
-			r.SendAddrs(e.Src, r.book.GetSelection())
+			r.SendAddrs(e.Src, r.withoutLowScores(r.book.GetSelection()))
 		}
 
 	case *tmp2p.PexAddrs:
@@ -378,6 +382,18 @@ func (r *Reactor) ReceiveAddrs(addrs []*na.NetAddr, src Peer) error {
 	return nil
 }
 
+// withoutLowScores returns the addresses of the peers scored at least
+// minPeerScore by the switch.
+func (r *Reactor) withoutLowScores(addrs []*na.NetAddr) []*na.NetAddr {
+	filtered := make([]*na.NetAddr, 0, len(addrs))
+	for _, addr := range addrs {
+		if r.Switch.PeerScore(addr.ID) >= minPeerScore {
+			filtered = append(filtered, addr)
+		}
+	}
+	return filtered
+}
+
 // SendAddrs sends addrs to the peer.
 func (*Reactor) SendAddrs(p Peer, netAddrs []*na.NetAddr) {
 	e := p2p.Envelope{
@@ -475,6 +491,9 @@ func (r *Reactor) ensurePeers() {
 		if r.Switch.IsDialingOrExistingAddress(try) {
 			continue
 		}
+		if r.Switch.PeerScore(try.ID) < minPeerScore {
+			continue
+		}
 		// TODO: consider moving some checks from toDial into here
 		// so we don't even consider dialing peers that we want to wait
 		// before dialing again, or have dialed too many times already
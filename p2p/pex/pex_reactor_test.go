@@ -124,6 +124,7 @@ func TestPEXReactorRunning(t *testing.T) {
 func TestPEXReactorReceive(t *testing.T) {
 	r, book := createReactor(&ReactorConfig{})
 	defer teardownReactor(book)
+	createSwitchAndAddReactors(r)
 
 	peer := p2p.CreateRandomPeer(false)
 
@@ -138,6 +139,23 @@ func TestPEXReactorReceive(t *testing.T) {
 	r.Receive(p2p.Envelope{ChannelID: PexChannel, Src: peer, Message: &tmp2p.PexRequest{}})
 }
 
+func TestPEXReactorSkipsLowScoredPeers(t *testing.T) {
+	r, book := createReactor(&ReactorConfig{})
+	defer teardownReactor(book)
+	sw := createSwitchAndAddReactors(r)
+
+	var (
+		good = mock.NewPeer(nil)
+		bad  = mock.NewPeer(nil)
+	)
+	sw.ReportBehaviour(bad, p2p.BehaviourInvalidMessage)
+	sw.ReportBehaviour(bad, p2p.BehaviourSlowResponse)
+	require.Less(t, sw.PeerScore(bad.ID()), minPeerScore)
+
+	addrs := r.withoutLowScores([]*na.NetAddr{good.SocketAddr(), bad.SocketAddr()})
+	assert.Equal(t, []*na.NetAddr{good.SocketAddr()}, addrs)
+}
+
 func TestPEXReactorRequestMessageAbuse(t *testing.T) {
 	r, book := createReactor(&ReactorConfig{})
 	defer teardownReactor(book)
@@ -15,6 +15,7 @@ import (
 	na "github.com/cometbft/cometbft/p2p/netaddr"
 	ni "github.com/cometbft/cometbft/p2p/nodeinfo"
 	"github.com/cometbft/cometbft/p2p/nodekey"
+	"github.com/cometbft/cometbft/p2p/transport/quic"
 	"github.com/cometbft/cometbft/p2p/transport/tcp"
 	"github.com/cometbft/cometbft/p2p/transport/tcp/conn"
 )
@@ -60,6 +61,7 @@ type AddrBook interface {
 	AddOurAddress(addr *na.NetAddr)
 	OurAddress(addr *na.NetAddr) bool
 	MarkGood(id nodekey.ID)
+	MarkBad(addr *na.NetAddr, banTime time.Duration)
 	RemoveAddress(addr *na.NetAddr)
 	HasAddress(addr *na.NetAddr) bool
 	Save()
@@ -100,6 +102,8 @@ type Switch struct {
 
 	rng *rand.Rand // seed for randomizing dial times and orders
 
+	scores *peerScores
+
 	metrics *Metrics
 }
 
@@ -132,6 +136,7 @@ func NewSwitch(
 		filterTimeout:        defaultFilterTimeout,
 		persistentPeersAddrs: make([]*na.NetAddr, 0),
 		unconditionalPeerIDs: make(map[nodekey.ID]struct{}),
+		scores:               newPeerScores(),
 	}
 
 	// Ensure we have a completely undeterministic PRNG.
@@ -458,6 +463,39 @@ func (sw *Switch) MarkPeerAsGood(peer Peer) {
 	}
 }
 
+// ReportBehaviour reports a behaviour of the given peer, which updates its
+// score. The peer is disconnected if its score drops too low, and banned for a
+// while if it drops further. Unconditional peers are scored, but never
+// disconnected nor banned.
+func (sw *Switch) ReportBehaviour(peer Peer, behaviour PeerBehaviour) {
+	now := time.Now()
+	score := sw.scores.report(peer.ID(), behaviour, now)
+	sw.metrics.PeerBehaviours.With("behaviour", behaviour.String()).Add(1)
+	sw.metrics.PeerScore.With("peer_id", string(peer.ID())).Set(score)
+
+	if sw.IsPeerUnconditional(peer.ID()) {
+		return
+	}
+	switch {
+	case score <= banPeerScore:
+		until := now.Add(peerBanTime)
+		sw.scores.ban(peer.ID(), until)
+		sw.metrics.BannedPeers.Add(1)
+		if sw.addrBook != nil {
+			sw.addrBook.MarkBad(peer.SocketAddr(), peerBanTime)
+		}
+		sw.StopPeerForError(peer, ErrPeerBanned{ID: peer.ID(), Until: until})
+	case score <= disconnectPeerScore:
+		sw.StopPeerForError(peer, ErrPeerScoreTooLow{ID: peer.ID(), Score: score})
+	}
+}
+
+// PeerScore returns the score of the peer with the given ID, which may no
+// longer be connected. Peers start with a score of zero.
+func (sw *Switch) PeerScore(id nodekey.ID) float64 {
+	return sw.scores.score(id, time.Now())
+}
+
 // ---------------------------------------------------------------------
 // Dialing
 
@@ -645,6 +683,14 @@ func (sw *Switch) acceptRoutine() {
 					"numPeers", sw.peers.Size(),
 				)
 
+				continue
+			case quic.ErrRejected:
+				sw.Logger.Info(
+					"Inbound Peer rejected",
+					"err", err,
+					"numPeers", sw.peers.Size(),
+				)
+
 				continue
 			case tcp.ErrFilterTimeout:
 				sw.Logger.Error(
@@ -818,6 +864,10 @@ func (sw *Switch) filterPeer(p Peer) error {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
 	}
 
+	if until, banned := sw.scores.bannedUntil(p.ID(), time.Now()); banned {
+		return ErrRejected{id: p.ID(), err: ErrPeerBanned{ID: p.ID(), Until: until}, isFiltered: true}
+	}
+
 	errc := make(chan error, len(sw.peerFilters))
 
 	for _, f := range sw.peerFilters {
@@ -525,6 +525,67 @@ func TestSwitchStopPeerForError(t *testing.T) {
 	assert.EqualValues(t, 0, peersMetricValue())
 }
 
+func TestSwitchReportBehaviour(t *testing.T) {
+	sw1, sw2 := MakeSwitchPair(initSwitchFunc)
+	t.Cleanup(func() {
+		if err := sw1.Stop(); err != nil {
+			t.Error(err)
+		}
+		if err := sw2.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	require.Len(t, sw1.Peers().Copy(), 1)
+	p := sw1.Peers().Copy()[0]
+
+	sw1.ReportBehaviour(p, BehaviourInvalidMessage)
+	assert.InDelta(t, -50.0, sw1.PeerScore(p.ID()), 1e-3)
+	assert.True(t, sw1.Peers().Has(p.ID()))
+
+	// A score dropping to disconnectPeerScore disconnects the peer. The score
+	// decays between reports, hence the extra report.
+	sw1.ReportBehaviour(p, BehaviourInvalidMessage)
+	sw1.ReportBehaviour(p, BehaviourInvalidMessage)
+	assert.False(t, sw1.Peers().Has(p.ID()))
+	err := sw1.filterPeer(p)
+	require.NoError(t, err)
+
+	// A score dropping to banPeerScore bans the peer.
+	sw1.ReportBehaviour(p, BehaviourInvalidMessage)
+	sw1.ReportBehaviour(p, BehaviourInvalidMessage)
+	err = sw1.filterPeer(p)
+	require.Error(t, err)
+	rejected, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	assert.True(t, rejected.IsFiltered())
+	assert.ErrorContains(t, rejected, "is banned")
+}
+
+func TestSwitchReportBehaviourUnconditionalPeer(t *testing.T) {
+	sw1, sw2 := MakeSwitchPair(initSwitchFunc)
+	t.Cleanup(func() {
+		if err := sw1.Stop(); err != nil {
+			t.Error(err)
+		}
+		if err := sw2.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	require.Len(t, sw1.Peers().Copy(), 1)
+	p := sw1.Peers().Copy()[0]
+	require.NoError(t, sw1.AddUnconditionalPeerIDs([]string{string(p.ID())}))
+
+	for i := 0; i < 5; i++ {
+		sw1.ReportBehaviour(p, BehaviourInvalidMessage)
+	}
+	assert.LessOrEqual(t, sw1.PeerScore(p.ID()), banPeerScore)
+	assert.True(t, sw1.Peers().Has(p.ID()))
+	_, banned := sw1.scores.bannedUntil(p.ID(), time.Now())
+	assert.False(t, banned)
+}
+
 func TestSwitchReconnectsToOutboundPersistentPeer(t *testing.T) {
 	sw := MakeSwitch(cfg, 1, initSwitchFunc)
 	err := sw.Start()
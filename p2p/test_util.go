@@ -291,7 +291,8 @@ func (book *AddrBookMock) OurAddress(addr *na.NetAddr) bool {
 	_, ok := book.OurAddrs[addr.String()]
 	return ok
 }
-func (*AddrBookMock) MarkGood(nodekey.ID) {}
+func (*AddrBookMock) MarkGood(nodekey.ID)                {}
+func (*AddrBookMock) MarkBad(*na.NetAddr, time.Duration) {}
 func (book *AddrBookMock) HasAddress(addr *na.NetAddr) bool {
 	_, ok := book.Addrs[addr.String()]
 	return ok
@@ -0,0 +1,221 @@
+package quic
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	tmp2p "github.com/cometbft/cometbft/api/cometbft/p2p/v1"
+	"github.com/cometbft/cometbft/crypto"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/libs/protoio"
+)
+
+const (
+	// alpn is the application protocol negotiated during the TLS handshake.
+	alpn = "cometbft-p2p"
+
+	// authExporterLabel is the label of the TLS exporter value signed with
+	// the node key to authenticate a connection.
+	authExporterLabel = "EXPORTER-cometbft-p2p-auth"
+
+	maxAuthMsgSize = 1024 * 1024
+
+	// codeNoError is the application error code used when closing a
+	// connection normally.
+	codeNoError quic.ApplicationErrorCode = 0
+)
+
+// ErrChallengeVerification is returned when the remote node didn't sign the
+// connection with the key it presented.
+var ErrChallengeVerification = errors.New("challenge verification failed")
+
+// Conn is an authenticated QUIC connection to a peer. Its net.Conn methods
+// read from and write to the handshake stream, opened by the dialer, which
+// carries the node key authentication and then the p2p handshake. The
+// messages of the channels are sent over streams of their own by a
+// StreamConnection.
+type Conn struct {
+	conn         quic.Connection
+	stream       quic.Stream
+	remotePubKey crypto.PubKey
+
+	closeOnce sync.Once
+	onClose   func() // called once the connection is closed, may be nil
+}
+
+var _ net.Conn = (*Conn)(nil)
+
+func newConn(qc quic.Connection) *Conn {
+	return &Conn{conn: qc}
+}
+
+// authenticate authenticates the connection, opening the handshake stream if
+// dialer is true or accepting it otherwise.
+//
+// The authentication is equivalent to the one of SecretConnection: the QUIC
+// handshake runs TLS 1.3, with an ephemeral key exchange, and each node then
+// signs a value exported from the TLS session with its node key and sends it,
+// along with its node public key, over the encrypted handshake stream. As the
+// exported value is unique to the TLS session, the signature binds the
+// identity of the remote node to the connection, like the signature of the
+// Diffie-Hellman challenge does in SecretConnection.
+func (c *Conn) authenticate(ctx context.Context, dialer bool, privKey crypto.PrivKey) error {
+	var (
+		stream quic.Stream
+		err    error
+	)
+	if dialer {
+		stream, err = c.conn.OpenStreamSync(ctx)
+	} else {
+		stream, err = c.conn.AcceptStream(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := stream.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	tlsState := c.conn.ConnectionState().TLS
+	challenge, err := tlsState.ExportKeyingMaterial(authExporterLabel, nil, 32)
+	if err != nil {
+		return err
+	}
+	sig, err := privKey.Sign(challenge)
+	if err != nil {
+		return err
+	}
+	pbpk, err := cryptoenc.PubKeyToProto(privKey.PubKey())
+	if err != nil {
+		return err
+	}
+
+	// The messages are small enough to fit in the stream flow control window,
+	// so they can be sent before the remote one is read.
+	_, err = protoio.NewDelimitedWriter(stream).WriteMsg(&tmp2p.AuthSigMessage{PubKey: pbpk, Sig: sig})
+	if err != nil {
+		return err
+	}
+	var remoteMsg tmp2p.AuthSigMessage
+	_, err = protoio.NewDelimitedReader(stream, maxAuthMsgSize).ReadMsg(&remoteMsg)
+	if err != nil {
+		return err
+	}
+	remotePubKey, err := cryptoenc.PubKeyFromProto(remoteMsg.PubKey)
+	if err != nil {
+		return err
+	}
+	if !remotePubKey.VerifySignature(challenge, remoteMsg.Sig) {
+		return ErrChallengeVerification
+	}
+
+	if err := stream.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+	c.stream = stream
+	c.remotePubKey = remotePubKey
+	return nil
+}
+
+// RemotePubKey returns the authenticated public key of the remote node.
+func (c *Conn) RemotePubKey() crypto.PubKey {
+	return c.remotePubKey
+}
+
+// Read implements net.Conn.
+func (c *Conn) Read(b []byte) (int, error) {
+	return c.stream.Read(b)
+}
+
+// Write implements net.Conn.
+func (c *Conn) Write(b []byte) (int, error) {
+	return c.stream.Write(b)
+}
+
+// Close closes the connection, along with all its streams.
+func (c *Conn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		err = c.conn.CloseWithError(codeNoError, "")
+		if c.onClose != nil {
+			c.onClose()
+		}
+	})
+	return err
+}
+
+// LocalAddr implements net.Conn.
+func (c *Conn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+// RemoteAddr implements net.Conn.
+func (c *Conn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+// SetDeadline implements net.Conn.
+func (c *Conn) SetDeadline(t time.Time) error {
+	return c.stream.SetDeadline(t)
+}
+
+// SetReadDeadline implements net.Conn.
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	return c.stream.SetReadDeadline(t)
+}
+
+// SetWriteDeadline implements net.Conn.
+func (c *Conn) SetWriteDeadline(t time.Time) error {
+	return c.stream.SetWriteDeadline(t)
+}
+
+// Context returns a context that is canceled when the connection is closed.
+func (c *Conn) Context() context.Context {
+	return c.conn.Context()
+}
+
+// newTLSConfig returns the TLS configuration of the transport, with an
+// ephemeral self-signed certificate. Certificates aren't verified, as nodes
+// authenticate with their node keys once the connection is established.
+func newTLSConfig() (*tls.Config, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(100 * 365 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pub, priv)
+	if err != nil {
+		return nil, fmt.Errorf("creating certificate: %w", err)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{{
+			Certificate: [][]byte{der},
+			PrivateKey:  priv,
+		}},
+		//nolint:gosec // nodes are authenticated with their node keys.
+		InsecureSkipVerify: true,
+		MinVersion:         tls.VersionTLS13,
+		NextProtos:         []string{alpn},
+	}, nil
+}
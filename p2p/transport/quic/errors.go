@@ -0,0 +1,50 @@
+package quic
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/cometbft/cometbft/p2p/nodekey"
+)
+
+// ErrRejected indicates that a connection was rejected, carrying additional
+// information as to the reason.
+type ErrRejected struct {
+	conn          net.Conn
+	err           error
+	id            nodekey.ID
+	isAuthFailure bool
+	isDuplicate   bool
+	isFiltered    bool
+}
+
+func (e ErrRejected) Error() string {
+	if e.isAuthFailure {
+		return fmt.Sprintf("auth failure: %s", e.err)
+	}
+
+	if e.isDuplicate {
+		return fmt.Sprintf("duplicate CONN<%s>", e.conn.RemoteAddr())
+	}
+
+	if e.isFiltered {
+		return fmt.Sprintf("filtered CONN<%s>: %s", e.conn.RemoteAddr(), e.err)
+	}
+
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error, if any.
+func (e ErrRejected) Unwrap() error { return e.err }
+
+// ID returns the ID of the rejected node, if known.
+func (e ErrRejected) ID() nodekey.ID { return e.id }
+
+// IsAuthFailure when the node authentication was unsuccessful.
+func (e ErrRejected) IsAuthFailure() bool { return e.isAuthFailure }
+
+// IsDuplicate when the connection is present already.
+func (e ErrRejected) IsDuplicate() bool { return e.isDuplicate }
+
+// IsFiltered when the connection was filtered.
+func (e ErrRejected) IsFiltered() bool { return e.isFiltered }
@@ -0,0 +1,414 @@
+package quic
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	flow "github.com/cometbft/cometbft/internal/flowrate"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/service"
+	tcpconn "github.com/cometbft/cometbft/p2p/transport/tcp/conn"
+)
+
+const (
+	defaultSendTimeout  = 10 * time.Second
+	defaultFlushTimeout = 5 * time.Second
+	updateStats         = 2 * time.Second
+)
+
+/*
+StreamConnection sends and receives the messages of the channels of a peer
+over a QUIC connection. Unlike MConnection, which multiplexes all the channels
+over a single TCP stream, it sends the messages of each channel over a
+unidirectional QUIC stream of its own, so that a large message on one channel,
+such as a block part, doesn't hold up the messages of the other channels, such
+as votes.
+
+Each stream starts with the ID of its channel, followed by the messages of the
+channel, each prefixed with its length as a uvarint. The send and receive
+rates of the connection are limited as for MConnection, while keep-alives and
+the detection of dead peers are left to QUIC.
+
+The methods to send messages are the same as the ones of MConnection:
+
+	func (c StreamConnection) Send(chID byte, msgBytes []byte) bool {}
+	func (c StreamConnection) TrySend(chID byte, msgBytes []byte}) bool {}
+*/
+type StreamConnection struct {
+	service.BaseService
+
+	conn        *Conn
+	channels    []*channel
+	channelsIdx map[byte]*channel
+	onReceive   func(chID byte, msgBytes []byte)
+	onError     func(any)
+	config      tcpconn.MConnConfig
+	sendMonitor *flow.Monitor
+	recvMonitor *flow.Monitor
+	errored     atomic.Bool
+	flushing    atomic.Bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	flush  chan struct{} // closed to make the send routines flush and quit
+	sendWg sync.WaitGroup
+
+	created time.Time
+}
+
+// channel is a channel of a StreamConnection.
+type channel struct {
+	desc          tcpconn.ChannelDescriptor
+	sendQueue     chan []byte
+	sendQueueSize atomic.Int32
+	recentlySent  atomic.Int64 // exponential moving average
+	receiving     atomic.Bool  // whether the stream of the remote node was accepted
+}
+
+// NewStreamConnection creates a StreamConnection over the given connection.
+func NewStreamConnection(
+	conn *Conn,
+	chDescs []*tcpconn.ChannelDescriptor,
+	onReceive func(chID byte, msgBytes []byte),
+	onError func(any),
+	config tcpconn.MConnConfig,
+) *StreamConnection {
+	c := &StreamConnection{
+		conn:        conn,
+		channelsIdx: make(map[byte]*channel, len(chDescs)),
+		onReceive:   onReceive,
+		onError:     onError,
+		config:      config,
+		sendMonitor: flow.New(0, 0),
+		recvMonitor: flow.New(0, 0),
+		flush:       make(chan struct{}),
+		created:     time.Now(),
+	}
+	for _, desc := range chDescs {
+		desc := desc.FillDefaults()
+		ch := &channel{
+			desc:      desc,
+			sendQueue: make(chan []byte, desc.SendQueueCapacity),
+		}
+		c.channels = append(c.channels, ch)
+		c.channelsIdx[desc.ID] = ch
+	}
+	c.BaseService = *service.NewBaseService(nil, "StreamConnection", c)
+	return c
+}
+
+// OnStart implements BaseService.
+func (c *StreamConnection) OnStart() error {
+	if err := c.BaseService.OnStart(); err != nil {
+		return err
+	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
+
+	for _, ch := range c.channels {
+		c.sendWg.Add(1)
+		go c.sendRoutine(ch)
+	}
+	go c.acceptRoutine()
+	go c.statsRoutine()
+	return nil
+}
+
+// OnStop implements BaseService.
+func (c *StreamConnection) OnStop() {
+	c.BaseService.OnStop()
+	c.cancel()
+	_ = c.conn.Close()
+}
+
+// FlushStop sends the queued messages before closing the connection. The
+// remote node closes the connection once it has received the messages, or the
+// connection is closed after a timeout.
+//
+// NOTE: it is not safe to call this method more than once.
+func (c *StreamConnection) FlushStop() {
+	if !c.IsRunning() {
+		return
+	}
+	c.flushing.Store(true)
+	close(c.flush)
+
+	timeout := time.NewTimer(defaultFlushTimeout)
+	defer timeout.Stop()
+	flushed := make(chan struct{})
+	go func() {
+		c.sendWg.Wait()
+		close(flushed)
+	}()
+	select {
+	case <-flushed:
+		select {
+		case <-c.conn.Context().Done():
+		case <-timeout.C:
+		}
+	case <-timeout.C:
+	}
+
+	if err := c.Stop(); err != nil {
+		c.Logger.Debug("Error stopping connection", "err", err)
+	}
+}
+
+// String implements fmt.Stringer.
+func (c *StreamConnection) String() string {
+	return fmt.Sprintf("QUICConn{%v}", c.conn.RemoteAddr())
+}
+
+// Send queues a message to be sent on the given channel, waiting until there
+// is room in the queue of the channel or a timeout. It returns false if the
+// message couldn't be queued.
+func (c *StreamConnection) Send(chID byte, msgBytes []byte) bool {
+	ch, ok := c.sendableChannel(chID)
+	if !ok {
+		return false
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+		ch.sendQueueSize.Add(1)
+		return true
+	case <-time.After(defaultSendTimeout):
+		c.Logger.Debug("Send failed", "channel", chID, "conn", c, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+		return false
+	}
+}
+
+// TrySend queues a message to be sent on the given channel, if there is room
+// in the queue of the channel. It returns false if the message wasn't queued.
+func (c *StreamConnection) TrySend(chID byte, msgBytes []byte) bool {
+	ch, ok := c.sendableChannel(chID)
+	if !ok {
+		return false
+	}
+	select {
+	case ch.sendQueue <- msgBytes:
+		ch.sendQueueSize.Add(1)
+		return true
+	default:
+		return false
+	}
+}
+
+// CanSend returns true if a message can be queued on the given channel.
+func (c *StreamConnection) CanSend(chID byte) bool {
+	if !c.IsRunning() {
+		return false
+	}
+	ch, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Unknown channel %X", chID))
+		return false
+	}
+	return ch.sendQueueSize.Load() < int32(ch.desc.SendQueueCapacity)
+}
+
+func (c *StreamConnection) sendableChannel(chID byte) (*channel, bool) {
+	if !c.IsRunning() || c.flushing.Load() {
+		return nil, false
+	}
+	ch, ok := c.channelsIdx[chID]
+	if !ok {
+		c.Logger.Error(fmt.Sprintf("Cannot send bytes, unknown channel %X", chID))
+		return nil, false
+	}
+	return ch, true
+}
+
+// Status returns the status of the connection and its channels.
+func (c *StreamConnection) Status() tcpconn.ConnectionStatus {
+	status := tcpconn.ConnectionStatus{
+		Duration:    time.Since(c.created),
+		SendMonitor: c.sendMonitor.Status(),
+		RecvMonitor: c.recvMonitor.Status(),
+		Channels:    make([]tcpconn.ChannelStatus, len(c.channels)),
+	}
+	for i, ch := range c.channels {
+		status.Channels[i] = tcpconn.ChannelStatus{
+			ID:                ch.desc.ID,
+			SendQueueCapacity: cap(ch.sendQueue),
+			SendQueueSize:     int(ch.sendQueueSize.Load()),
+			Priority:          ch.desc.Priority,
+			RecentlySent:      ch.recentlySent.Load(),
+		}
+	}
+	return status
+}
+
+// sendRoutine opens the stream of a channel and sends the queued messages of
+// the channel over it.
+func (c *StreamConnection) sendRoutine(ch *channel) {
+	defer c.sendWg.Done()
+	defer c._recover()
+
+	stream, err := c.conn.conn.OpenUniStreamSync(c.ctx)
+	if err != nil {
+		c.stopForError(err)
+		return
+	}
+	w := flow.NewWriter(stream, c.config.SendRate)
+	w.Monitor = c.sendMonitor
+	bw := bufio.NewWriter(w)
+	if err := bw.WriteByte(ch.desc.ID); err != nil {
+		c.stopForError(err)
+		return
+	}
+
+	var lenBuf [binary.MaxVarintLen64]byte
+	write := func(msg []byte) error {
+		n := binary.PutUvarint(lenBuf[:], uint64(len(msg)))
+		if _, err := bw.Write(lenBuf[:n]); err != nil {
+			return err
+		}
+		if _, err := bw.Write(msg); err != nil {
+			return err
+		}
+		ch.sendQueueSize.Add(-1)
+		ch.recentlySent.Add(int64(n + len(msg)))
+		// Flush as soon as the queue is empty, batching messages otherwise.
+		if len(ch.sendQueue) == 0 {
+			return bw.Flush()
+		}
+		return nil
+	}
+
+	for {
+		select {
+		case msg := <-ch.sendQueue:
+			if err := write(msg); err != nil {
+				c.stopForError(err)
+				return
+			}
+
+		case <-c.flush:
+			for len(ch.sendQueue) > 0 {
+				if err := write(<-ch.sendQueue); err != nil {
+					c.stopForError(err)
+					return
+				}
+			}
+			if err := bw.Flush(); err != nil {
+				c.stopForError(err)
+				return
+			}
+			_ = stream.Close()
+			return
+
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+// acceptRoutine accepts the streams of the channels of the remote node.
+func (c *StreamConnection) acceptRoutine() {
+	for {
+		stream, err := c.conn.conn.AcceptUniStream(c.ctx)
+		if err != nil {
+			c.stopForError(err)
+			return
+		}
+		go c.recvRoutine(stream)
+	}
+}
+
+// recvRoutine receives the messages of a channel of the remote node.
+func (c *StreamConnection) recvRoutine(stream quic.ReceiveStream) {
+	defer c._recover()
+
+	r := flow.NewReader(stream, c.config.RecvRate)
+	r.Monitor = c.recvMonitor
+	br := bufio.NewReader(r)
+
+	chID, err := br.ReadByte()
+	if err != nil {
+		c.stopForError(err)
+		return
+	}
+	ch, ok := c.channelsIdx[chID]
+	if !ok {
+		c.stopForError(fmt.Errorf("unknown channel %X", chID))
+		return
+	}
+	if ch.receiving.Swap(true) {
+		c.stopForError(fmt.Errorf("duplicate stream for channel %X", chID))
+		return
+	}
+
+	for {
+		size, err := binary.ReadUvarint(br)
+		if err != nil {
+			c.stopForError(err)
+			return
+		}
+		if size > uint64(ch.desc.RecvMessageCapacity) {
+			c.stopForError(fmt.Errorf("received message exceeds available capacity: %v < %v",
+				ch.desc.RecvMessageCapacity, size))
+			return
+		}
+		msgBytes := make([]byte, size)
+		if _, err := io.ReadFull(br, msgBytes); err != nil {
+			c.stopForError(err)
+			return
+		}
+		c.Logger.Debug("Received bytes", "chID", chID, "msgBytes", log.NewLazySprintf("%X", msgBytes))
+		c.onReceive(chID, msgBytes)
+	}
+}
+
+// statsRoutine decays the recently sent statistics of the channels.
+func (c *StreamConnection) statsRoutine() {
+	ticker := time.NewTicker(updateStats)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for _, ch := range c.channels {
+				sent := ch.recentlySent.Load()
+				ch.recentlySent.Store(int64(float64(sent) * 0.8))
+			}
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+// _recover catches panics, which are most likely caused by the onReceive
+// callback, and stops the connection.
+func (c *StreamConnection) _recover() {
+	if r := recover(); r != nil {
+		c.Logger.Error("StreamConnection panicked", "err", r, "stack", string(debug.Stack()))
+		c.stopForError(fmt.Errorf("recovered from panic: %v", r))
+	}
+}
+
+// stopForError stops the connection and reports the error, unless the
+// connection is already being stopped.
+func (c *StreamConnection) stopForError(r any) {
+	if c.ctx.Err() != nil || c.flushing.Load() {
+		return
+	}
+	if err, ok := r.(error); ok && errors.Is(err, context.Canceled) {
+		return
+	}
+	if err := c.Stop(); err != nil {
+		c.Logger.Debug("Error stopping connection", "err", err)
+	}
+	if c.errored.CompareAndSwap(false, true) {
+		if c.onError != nil {
+			c.onError(r)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package quic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tcpconn "github.com/cometbft/cometbft/p2p/transport/tcp/conn"
+)
+
+type receivedMsg struct {
+	chID     byte
+	msgBytes []byte
+}
+
+// createConnPair returns the two ends of an authenticated connection.
+func createConnPair(t *testing.T) (*Conn, *Conn) {
+	t.Helper()
+
+	tr, addr := testSetupTransport(t)
+	dialer := newTransport(t, newNodeKey())
+
+	type dialResult struct {
+		c   *Conn
+		err error
+	}
+	dialc := make(chan dialResult, 1)
+	go func() {
+		c, err := dialer.Dial(addr)
+		if err != nil {
+			dialc <- dialResult{err: err}
+			return
+		}
+		dialc <- dialResult{c: c.(*Conn)}
+	}()
+
+	c, _, err := tr.Accept()
+	require.NoError(t, err)
+	res := <-dialc
+	require.NoError(t, res.err)
+
+	return res.c, c.(*Conn)
+}
+
+func createTestStreamConnection(
+	t *testing.T,
+	conn *Conn,
+	chDescs []*tcpconn.ChannelDescriptor,
+	onReceive func(chID byte, msgBytes []byte),
+	onError func(any),
+) *StreamConnection {
+	t.Helper()
+
+	c := NewStreamConnection(conn, chDescs, onReceive, onError, tcpconn.DefaultMConnConfig())
+	c.SetLogger(log.TestingLogger())
+	require.NoError(t, c.Start())
+	t.Cleanup(func() { _ = c.Stop() })
+	return c
+}
+
+func TestStreamConnection_SendReceive(t *testing.T) {
+	client, server := createConnPair(t)
+	chDescs := []*tcpconn.ChannelDescriptor{
+		{ID: 0x01, Priority: 1, SendQueueCapacity: 10},
+		{ID: 0x02, Priority: 1, SendQueueCapacity: 10},
+	}
+
+	receivedCh := make(chan receivedMsg, 10)
+	errorsCh := make(chan any, 1)
+	onReceive := func(chID byte, msgBytes []byte) {
+		receivedCh <- receivedMsg{chID, msgBytes}
+	}
+	onError := func(r any) {
+		errorsCh <- r
+	}
+	clientConn := createTestStreamConnection(t, client, chDescs, func(byte, []byte) {}, onError)
+	createTestStreamConnection(t, server, chDescs, onReceive, onError)
+
+	assert.True(t, clientConn.CanSend(0x01))
+	assert.True(t, clientConn.Send(0x01, []byte("vote")))
+	assert.True(t, clientConn.TrySend(0x02, []byte("block part")))
+	assert.False(t, clientConn.Send(0x05, []byte("unknown channel")))
+
+	received := make(map[byte][]byte)
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-receivedCh:
+			received[msg.chID] = msg.msgBytes
+		case err := <-errorsCh:
+			t.Fatalf("Expected %s, got %+v", "message", err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Did not receive the messages in 5s")
+		}
+	}
+	assert.Equal(t, []byte("vote"), received[0x01])
+	assert.Equal(t, []byte("block part"), received[0x02])
+
+	status := clientConn.Status()
+	require.Len(t, status.Channels, 2)
+	assert.Positive(t, status.Channels[0].RecentlySent)
+}
+
+func TestStreamConnection_FlushStop(t *testing.T) {
+	client, server := createConnPair(t)
+	chDescs := []*tcpconn.ChannelDescriptor{{ID: 0x01, Priority: 1, SendQueueCapacity: 10}}
+
+	receivedCh := make(chan []byte, 10)
+	onReceive := func(_ byte, msgBytes []byte) {
+		receivedCh <- msgBytes
+	}
+	clientConn := createTestStreamConnection(t, client, chDescs, func(byte, []byte) {}, nil)
+	createTestStreamConnection(t, server, chDescs, onReceive, nil)
+
+	for i := 0; i < 5; i++ {
+		require.True(t, clientConn.Send(0x01, []byte{byte(i)}))
+	}
+
+	flushed := make(chan struct{})
+	go func() {
+		clientConn.FlushStop()
+		close(flushed)
+	}()
+
+	for i := 0; i < 5; i++ {
+		select {
+		case msg := <-receivedCh:
+			assert.Equal(t, []byte{byte(i)}, msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Did not receive the messages in 5s")
+		}
+	}
+	// Close the connection once the messages are received, as a peer would.
+	require.NoError(t, server.Close())
+
+	select {
+	case <-flushed:
+	case <-time.After(defaultFlushTimeout):
+		t.Fatal("FlushStop did not return once the remote closed the connection")
+	}
+	assert.False(t, clientConn.IsRunning())
+	assert.False(t, clientConn.Send(0x01, []byte("after stop")))
+}
+
+func TestStreamConnection_ReceiveTooLargeMessage(t *testing.T) {
+	client, server := createConnPair(t)
+	chDescs := []*tcpconn.ChannelDescriptor{
+		{ID: 0x01, Priority: 1, SendQueueCapacity: 1, RecvMessageCapacity: 4},
+	}
+
+	errorsCh := make(chan any, 1)
+	onError := func(r any) {
+		errorsCh <- r
+	}
+	clientConn := createTestStreamConnection(t, client, chDescs, func(byte, []byte) {}, nil)
+	serverConn := createTestStreamConnection(t, server, chDescs, func(byte, []byte) {}, onError)
+
+	require.True(t, clientConn.Send(0x01, []byte("too large")))
+
+	select {
+	case err := <-errorsCh:
+		assert.ErrorContains(t, err.(error), "exceeds available capacity")
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected an error in 5s")
+	}
+	assert.False(t, serverConn.IsRunning())
+}
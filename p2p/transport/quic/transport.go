@@ -0,0 +1,385 @@
+package quic
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/quic-go/quic-go"
+
+	na "github.com/cometbft/cometbft/p2p/netaddr"
+	"github.com/cometbft/cometbft/p2p/nodekey"
+	"github.com/cometbft/cometbft/p2p/transport/tcp"
+	tcpconn "github.com/cometbft/cometbft/p2p/transport/tcp/conn"
+)
+
+const (
+	defaultDialTimeout      = time.Second
+	defaultFilterTimeout    = 5 * time.Second
+	defaultHandshakeTimeout = 3 * time.Second
+
+	// maxIncomingUniStreams is the maximum number of streams a peer can open,
+	// one per channel.
+	maxIncomingUniStreams = 256
+)
+
+// accept is the container to carry the upgraded connection from an
+// asynchronously running routine to the Accept method.
+type accept struct {
+	netAddr *na.NetAddr
+	conn    net.Conn
+	err     error
+}
+
+// TransportOption sets an optional parameter on the Transport.
+type TransportOption func(*Transport)
+
+// TransportConnFilters sets the filters for rejection new connections.
+func TransportConnFilters(filters ...tcp.ConnFilterFunc) TransportOption {
+	return func(t *Transport) { t.connFilters = filters }
+}
+
+// TransportConnSet sets the lookup table of the connections, so that it can
+// be shared with a fallback transport.
+func TransportConnSet(conns tcp.ConnSet) TransportOption {
+	return func(t *Transport) { t.conns = conns }
+}
+
+// TransportFilterTimeout sets the timeout waited for filter calls to return.
+func TransportFilterTimeout(timeout time.Duration) TransportOption {
+	return func(t *Transport) { t.filterTimeout = timeout }
+}
+
+// TransportMaxIncomingConnections sets the maximum number of simultaneous
+// connections (incoming). Default: 0 (unlimited).
+func TransportMaxIncomingConnections(n int) TransportOption {
+	return func(t *Transport) { t.maxIncomingConnections = n }
+}
+
+// TransportFallback sets a TCP transport to fall back to. It listens on the
+// same address as the QUIC transport, and is dialed when a peer doesn't
+// accept QUIC connections, so that nodes using either transport can connect
+// to each other.
+func TransportFallback(fallback *tcp.MultiplexTransport) TransportOption {
+	return func(t *Transport) { t.fallback = fallback }
+}
+
+// Transport accepts and dials QUIC connections, authenticated with the node
+// key. Unlike tcp.MultiplexTransport, whose connections multiplex all the
+// channels over a single stream, it sends the messages of each channel over a
+// QUIC stream of its own (see StreamConnection).
+type Transport struct {
+	netAddr                na.NetAddr
+	listener               *quic.Listener
+	maxIncomingConnections int // see MaxIncomingConnections
+	incoming               chan struct{}
+
+	acceptc chan accept
+	closec  chan struct{}
+
+	// Lookup table for duplicate ip and id checks.
+	conns       tcp.ConnSet
+	connFilters []tcp.ConnFilterFunc
+
+	dialTimeout      time.Duration
+	filterTimeout    time.Duration
+	handshakeTimeout time.Duration
+	nodeKey          nodekey.NodeKey
+	tlsConfig        *tls.Config
+	quicConfig       *quic.Config
+
+	fallback *tcp.MultiplexTransport
+}
+
+// NewTransport returns a QUIC transport, with the given options.
+func NewTransport(
+	nodeKey nodekey.NodeKey,
+	mConfig tcpconn.MConnConfig,
+	options ...TransportOption,
+) (*Transport, error) {
+	tlsConfig, err := newTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	t := &Transport{
+		acceptc:          make(chan accept),
+		closec:           make(chan struct{}),
+		dialTimeout:      defaultDialTimeout,
+		filterTimeout:    defaultFilterTimeout,
+		handshakeTimeout: defaultHandshakeTimeout,
+		nodeKey:          nodeKey,
+		conns:            tcp.NewConnSet(),
+		tlsConfig:        tlsConfig,
+		quicConfig: &quic.Config{
+			HandshakeIdleTimeout: defaultHandshakeTimeout,
+			// Peers that don't respond to keep-alives for as long as the
+			// MConnection would wait for a pong are disconnected.
+			KeepAlivePeriod:       mConfig.PingInterval,
+			MaxIdleTimeout:        mConfig.PingInterval + mConfig.PongTimeout,
+			MaxIncomingStreams:    1,
+			MaxIncomingUniStreams: maxIncomingUniStreams,
+		},
+	}
+	for _, option := range options {
+		option(t)
+	}
+	return t, nil
+}
+
+// NetAddr implements p2p.Transport.
+func (t *Transport) NetAddr() na.NetAddr {
+	return t.netAddr
+}
+
+// Accept implements p2p.Transport.
+func (t *Transport) Accept() (net.Conn, *na.NetAddr, error) {
+	select {
+	case a := <-t.acceptc:
+		if a.err != nil {
+			return nil, nil, a.err
+		}
+		return a.conn, a.netAddr, nil
+	case <-t.closec:
+		return nil, nil, tcp.ErrTransportClosed{}
+	}
+}
+
+// Dial implements p2p.Transport. If the peer doesn't accept QUIC connections,
+// it dials the fallback transport, if any.
+func (t *Transport) Dial(addr na.NetAddr) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), t.dialTimeout+t.handshakeTimeout)
+	defer cancel()
+
+	qc, err := quic.DialAddr(ctx, addr.DialString(), t.tlsConfig, t.quicConfig)
+	if err != nil {
+		if t.fallback != nil {
+			return t.fallback.Dial(addr)
+		}
+		return nil, err
+	}
+
+	c := newConn(qc)
+	if err := t.filterConn(c); err != nil {
+		return nil, err
+	}
+	if err := t.upgrade(ctx, c, &addr); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Close closes the transport, along with the fallback transport, if any.
+func (t *Transport) Close() error {
+	close(t.closec)
+
+	if t.fallback != nil {
+		if err := t.fallback.Close(); err != nil {
+			return err
+		}
+	}
+	if t.listener != nil {
+		return t.listener.Close()
+	}
+	return nil
+}
+
+// Listen listens on the UDP port of the given address, and the fallback
+// transport, if any, on its TCP port. If the port is 0, the fallback transport
+// listens on the TCP port with the number of the UDP port picked.
+func (t *Transport) Listen(addr na.NetAddr) error {
+	ln, err := quic.ListenAddr(addr.DialString(), t.tlsConfig, t.quicConfig)
+	if err != nil {
+		return err
+	}
+
+	if t.fallback != nil {
+		fallbackAddr := addr
+		if udpAddr, ok := ln.Addr().(*net.UDPAddr); ok && addr.Port == 0 {
+			fallbackAddr.Port = uint16(udpAddr.Port)
+		}
+		if err := t.fallback.Listen(fallbackAddr); err != nil {
+			_ = ln.Close()
+			return err
+		}
+		go t.acceptFallbackPeers()
+	}
+
+	if t.maxIncomingConnections > 0 {
+		t.incoming = make(chan struct{}, t.maxIncomingConnections)
+	}
+
+	t.netAddr = addr
+	t.listener = ln
+
+	go t.acceptPeers()
+
+	return nil
+}
+
+func (t *Transport) acceptPeers() {
+	for {
+		if t.incoming != nil {
+			select {
+			case t.incoming <- struct{}{}:
+			case <-t.closec:
+				return
+			}
+		}
+
+		qc, err := t.listener.Accept(context.Background())
+		if err != nil {
+			// If Close() has been called, silently exit.
+			select {
+			case _, ok := <-t.closec:
+				if !ok {
+					return
+				}
+			default:
+				// Transport is not closed
+			}
+
+			t.acceptc <- accept{err: err}
+			return
+		}
+
+		// Connection upgrade and filtering should be asynchronous to avoid
+		// head-of-line blocking.
+		go func(qc quic.Connection) {
+			c := newConn(qc)
+			if t.incoming != nil {
+				c.onClose = func() { <-t.incoming }
+			}
+
+			var netAddr *na.NetAddr
+			err := t.filterConn(c)
+			if err == nil {
+				ctx, cancel := context.WithTimeout(context.Background(), t.handshakeTimeout)
+				err = t.upgrade(ctx, c, nil)
+				cancel()
+				if err == nil {
+					id := nodekey.PubKeyToID(c.RemotePubKey())
+					netAddr = na.New(id, c.RemoteAddr())
+				}
+			}
+
+			select {
+			case t.acceptc <- accept{netAddr, c, err}:
+				// Make the upgraded peer available.
+			case <-t.closec:
+				// Give up if the transport was closed.
+				_ = c.Close()
+				return
+			}
+		}(qc)
+	}
+}
+
+// acceptFallbackPeers makes the connections accepted by the fallback
+// transport available.
+func (t *Transport) acceptFallbackPeers() {
+	for {
+		c, netAddr, err := t.fallback.Accept()
+		if _, ok := err.(tcp.ErrTransportClosed); ok {
+			return
+		}
+
+		select {
+		case t.acceptc <- accept{netAddr, c, err}:
+		case <-t.closec:
+			if c != nil {
+				_ = t.fallback.Cleanup(c)
+			}
+			return
+		}
+	}
+}
+
+// Cleanup removes the given address from the connections set and
+// closes the connection.
+func (t *Transport) Cleanup(c net.Conn) error {
+	if _, ok := c.(*Conn); !ok && t.fallback != nil {
+		return t.fallback.Cleanup(c)
+	}
+	t.conns.Remove(c)
+	return c.Close()
+}
+
+func (t *Transport) filterConn(c *Conn) (err error) {
+	defer func() {
+		if err != nil {
+			_ = c.Close()
+		}
+	}()
+
+	// Reject if connection is already present.
+	if t.conns.Has(c) {
+		return ErrRejected{conn: c, isDuplicate: true}
+	}
+
+	var ips []net.IP
+	if addr, ok := c.RemoteAddr().(*net.UDPAddr); ok {
+		ips = []net.IP{addr.IP}
+	}
+
+	errc := make(chan error, len(t.connFilters))
+
+	for _, f := range t.connFilters {
+		go func(f tcp.ConnFilterFunc, c net.Conn, ips []net.IP, errc chan<- error) {
+			errc <- f(t.conns, c, ips)
+		}(f, c, ips, errc)
+	}
+
+	for i := 0; i < cap(errc); i++ {
+		select {
+		case err := <-errc:
+			if err != nil {
+				return ErrRejected{conn: c, err: err, isFiltered: true}
+			}
+		case <-time.After(t.filterTimeout):
+			return tcp.ErrFilterTimeout{}
+		}
+	}
+
+	t.conns.Set(c, ips)
+
+	return nil
+}
+
+// upgrade authenticates the connection, checking the ID of the remote node
+// against the dialed one for outgoing connections.
+func (t *Transport) upgrade(ctx context.Context, c *Conn, dialedAddr *na.NetAddr) (err error) {
+	defer func() {
+		if err != nil {
+			_ = t.Cleanup(c)
+		}
+	}()
+
+	if err := c.authenticate(ctx, dialedAddr != nil, t.nodeKey.PrivKey); err != nil {
+		return ErrRejected{
+			conn:          c,
+			err:           fmt.Errorf("authentication failed: %w", err),
+			isAuthFailure: true,
+		}
+	}
+
+	// For outgoing conns, ensure connection key matches dialed key.
+	connID := nodekey.PubKeyToID(c.RemotePubKey())
+	if dialedAddr != nil {
+		if dialedID := dialedAddr.ID; connID != dialedID {
+			return ErrRejected{
+				conn: c,
+				id:   connID,
+				err: fmt.Errorf(
+					"conn.ID (%v) dialed ID (%v) mismatch",
+					connID,
+					dialedID,
+				),
+				isAuthFailure: true,
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package quic
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	na "github.com/cometbft/cometbft/p2p/netaddr"
+	"github.com/cometbft/cometbft/p2p/nodekey"
+	"github.com/cometbft/cometbft/p2p/transport/tcp"
+	tcpconn "github.com/cometbft/cometbft/p2p/transport/tcp/conn"
+)
+
+func newNodeKey() nodekey.NodeKey {
+	return nodekey.NodeKey{PrivKey: ed25519.GenPrivKey()}
+}
+
+func newTransport(t *testing.T, nodeKey nodekey.NodeKey, options ...TransportOption) *Transport {
+	t.Helper()
+
+	tr, err := NewTransport(nodeKey, tcpconn.DefaultMConnConfig(), options...)
+	require.NoError(t, err)
+	return tr
+}
+
+// testSetupTransport returns a transport listening on a random local port,
+// along with the address to dial it.
+func testSetupTransport(t *testing.T, options ...TransportOption) (*Transport, na.NetAddr) {
+	t.Helper()
+
+	nodeKey := newNodeKey()
+	tr := newTransport(t, nodeKey, options...)
+
+	addr, err := na.NewFromString(na.IDAddrString(nodeKey.ID(), "127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, tr.Listen(*addr))
+	t.Cleanup(func() { _ = tr.Close() })
+
+	return tr, *na.New(nodeKey.ID(), tr.listener.Addr())
+}
+
+func TestTransport_DialAccept(t *testing.T) {
+	tr, addr := testSetupTransport(t)
+
+	dialerKey := newNodeKey()
+	dialer := newTransport(t, dialerKey)
+
+	dialErrc := make(chan error, 1)
+	go func() {
+		c, err := dialer.Dial(addr)
+		if err == nil {
+			require.Equal(t, tr.nodeKey.PubKey(), c.(*Conn).RemotePubKey())
+		}
+		dialErrc <- err
+	}()
+
+	c, netAddr, err := tr.Accept()
+	require.NoError(t, err)
+	require.NoError(t, <-dialErrc)
+	defer c.Close()
+
+	require.Equal(t, dialerKey.ID(), netAddr.ID)
+	require.Equal(t, dialerKey.PubKey(), c.(*Conn).RemotePubKey())
+	require.True(t, tr.conns.Has(c))
+}
+
+func TestTransport_DialRejectWrongID(t *testing.T) {
+	_, addr := testSetupTransport(t)
+
+	dialer := newTransport(t, newNodeKey())
+	addr.ID = nodekey.PubKeyToID(ed25519.GenPrivKey().PubKey())
+
+	_, err := dialer.Dial(addr)
+	require.Error(t, err)
+	e, ok := err.(ErrRejected)
+	require.True(t, ok, "expected ErrRejected, got %v", err)
+	require.True(t, e.IsAuthFailure())
+}
+
+func TestTransport_AcceptAfterClose(t *testing.T) {
+	nodeKey := newNodeKey()
+	tr := newTransport(t, nodeKey)
+	addr, err := na.NewFromString(na.IDAddrString(nodeKey.ID(), "127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, tr.Listen(*addr))
+	require.NoError(t, tr.Close())
+
+	_, _, err = tr.Accept()
+	require.ErrorIs(t, err, tcp.ErrTransportClosed{})
+}
+
+// testSetupFallbackTransport returns a QUIC transport falling back to a TCP
+// transport, listening on a random local port, along with the address to dial
+// it.
+func testSetupFallbackTransport(t *testing.T) (*Transport, na.NetAddr) {
+	t.Helper()
+
+	var (
+		nodeKey  = newNodeKey()
+		conns    = tcp.NewConnSet()
+		fallback = tcp.NewMultiplexTransport(nodeKey, tcpconn.DefaultMConnConfig())
+	)
+	tcp.MultiplexTransportConnSet(conns)(fallback)
+	tr := newTransport(t, nodeKey, TransportConnSet(conns), TransportFallback(fallback))
+
+	addr, err := na.NewFromString(na.IDAddrString(nodeKey.ID(), "127.0.0.1:0"))
+	require.NoError(t, err)
+	require.NoError(t, tr.Listen(*addr))
+	t.Cleanup(func() { _ = tr.Close() })
+
+	return tr, *na.New(nodeKey.ID(), tr.listener.Addr())
+}
+
+func TestTransport_FallbackAccept(t *testing.T) {
+	tr, addr := testSetupFallbackTransport(t)
+
+	dialerKey := newNodeKey()
+	dialer := tcp.NewMultiplexTransport(dialerKey, tcpconn.DefaultMConnConfig())
+
+	dialErrc := make(chan error, 1)
+	go func() {
+		_, err := dialer.Dial(addr)
+		dialErrc <- err
+	}()
+
+	c, netAddr, err := tr.Accept()
+	require.NoError(t, err)
+	require.NoError(t, <-dialErrc)
+	defer tr.Cleanup(c) //nolint:errcheck
+
+	_, isQUIC := c.(*Conn)
+	require.False(t, isQUIC)
+	require.Equal(t, dialerKey.ID(), netAddr.ID)
+	require.True(t, tr.conns.Has(c))
+}
+
+func TestTransport_FallbackDial(t *testing.T) {
+	// Pick a free port for the TCP transport, which doesn't listen on UDP.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := ln.Addr().(*net.TCPAddr).Port
+	require.NoError(t, ln.Close())
+
+	var (
+		tcpKey       = newNodeKey()
+		tcpTransport = tcp.NewMultiplexTransport(tcpKey, tcpconn.DefaultMConnConfig())
+	)
+	addr, err := na.NewFromString(na.IDAddrString(tcpKey.ID(), fmt.Sprintf("127.0.0.1:%d", port)))
+	require.NoError(t, err)
+	require.NoError(t, tcpTransport.Listen(*addr))
+	defer tcpTransport.Close()
+
+	dialer, _ := testSetupFallbackTransport(t)
+
+	acceptErrc := make(chan error, 1)
+	go func() {
+		_, _, err := tcpTransport.Accept()
+		acceptErrc <- err
+	}()
+
+	c, err := dialer.Dial(*addr)
+	require.NoError(t, err)
+	require.NoError(t, <-acceptErrc)
+	defer dialer.Cleanup(c) //nolint:errcheck
+
+	_, isQUIC := c.(*Conn)
+	require.False(t, isQUIC)
+}
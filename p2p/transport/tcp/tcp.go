@@ -75,6 +75,12 @@ func MultiplexTransportConnFilters(
 	return func(mt *MultiplexTransport) { mt.connFilters = filters }
 }
 
+// MultiplexTransportConnSet sets the lookup table of the connections, so that
+// it can be shared with another transport.
+func MultiplexTransportConnSet(conns ConnSet) MultiplexTransportOption {
+	return func(mt *MultiplexTransport) { mt.conns = conns }
+}
+
 // MultiplexTransportFilterTimeout sets the timeout waited for filter calls to
 // return.
 func MultiplexTransportFilterTimeout(
@@ -32,3 +32,13 @@ type RemoteSignerError struct {
 func (e *RemoteSignerError) Error() string {
 	return fmt.Sprintf("signerEndpoint returned error #%d: %s", e.Code, e.Description)
 }
+
+// Slashing protection errors.
+var (
+	ErrSlashingProtectionConflict   = errors.New("conflicting data was already signed")
+	ErrSlashingProtectionRegression = errors.New("height/round/step is lower than the highest signed")
+)
+
+// ErrThresholdNotReached is returned by ThresholdSignerClient when not enough
+// remote signers returned the same response.
+var ErrThresholdNotReached = errors.New("threshold of matching signer responses not reached")
@@ -164,6 +164,8 @@ func (lss *FilePVLastSignState) Save() {
 type FilePV struct {
 	Key           FilePVKey
 	LastSignState FilePVLastSignState
+
+	slashingProtection *SlashingProtectionDB
 }
 
 // NewFilePV generates a new validator from the given key and paths.
@@ -264,6 +266,17 @@ func LoadOrGenFilePV(keyFilePath, stateFilePath string, keyGenF func() (crypto.P
 	return pv, nil
 }
 
+// SetSlashingProtectionDB makes the FilePV check every vote and proposal
+// against the signing history in spdb before signing it, and record it there.
+func (pv *FilePV) SetSlashingProtectionDB(spdb *SlashingProtectionDB) {
+	pv.slashingProtection = spdb
+}
+
+// SlashingProtectionDB returns the signing history used by the FilePV, if any.
+func (pv *FilePV) SlashingProtectionDB() *SlashingProtectionDB {
+	return pv.slashingProtection
+}
+
 // GetAddress returns the address of the validator.
 // Implements PrivValidator.
 func (pv *FilePV) GetAddress() types.Address {
@@ -280,7 +293,7 @@ func (pv *FilePV) GetPubKey() (crypto.PubKey, error) {
 // chainID. Implements PrivValidator.
 func (pv *FilePV) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
 	if err := pv.signVote(chainID, vote, signExtension); err != nil {
-		return fmt.Errorf("error signing vote: %v", err)
+		return fmt.Errorf("error signing vote: %w", err)
 	}
 	return nil
 }
@@ -289,7 +302,7 @@ func (pv *FilePV) SignVote(chainID string, vote *cmtproto.Vote, signExtension bo
 // the chainID. Implements PrivValidator.
 func (pv *FilePV) SignProposal(chainID string, proposal *cmtproto.Proposal) error {
 	if err := pv.signProposal(chainID, proposal); err != nil {
-		return fmt.Errorf("error signing proposal: %v", err)
+		return fmt.Errorf("error signing proposal: %w", err)
 	}
 	return nil
 }
@@ -381,6 +394,10 @@ func (pv *FilePV) signVote(chainID string, vote *cmtproto.Vote, signExtension bo
 		return err
 	}
 
+	if err := pv.checkSlashingProtection(height, round, step, signBytes); err != nil {
+		return err
+	}
+
 	// It passed the checks. Sign the vote
 	sig, err := pv.Key.PrivKey.Sign(signBytes)
 	if err != nil {
@@ -424,6 +441,10 @@ func (pv *FilePV) signProposal(chainID string, proposal *cmtproto.Proposal) erro
 		return err
 	}
 
+	if err := pv.checkSlashingProtection(height, round, step, signBytes); err != nil {
+		return err
+	}
+
 	// It passed the checks. Sign the proposal
 	sig, err := pv.Key.PrivKey.Sign(signBytes)
 	if err != nil {
@@ -434,6 +455,17 @@ func (pv *FilePV) signProposal(chainID string, proposal *cmtproto.Proposal) erro
 	return nil
 }
 
+// checkSlashingProtection checks the message against the signing history and
+// records it, if a SlashingProtectionDB is set. Unlike the LastSignState, only
+// the hash of the sign bytes is recorded, so a message that only differs by
+// its timestamp from a recorded one is refused.
+func (pv *FilePV) checkSlashingProtection(height int64, round int32, step int8, signBytes []byte) error {
+	if pv.slashingProtection == nil {
+		return nil
+	}
+	return pv.slashingProtection.CheckAndRecord(height, round, step, signBytes)
+}
+
 // Persist height/round/step and signature.
 func (pv *FilePV) saveSigned(height int64, round int32, step int8,
 	signBytes []byte, sig []byte,
@@ -0,0 +1,53 @@
+// Code generated by metricsgen. DO NOT EDIT.
+
+package privval
+
+import (
+	"github.com/cometbft/cometbft/libs/metrics/discard"
+	prometheus "github.com/cometbft/cometbft/libs/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		SignerResponseSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "signer_response_seconds",
+			Help:      "Time taken by each remote signer to respond to a request, in seconds.",
+
+			Buckets: []float64{.001, .005, .01, .05, .1, .5, 1, 5},
+		}, append(labels, "signer", "method")).With(labelsAndValues...),
+		SignerFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "signer_failures",
+			Help:      "Number of requests that failed or timed out, per remote signer.",
+		}, append(labels, "signer", "method")).With(labelsAndValues...),
+		SignerDisagreements: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "signer_disagreements",
+			Help:      "Number of responses that disagreed with the ones returned by the threshold signer client, per remote signer.",
+		}, append(labels, "signer", "method")).With(labelsAndValues...),
+		ThresholdFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "threshold_failures",
+			Help:      "Number of requests for which the threshold signer client couldn't get enough matching responses.",
+		}, append(labels, "method")).With(labelsAndValues...),
+	}
+}
+
+func NopMetrics() *Metrics {
+	return &Metrics{
+		SignerResponseSeconds: discard.NewHistogram(),
+		SignerFailures:        discard.NewCounter(),
+		SignerDisagreements:   discard.NewCounter(),
+		ThresholdFailures:     discard.NewCounter(),
+	}
+}
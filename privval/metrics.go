@@ -0,0 +1,30 @@
+package privval
+
+import (
+	"github.com/cometbft/cometbft/libs/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "privval"
+)
+
+//go:generate go run ../scripts/metricsgen -struct=Metrics
+
+// Metrics contains the prometheus metrics exposed by the privval package.
+type Metrics struct {
+	// Time taken by each remote signer to respond to a request, in seconds.
+	SignerResponseSeconds metrics.Histogram `metrics_bucketsizes:".001,.005,.01,.05,.1,.5,1,5" metrics_labels:"signer, method"`
+
+	// Number of requests that failed or timed out, per remote signer.
+	SignerFailures metrics.Counter `metrics_labels:"signer, method"`
+
+	// Number of responses that disagreed with the ones returned by the
+	// threshold signer client, per remote signer.
+	SignerDisagreements metrics.Counter `metrics_labels:"signer, method"`
+
+	// Number of requests for which the threshold signer client couldn't get
+	// enough matching responses.
+	ThresholdFailures metrics.Counter `metrics_labels:"method"`
+}
@@ -0,0 +1,274 @@
+package privval
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/types"
+)
+
+// SlashingProtectionInterchangeVersion is the version of the interchange
+// format produced by SlashingProtectionDB.Export.
+const SlashingProtectionInterchangeVersion = "1"
+
+var signedKeyPrefix = []byte("signed/")
+
+// SignedRecord is a vote or proposal signed by a validator, identified by its
+// height, round and step (HRS) and the hash of its sign bytes. The step is 1
+// for proposals, 2 for prevotes and 3 for precommits.
+type SignedRecord struct {
+	Height        int64             `json:"height"`
+	Round         int32             `json:"round"`
+	Step          int8              `json:"step"`
+	SignBytesHash cmtbytes.HexBytes `json:"sign_bytes_hash"`
+}
+
+func (r SignedRecord) String() string {
+	return fmt.Sprintf("%d/%d/%d", r.Height, r.Round, r.Step)
+}
+
+// compareHRS returns -1, 0 or 1 if the HRS of r is lower, equal or higher
+// than the one of other.
+func (r SignedRecord) compareHRS(other SignedRecord) int {
+	return bytes.Compare(signedKey(r.Height, r.Round, r.Step), signedKey(other.Height, other.Round, other.Step))
+}
+
+// SlashingProtectionMetadata identifies an interchange file.
+type SlashingProtectionMetadata struct {
+	InterchangeFormatVersion string        `json:"interchange_format_version"`
+	Address                  types.Address `json:"address"`
+}
+
+// SlashingProtectionInterchange is the signing history of a validator, as
+// exported from and imported into a SlashingProtectionDB, e.g. to move a
+// validator to a new machine.
+type SlashingProtectionInterchange struct {
+	Metadata SlashingProtectionMetadata `json:"metadata"`
+	Signed   []SignedRecord             `json:"signed"`
+}
+
+// SlashingProtectionDB records every vote and proposal signed by a validator.
+// Unlike FilePVLastSignState, which only remembers the last signature, it
+// keeps the whole signing history, so that a double sign is detected even
+// after the history is imported on another machine.
+//
+// A message is refused if a different message was signed at the same HRS, or
+// if its HRS is lower than the highest one recorded.
+type SlashingProtectionDB struct {
+	mtx sync.Mutex
+	db  dbm.DB
+}
+
+// NewSlashingProtectionDB returns a SlashingProtectionDB stored in db.
+func NewSlashingProtectionDB(db dbm.DB) *SlashingProtectionDB {
+	return &SlashingProtectionDB{db: db}
+}
+
+// CheckAndRecord checks that the message with the given HRS and sign bytes
+// can be signed, and records it before it's signed. Signing the same message
+// again is allowed.
+func (spdb *SlashingProtectionDB) CheckAndRecord(height int64, round int32, step int8, signBytes []byte) error {
+	spdb.mtx.Lock()
+	defer spdb.mtx.Unlock()
+
+	rec := SignedRecord{
+		Height:        height,
+		Round:         round,
+		Step:          step,
+		SignBytesHash: tmhash.Sum(signBytes),
+	}
+
+	existing, err := spdb.get(height, round, step)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		if !bytes.Equal(existing.SignBytesHash, rec.SignBytesHash) {
+			return fmt.Errorf("%w: at %v", ErrSlashingProtectionConflict, rec)
+		}
+		return nil
+	}
+
+	latest, err := spdb.Latest()
+	if err != nil {
+		return err
+	}
+	if latest != nil && rec.compareHRS(*latest) < 0 {
+		return fmt.Errorf("%w: got %v, highest signed %v", ErrSlashingProtectionRegression, rec, latest)
+	}
+
+	return spdb.db.SetSync(signedKey(height, round, step), rec.SignBytesHash)
+}
+
+// Latest returns the record with the highest HRS, or nil if nothing was
+// signed.
+func (spdb *SlashingProtectionDB) Latest() (*SignedRecord, error) {
+	it, err := spdb.db.ReverseIterator(signedKeyPrefix, prefixEnd(signedKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil, it.Error()
+	}
+	rec, err := decodeSignedRecord(it.Key(), it.Value())
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+// Export returns the signing history of the validator with the given address,
+// ordered by HRS.
+func (spdb *SlashingProtectionDB) Export(address types.Address) (*SlashingProtectionInterchange, error) {
+	spdb.mtx.Lock()
+	defer spdb.mtx.Unlock()
+
+	it, err := spdb.db.Iterator(signedKeyPrefix, prefixEnd(signedKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	interchange := &SlashingProtectionInterchange{
+		Metadata: SlashingProtectionMetadata{
+			InterchangeFormatVersion: SlashingProtectionInterchangeVersion,
+			Address:                  address,
+		},
+		Signed: []SignedRecord{},
+	}
+	for ; it.Valid(); it.Next() {
+		rec, err := decodeSignedRecord(it.Key(), it.Value())
+		if err != nil {
+			return nil, err
+		}
+		interchange.Signed = append(interchange.Signed, rec)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return interchange, nil
+}
+
+// Import merges the signing history in interchange into the database. It
+// fails without modifying the database if the history belongs to a validator
+// other than address, or if it conflicts with the recorded one.
+func (spdb *SlashingProtectionDB) Import(interchange *SlashingProtectionInterchange, address types.Address) error {
+	spdb.mtx.Lock()
+	defer spdb.mtx.Unlock()
+
+	if v := interchange.Metadata.InterchangeFormatVersion; v != SlashingProtectionInterchangeVersion {
+		return fmt.Errorf("unsupported interchange format version %q, expected %q", v, SlashingProtectionInterchangeVersion)
+	}
+	if !bytes.Equal(interchange.Metadata.Address, address) {
+		return fmt.Errorf("interchange is for validator %v, expected %v", interchange.Metadata.Address, address)
+	}
+
+	batch := spdb.db.NewBatch()
+	defer batch.Close()
+
+	for _, rec := range interchange.Signed {
+		if err := rec.validateBasic(); err != nil {
+			return fmt.Errorf("invalid record %v: %w", rec, err)
+		}
+		existing, err := spdb.get(rec.Height, rec.Round, rec.Step)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			if !bytes.Equal(existing.SignBytesHash, rec.SignBytesHash) {
+				return fmt.Errorf("%w: at %v", ErrSlashingProtectionConflict, rec)
+			}
+			continue
+		}
+		if err := batch.Set(signedKey(rec.Height, rec.Round, rec.Step), rec.SignBytesHash); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
+// ExportJSON returns the signing history of the validator with the given
+// address in the JSON interchange format.
+func (spdb *SlashingProtectionDB) ExportJSON(address types.Address) ([]byte, error) {
+	interchange, err := spdb.Export(address)
+	if err != nil {
+		return nil, err
+	}
+	return cmtjson.MarshalIndent(interchange, "", "  ")
+}
+
+// ImportJSON imports a signing history in the JSON interchange format.
+// See Import.
+func (spdb *SlashingProtectionDB) ImportJSON(bz []byte, address types.Address) error {
+	var interchange SlashingProtectionInterchange
+	if err := cmtjson.Unmarshal(bz, &interchange); err != nil {
+		return fmt.Errorf("failed to unmarshal interchange: %w", err)
+	}
+	return spdb.Import(&interchange, address)
+}
+
+// Close closes the underlying database.
+func (spdb *SlashingProtectionDB) Close() error {
+	return spdb.db.Close()
+}
+
+func (spdb *SlashingProtectionDB) get(height int64, round int32, step int8) (*SignedRecord, error) {
+	bz, err := spdb.db.Get(signedKey(height, round, step))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+	return &SignedRecord{Height: height, Round: round, Step: step, SignBytesHash: bz}, nil
+}
+
+func (r SignedRecord) validateBasic() error {
+	switch {
+	case r.Height < 0:
+		return fmt.Errorf("negative height %d", r.Height)
+	case r.Round < 0:
+		return fmt.Errorf("negative round %d", r.Round)
+	case r.Step < stepPropose || r.Step > stepPrecommit:
+		return fmt.Errorf("unknown step %d", r.Step)
+	case len(r.SignBytesHash) != tmhash.Size:
+		return fmt.Errorf("expected sign bytes hash of %d bytes, got %d", tmhash.Size, len(r.SignBytesHash))
+	}
+	return nil
+}
+
+// signedKey returns the key of a record. Heights and rounds are never
+// negative, so the keys are ordered by HRS.
+func signedKey(height int64, round int32, step int8) []byte {
+	key := make([]byte, 0, len(signedKeyPrefix)+8+4+1)
+	key = append(key, signedKeyPrefix...)
+	key = binary.BigEndian.AppendUint64(key, uint64(height))
+	key = binary.BigEndian.AppendUint32(key, uint32(round))
+	return append(key, byte(step))
+}
+
+func decodeSignedRecord(key, value []byte) (SignedRecord, error) {
+	hrs := key[len(signedKeyPrefix):]
+	if len(hrs) != 8+4+1 {
+		return SignedRecord{}, fmt.Errorf("invalid slashing protection key %X", key)
+	}
+	return SignedRecord{
+		Height:        int64(binary.BigEndian.Uint64(hrs[:8])),
+		Round:         int32(binary.BigEndian.Uint32(hrs[8:12])),
+		Step:          int8(hrs[12]),
+		SignBytesHash: append([]byte(nil), value...),
+	}, nil
+}
+
+// prefixEnd returns the end of the range of keys starting with prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte(nil), prefix...)
+	end[len(end)-1]++
+	return end
+}
@@ -0,0 +1,134 @@
+package privval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestSlashingProtectionDBCheckAndRecord(t *testing.T) {
+	spdb := NewSlashingProtectionDB(dbm.NewMemDB())
+
+	latest, err := spdb.Latest()
+	require.NoError(t, err)
+	assert.Nil(t, latest)
+
+	require.NoError(t, spdb.CheckAndRecord(10, 1, stepPrevote, []byte("a")))
+	// Signing the same message again is allowed.
+	require.NoError(t, spdb.CheckAndRecord(10, 1, stepPrevote, []byte("a")))
+	require.NoError(t, spdb.CheckAndRecord(10, 1, stepPrecommit, []byte("b")))
+	require.NoError(t, spdb.CheckAndRecord(10, 2, stepPropose, []byte("c")))
+
+	err = spdb.CheckAndRecord(10, 1, stepPrevote, []byte("d"))
+	require.ErrorIs(t, err, ErrSlashingProtectionConflict)
+	err = spdb.CheckAndRecord(10, 1, stepPropose, []byte("e"))
+	require.ErrorIs(t, err, ErrSlashingProtectionRegression)
+	err = spdb.CheckAndRecord(9, 5, stepPrecommit, []byte("f"))
+	require.ErrorIs(t, err, ErrSlashingProtectionRegression)
+
+	latest, err = spdb.Latest()
+	require.NoError(t, err)
+	assert.Equal(t, SignedRecord{Height: 10, Round: 2, Step: stepPropose, SignBytesHash: tmhash.Sum([]byte("c"))}, *latest)
+}
+
+func TestSlashingProtectionDBExportImport(t *testing.T) {
+	address := types.Address(cmtrand.Bytes(20))
+	spdb := NewSlashingProtectionDB(dbm.NewMemDB())
+	require.NoError(t, spdb.CheckAndRecord(1, 0, stepPropose, []byte("a")))
+	require.NoError(t, spdb.CheckAndRecord(1, 0, stepPrevote, []byte("b")))
+	require.NoError(t, spdb.CheckAndRecord(2, 3, stepPrecommit, []byte("c")))
+
+	bz, err := spdb.ExportJSON(address)
+	require.NoError(t, err)
+
+	// Import on a new machine, where the history is enforced.
+	newDB := NewSlashingProtectionDB(dbm.NewMemDB())
+	require.Error(t, newDB.ImportJSON(bz, types.Address(cmtrand.Bytes(20))))
+	require.NoError(t, newDB.ImportJSON(bz, address))
+	// Importing twice is a no-op.
+	require.NoError(t, newDB.ImportJSON(bz, address))
+
+	exported, err := newDB.Export(address)
+	require.NoError(t, err)
+	expected, err := spdb.Export(address)
+	require.NoError(t, err)
+	assert.Equal(t, expected, exported)
+	assert.Len(t, exported.Signed, 3)
+
+	err = newDB.CheckAndRecord(2, 3, stepPrecommit, []byte("d"))
+	require.ErrorIs(t, err, ErrSlashingProtectionConflict)
+	err = newDB.CheckAndRecord(2, 0, stepPrecommit, []byte("d"))
+	require.ErrorIs(t, err, ErrSlashingProtectionRegression)
+
+	// Conflicting histories are not merged.
+	conflicting := NewSlashingProtectionDB(dbm.NewMemDB())
+	require.NoError(t, conflicting.CheckAndRecord(1, 0, stepPrevote, []byte("x")))
+	require.NoError(t, conflicting.CheckAndRecord(1, 1, stepPrevote, []byte("y")))
+	err = conflicting.ImportJSON(bz, address)
+	require.ErrorIs(t, err, ErrSlashingProtectionConflict)
+	exported, err = conflicting.Export(address)
+	require.NoError(t, err)
+	assert.Len(t, exported.Signed, 2)
+
+	// Invalid records and versions are rejected.
+	interchange := &SlashingProtectionInterchange{
+		Metadata: SlashingProtectionMetadata{InterchangeFormatVersion: "0", Address: address},
+	}
+	require.Error(t, newDB.Import(interchange, address))
+	interchange.Metadata.InterchangeFormatVersion = SlashingProtectionInterchangeVersion
+	interchange.Signed = []SignedRecord{{Height: 5, Round: 0, Step: 7, SignBytesHash: tmhash.Sum(nil)}}
+	require.Error(t, newDB.Import(interchange, address))
+}
+
+func TestFilePVSlashingProtection(t *testing.T) {
+	chainID := "mychainid"
+	privVal, _, _ := newTestFilePV(t, nil)
+	spdb := NewSlashingProtectionDB(dbm.NewMemDB())
+	privVal.SetSlashingProtectionDB(spdb)
+
+	block1 := types.BlockID{Hash: cmtrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{Total: 5, Hash: cmtrand.Bytes(tmhash.Size)}}
+	block2 := types.BlockID{Hash: cmtrand.Bytes(tmhash.Size), PartSetHeader: types.PartSetHeader{Total: 5, Hash: cmtrand.Bytes(tmhash.Size)}}
+	height, round := int64(10), int32(1)
+
+	proposal := newProposal(height, round, block1).ToProto()
+	require.NoError(t, privVal.SignProposal(chainID, proposal))
+	vote := newVote(privVal.Key.Address, height, round, types.PrevoteType, block1)
+	require.NoError(t, privVal.SignVote(chainID, vote.ToProto(), false))
+
+	// A re-sign that only differs by timestamp is still served from the
+	// last sign state.
+	vote.Timestamp = vote.Timestamp.Add(time.Second)
+	require.NoError(t, privVal.SignVote(chainID, vote.ToProto(), false))
+
+	// Simulate moving the key to a new machine with an empty last sign
+	// state, but with the signing history.
+	address := privVal.GetAddress()
+	bz, err := spdb.ExportJSON(address)
+	require.NoError(t, err)
+	newSPDB := NewSlashingProtectionDB(dbm.NewMemDB())
+	require.NoError(t, newSPDB.ImportJSON(bz, address))
+	privVal.LastSignState.reset()
+	privVal.SetSlashingProtectionDB(newSPDB)
+
+	err = privVal.SignVote(chainID, newVote(address, height, round, types.PrevoteType, block2).ToProto(), false)
+	require.ErrorIs(t, err, ErrSlashingProtectionConflict)
+	err = privVal.SignProposal(chainID, newProposal(height, round, block2).ToProto())
+	require.ErrorIs(t, err, ErrSlashingProtectionConflict)
+	err = privVal.SignProposal(chainID, newProposal(height, round-1, block2).ToProto())
+	require.ErrorIs(t, err, ErrSlashingProtectionRegression)
+
+	// Signing the same proposal again is fine.
+	proposal.Signature = nil
+	require.NoError(t, privVal.SignProposal(chainID, proposal))
+	require.NotEmpty(t, proposal.Signature)
+
+	require.NoError(t, privVal.SignVote(chainID, newVote(address, height, round, types.PrecommitType, block1).ToProto(), false))
+}
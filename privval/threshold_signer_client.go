@@ -0,0 +1,288 @@
+package privval
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/types"
+)
+
+const defaultThresholdSignerTimeout = 2 * time.Second
+
+// ThresholdSignerClientOption sets an optional parameter on the
+// ThresholdSignerClient.
+type ThresholdSignerClientOption func(*ThresholdSignerClient)
+
+// ThresholdSignerClientTimeout sets the time each remote signer has to respond
+// to a request before it's considered failed.
+//
+// Default: 2s.
+func ThresholdSignerClientTimeout(timeout time.Duration) ThresholdSignerClientOption {
+	return func(tc *ThresholdSignerClient) { tc.timeout = timeout }
+}
+
+// ThresholdSignerClientMetrics sets the metrics reported by the
+// ThresholdSignerClient.
+func ThresholdSignerClientMetrics(metrics *Metrics) ThresholdSignerClientOption {
+	return func(tc *ThresholdSignerClient) { tc.metrics = metrics }
+}
+
+// ThresholdSignerClient implements PrivValidator on top of n remote signers
+// holding the same key. Every request is sent to all the signers, and a
+// response is only returned once threshold of them returned the same one. A
+// signer that fails, times out or disagrees with the others is ignored, so up
+// to n-threshold signers can be unavailable, and a single misconfigured or
+// lagging signer can't make the validator sign something the others wouldn't.
+//
+// This doesn't protect against a compromised signer: every signer holds the
+// full key, so it can sign anything on its own, without going through the
+// ThresholdSignerClient.
+//
+// Signers are identified in metrics and errors by their index in the list
+// given to NewThresholdSignerClient.
+type ThresholdSignerClient struct {
+	signers   []*SignerClient
+	threshold int
+	timeout   time.Duration
+	metrics   *Metrics
+}
+
+var _ types.PrivValidator = (*ThresholdSignerClient)(nil)
+
+// NewThresholdSignerClient returns a ThresholdSignerClient requiring
+// threshold of the given signers to agree on every response.
+func NewThresholdSignerClient(
+	signers []*SignerClient,
+	threshold int,
+	options ...ThresholdSignerClientOption,
+) (*ThresholdSignerClient, error) {
+	if len(signers) == 0 {
+		return nil, errors.New("no remote signers")
+	}
+	if threshold <= 0 || threshold > len(signers) {
+		return nil, fmt.Errorf("threshold must be between 1 and %d, got %d", len(signers), threshold)
+	}
+
+	tc := &ThresholdSignerClient{
+		signers:   signers,
+		threshold: threshold,
+		timeout:   defaultThresholdSignerTimeout,
+		metrics:   NopMetrics(),
+	}
+	for _, optionFunc := range options {
+		optionFunc(tc)
+	}
+	return tc, nil
+}
+
+// Close closes the connections to all the signers.
+func (tc *ThresholdSignerClient) Close() error {
+	var errs []error
+	for _, sc := range tc.signers {
+		if err := sc.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// IsConnected indicates whether at least threshold signers are connected.
+func (tc *ThresholdSignerClient) IsConnected() bool {
+	connected := 0
+	for _, sc := range tc.signers {
+		if sc.IsConnected() {
+			connected++
+		}
+	}
+	return connected >= tc.threshold
+}
+
+// WaitForConnection waits maxWait for threshold signers to be connected, or
+// returns an error.
+func (tc *ThresholdSignerClient) WaitForConnection(maxWait time.Duration) error {
+	errCh := make(chan error, len(tc.signers))
+	for _, sc := range tc.signers {
+		go func(sc *SignerClient) {
+			errCh <- sc.WaitForConnection(maxWait)
+		}(sc)
+	}
+
+	connected := 0
+	var errs []error
+	for range tc.signers {
+		err := <-errCh
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		connected++
+		if connected >= tc.threshold {
+			return nil
+		}
+	}
+	return fmt.Errorf("only %d of %d required signers connected: %w", connected, tc.threshold, errors.Join(errs...))
+}
+
+// --------------------------------------------------------
+// Implement PrivValidator
+
+// Ping sends a ping request to all the signers. It succeeds if threshold of
+// them respond.
+func (tc *ThresholdSignerClient) Ping() error {
+	_, err := tc.request("ping", func(sc *SignerClient) (any, []byte, error) {
+		return nil, nil, sc.Ping()
+	})
+	return err
+}
+
+// GetPubKey retrieves the public key from the signers.
+func (tc *ThresholdSignerClient) GetPubKey() (crypto.PubKey, error) {
+	res, err := tc.request("pub_key", func(sc *SignerClient) (any, []byte, error) {
+		pk, err := sc.GetPubKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		return pk, append([]byte(pk.Type()), pk.Bytes()...), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(crypto.PubKey), nil
+}
+
+// SignVote requests the signers to sign a vote.
+func (tc *ThresholdSignerClient) SignVote(chainID string, vote *cmtproto.Vote, signExtension bool) error {
+	// Late responses may still be read after *vote is updated, so each signer
+	// gets a copy of the original.
+	req := *vote
+	res, err := tc.request("vote", func(sc *SignerClient) (any, []byte, error) {
+		v := req
+		if err := sc.SignVote(chainID, &v, signExtension); err != nil {
+			return nil, nil, err
+		}
+		bz, err := v.Marshal()
+		return v, bz, err
+	})
+	if err != nil {
+		return err
+	}
+	*vote = res.(cmtproto.Vote)
+	return nil
+}
+
+// SignProposal requests the signers to sign a proposal.
+func (tc *ThresholdSignerClient) SignProposal(chainID string, proposal *cmtproto.Proposal) error {
+	req := *proposal
+	res, err := tc.request("proposal", func(sc *SignerClient) (any, []byte, error) {
+		p := req
+		if err := sc.SignProposal(chainID, &p); err != nil {
+			return nil, nil, err
+		}
+		bz, err := p.Marshal()
+		return p, bz, err
+	})
+	if err != nil {
+		return err
+	}
+	*proposal = res.(cmtproto.Proposal)
+	return nil
+}
+
+// SignBytes requests the signers to sign bytes.
+func (tc *ThresholdSignerClient) SignBytes(bytes []byte) ([]byte, error) {
+	res, err := tc.request("bytes", func(sc *SignerClient) (any, []byte, error) {
+		sig, err := sc.SignBytes(bytes)
+		return sig, sig, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]byte), nil
+}
+
+// --------------------------------------------------------
+
+type signerResponse struct {
+	signer   int
+	value    any
+	key      []byte
+	err      error
+	duration time.Duration
+}
+
+// request sends a request to all the signers in parallel, and returns the
+// first value for which threshold signers returned the same key. It fails as
+// soon as no key can reach the threshold anymore, counting the signers that
+// didn't respond within the timeout as failed.
+func (tc *ThresholdSignerClient) request(
+	method string,
+	fn func(sc *SignerClient) (value any, key []byte, err error),
+) (any, error) {
+	// The channel is buffered so that late responses don't block.
+	respCh := make(chan signerResponse, len(tc.signers))
+	for i, sc := range tc.signers {
+		go func(i int, sc *SignerClient) {
+			start := time.Now()
+			value, key, err := fn(sc)
+			respCh <- signerResponse{signer: i, value: value, key: key, err: err, duration: time.Since(start)}
+		}(i, sc)
+	}
+
+	timer := time.NewTimer(tc.timeout)
+	defer timer.Stop()
+
+	var (
+		responses []signerResponse
+		counts    = make(map[string]int)
+		maxCount  int
+		responded = make([]bool, len(tc.signers))
+		errs      []error
+	)
+	for pending := len(tc.signers); pending > 0 && maxCount+pending >= tc.threshold; {
+		select {
+		case resp := <-respCh:
+			pending--
+			responded[resp.signer] = true
+			signer := strconv.Itoa(resp.signer)
+			if resp.err != nil {
+				tc.metrics.SignerFailures.With("signer", signer, "method", method).Add(1)
+				errs = append(errs, fmt.Errorf("signer %d: %w", resp.signer, resp.err))
+				continue
+			}
+			tc.metrics.SignerResponseSeconds.With("signer", signer, "method", method).Observe(resp.duration.Seconds())
+
+			responses = append(responses, resp)
+			counts[string(resp.key)]++
+			maxCount = max(maxCount, counts[string(resp.key)])
+			if counts[string(resp.key)] >= tc.threshold {
+				tc.countDisagreements(method, resp.key, responses)
+				return resp.value, nil
+			}
+
+		case <-timer.C:
+			for i, ok := range responded {
+				if !ok {
+					tc.metrics.SignerFailures.With("signer", strconv.Itoa(i), "method", method).Add(1)
+					errs = append(errs, fmt.Errorf("signer %d: timed out after %v", i, tc.timeout))
+				}
+			}
+			pending = 0
+		}
+	}
+
+	tc.metrics.ThresholdFailures.With("method", method).Add(1)
+	return nil, fmt.Errorf("%w: %d of %d required signers agreed: %w",
+		ErrThresholdNotReached, maxCount, tc.threshold, errors.Join(errs...))
+}
+
+func (tc *ThresholdSignerClient) countDisagreements(method string, key []byte, responses []signerResponse) {
+	for _, resp := range responses {
+		if string(resp.key) != string(key) {
+			tc.metrics.SignerDisagreements.With("signer", strconv.Itoa(resp.signer), "method", method).Add(1)
+		}
+	}
+}
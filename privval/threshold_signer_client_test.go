@@ -0,0 +1,164 @@
+package privval
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtrand "github.com/cometbft/cometbft/internal/rand"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/types"
+)
+
+// newFilePVSigner returns a client connected to a SignerServer backed by a
+// FilePV with the given key.
+func newFilePVSigner(t *testing.T, chainID string, privKey crypto.PrivKey) *SignerClient {
+	t.Helper()
+	dir := t.TempDir()
+	pv := NewFilePV(privKey, filepath.Join(dir, "key.json"), filepath.Join(dir, "state.json"))
+
+	addr := GetFreeLocalhostAddrPort()
+	sl, sd := getMockEndpoints(t, addr, DialTCPFn(addr, testTimeoutReadWrite, ed25519.GenPrivKey()))
+	sc, err := NewSignerClient(sl, chainID)
+	require.NoError(t, err)
+	ss := NewSignerServer(sd, chainID, pv)
+	require.NoError(t, ss.Start())
+
+	t.Cleanup(func() {
+		_ = ss.Stop()
+		_ = sc.Close()
+	})
+	return sc
+}
+
+// newUnavailableSigner returns a client for a signer that never connects.
+func newUnavailableSigner(t *testing.T, chainID string) *SignerClient {
+	t.Helper()
+	sl := newSignerListenerEndpoint(log.TestingLogger(), GetFreeLocalhostAddrPort(), testTimeoutReadWrite)
+	sc, err := NewSignerClient(sl, chainID)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sc.Close() })
+	return sc
+}
+
+func newThresholdTestVote(addr types.Address, height int64, typ types.SignedMsgType) *types.Vote {
+	blockID := types.BlockID{
+		Hash:          cmtrand.Bytes(tmhash.Size),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: cmtrand.Bytes(tmhash.Size)},
+	}
+	return newVote(addr, height, 0, typ, blockID)
+}
+
+func TestThresholdSignerClient(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	privKey := ed25519.GenPrivKey()
+	signers := []*SignerClient{
+		newFilePVSigner(t, chainID, privKey),
+		newFilePVSigner(t, chainID, privKey),
+		newFilePVSigner(t, chainID, privKey),
+	}
+
+	_, err := NewThresholdSignerClient(signers, 4)
+	require.Error(t, err)
+	_, err = NewThresholdSignerClient(nil, 1)
+	require.Error(t, err)
+
+	tc, err := NewThresholdSignerClient(signers, 3)
+	require.NoError(t, err)
+	require.NoError(t, tc.WaitForConnection(time.Second))
+	assert.True(t, tc.IsConnected())
+	require.NoError(t, tc.Ping())
+
+	pubKey, err := tc.GetPubKey()
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
+
+	vote := newThresholdTestVote(pubKey.Address(), 1, types.PrecommitType)
+	proposal := newProposal(1, 0, vote.BlockID).ToProto()
+	require.NoError(t, tc.SignProposal(chainID, proposal))
+	assert.True(t, pubKey.VerifySignature(types.ProposalSignBytes(chainID, proposal), proposal.Signature))
+
+	v := vote.ToProto()
+	require.NoError(t, tc.SignVote(chainID, v, true))
+	assert.True(t, pubKey.VerifySignature(types.VoteSignBytes(chainID, v), v.Signature))
+	assert.True(t, pubKey.VerifySignature(types.VoteExtensionSignBytes(chainID, v), v.ExtensionSignature))
+
+	sig, err := tc.SignBytes([]byte("bytes"))
+	require.NoError(t, err)
+	assert.True(t, pubKey.VerifySignature([]byte("bytes"), sig))
+
+	// The FilePVs refuse to sign a conflicting vote.
+	conflicting := newThresholdTestVote(pubKey.Address(), 1, types.PrecommitType).ToProto()
+	err = tc.SignVote(chainID, conflicting, true)
+	require.ErrorIs(t, err, ErrThresholdNotReached)
+	assert.Empty(t, conflicting.Signature)
+}
+
+func TestThresholdSignerClientFailover(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	privKey := ed25519.GenPrivKey()
+	signers := []*SignerClient{
+		newFilePVSigner(t, chainID, privKey),
+		newUnavailableSigner(t, chainID),
+		newFilePVSigner(t, chainID, privKey),
+	}
+
+	tc, err := NewThresholdSignerClient(signers, 2, ThresholdSignerClientTimeout(500*time.Millisecond))
+	require.NoError(t, err)
+	require.NoError(t, tc.WaitForConnection(time.Second))
+
+	require.NoError(t, tc.Ping())
+
+	pubKey, err := tc.GetPubKey()
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 3; height++ {
+		v := newThresholdTestVote(pubKey.Address(), height, types.PrevoteType).ToProto()
+		require.NoError(t, tc.SignVote(chainID, v, false), "height %d", height)
+		assert.True(t, pubKey.VerifySignature(types.VoteSignBytes(chainID, v), v.Signature))
+	}
+
+	// All the signers are needed, but one of them is unavailable.
+	tc, err = NewThresholdSignerClient(signers, 3, ThresholdSignerClientTimeout(200*time.Millisecond))
+	require.NoError(t, err)
+	assert.False(t, tc.IsConnected())
+	require.ErrorIs(t, tc.Ping(), ErrThresholdNotReached)
+	v := newThresholdTestVote(pubKey.Address(), 4, types.PrevoteType).ToProto()
+	err = tc.SignVote(chainID, v, false)
+	require.ErrorIs(t, err, ErrThresholdNotReached)
+	assert.Empty(t, v.Signature)
+}
+
+func TestThresholdSignerClientDisagreement(t *testing.T) {
+	chainID := cmtrand.Str(12)
+	privKey := ed25519.GenPrivKey()
+	signers := []*SignerClient{
+		newFilePVSigner(t, chainID, privKey),
+		newFilePVSigner(t, chainID, ed25519.GenPrivKey()),
+		newFilePVSigner(t, chainID, privKey),
+	}
+
+	for threshold, ok := range map[int]bool{1: true, 2: true, 3: false} {
+		t.Run(strconv.Itoa(threshold), func(t *testing.T) {
+			tc, err := NewThresholdSignerClient(signers, threshold)
+			require.NoError(t, err)
+
+			sig, err := tc.SignBytes([]byte("bytes" + strconv.Itoa(threshold)))
+			if !ok {
+				require.ErrorIs(t, err, ErrThresholdNotReached)
+				return
+			}
+			require.NoError(t, err)
+			if threshold > 1 {
+				assert.True(t, privKey.PubKey().VerifySignature([]byte("bytes"+strconv.Itoa(threshold)), sig))
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package proxy
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -41,3 +42,30 @@ func (e ErrABCIClientStart) Error() string {
 func (e ErrABCIClientStart) Unwrap() error {
 	return e.Err
 }
+
+// ErrSimulateTxEmptyResult is returned when the application returns an empty
+// result when simulating a tx, which likely means that it doesn't support
+// simulation.
+var ErrSimulateTxEmptyResult = errors.New("application returned an empty simulation result; it may not support tx simulation")
+
+type ErrSimulateTx struct {
+	Code      uint32
+	Codespace string
+	Log       string
+}
+
+func (e ErrSimulateTx) Error() string {
+	return fmt.Sprintf("application failed to simulate tx (code %d, codespace %q): %s", e.Code, e.Codespace, e.Log)
+}
+
+type ErrSimulateTxInvalidResult struct {
+	Err error
+}
+
+func (e ErrSimulateTxInvalidResult) Error() string {
+	return fmt.Sprintf("invalid tx simulation result returned by the application: %v", e.Err)
+}
+
+func (e ErrSimulateTxInvalidResult) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,35 @@
+package proxy
+
+import (
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// SimulateTx asks the application to execute tx against a throwaway copy of its
+// state, using the Query convention described in abci.SimulateTxQueryPath, and
+// returns the result. The tx is neither added to the mempool nor gossiped.
+func SimulateTx(ctx context.Context, app AppConnQuery, tx []byte) (*abci.ExecTxResult, error) {
+	res, err := app.Query(ctx, &abci.QueryRequest{
+		Path: abci.SimulateTxQueryPath,
+		Data: tx,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != abci.CodeTypeOK {
+		return nil, ErrSimulateTx{Code: res.Code, Codespace: res.Codespace, Log: res.Log}
+	}
+	// An empty result would mean that the tx succeeded without using any gas,
+	// which is more likely to come from an app that doesn't support
+	// simulation and ignores the query path.
+	if len(res.Value) == 0 {
+		return nil, ErrSimulateTxEmptyResult
+	}
+
+	result := &abci.ExecTxResult{}
+	if err := result.Unmarshal(res.Value); err != nil {
+		return nil, ErrSimulateTxInvalidResult{Err: err}
+	}
+	return result, nil
+}
@@ -0,0 +1,39 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+func TestSimulateTx(t *testing.T) {
+	app := kvstore.NewInMemoryApplication()
+	queryConn := NewAppConnQuery(abcicli.NewLocalClient(nil, app), NopMetrics())
+
+	res, err := SimulateTx(context.Background(), queryConn, []byte("key=value"))
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, res.Code)
+	require.NotEmpty(t, res.Events)
+
+	// The state of the application is left unchanged.
+	info, err := app.Info(context.Background(), &abci.InfoRequest{})
+	require.NoError(t, err)
+	require.Zero(t, info.LastBlockHeight)
+	qres, err := app.Query(context.Background(), &abci.QueryRequest{Data: []byte("key")})
+	require.NoError(t, err)
+	require.Nil(t, qres.Value)
+
+	// Txs that would fail are reported in the result.
+	res, err = SimulateTx(context.Background(), queryConn, []byte("key=value=more"))
+	require.NoError(t, err)
+	require.NotEqual(t, abci.CodeTypeOK, res.Code)
+
+	// Apps that ignore the query path are detected.
+	_, err = SimulateTx(context.Background(), NewAppConnQuery(abcicli.NewLocalClient(nil, abci.NewBaseApplication()), NopMetrics()), []byte("tx"))
+	require.ErrorIs(t, err, ErrSimulateTxEmptyResult)
+}
@@ -136,6 +136,15 @@ func TestBroadcastEvidence_DuplicateVoteEvidence(t *testing.T) {
 		err = client.WaitForHeight(c, status.SyncInfo.LatestBlockHeight+2, nil)
 		require.NoError(t, err)
 
+		committed, err := c.CommittedEvidence(context.Background(), correct.Hash())
+		require.NoError(t, err)
+		require.True(t, committed.Committed, "evidence %X was not committed", correct.Hash())
+		require.Greater(t, committed.Height, evidenceHeight)
+
+		search, err := c.EvidenceSearch(context.Background(), pv.Key.Address, &evidenceHeight, nil, nil, nil)
+		require.NoError(t, err)
+		require.Contains(t, search.Evidence, types.Evidence(correct))
+
 		ed25519pub := pv.Key.PubKey.(ed25519.PubKey)
 		rawpub := ed25519pub.Bytes()
 		result2, err := c.ABCIQuery(context.Background(), "/val", rawpub)
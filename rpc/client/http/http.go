@@ -333,6 +333,15 @@ func (c *baseRPCClient) CheckTx(ctx context.Context, tx types.Tx) (*ctypes.Resul
 	return result, nil
 }
 
+func (c *baseRPCClient) SimulateTx(ctx context.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error) {
+	result := new(ctypes.ResultSimulateTx)
+	_, err := c.caller.Call(ctx, "simulate_tx", map[string]any{"tx": tx}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error) {
 	result := new(ctypes.ResultNetInfo)
 	_, err := c.caller.Call(ctx, "net_info", map[string]any{}, result)
@@ -360,6 +369,31 @@ func (c *baseRPCClient) ConsensusState(ctx context.Context) (*ctypes.ResultConse
 	return result, nil
 }
 
+func (c *baseRPCClient) ConsensusTrace(
+	ctx context.Context,
+	height *int64,
+) (*ctypes.ResultConsensusTrace, error) {
+	result := new(ctypes.ResultConsensusTrace)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "consensus_trace", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) StateSyncStatus(ctx context.Context) (*ctypes.ResultStateSyncStatus, error) {
+	result := new(ctypes.ResultStateSyncStatus)
+	_, err := c.caller.Call(ctx, "state_sync_status", map[string]any{}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) ConsensusParams(
 	ctx context.Context,
 	height *int64,
@@ -603,6 +637,65 @@ func (c *baseRPCClient) BroadcastEvidence(
 	return result, nil
 }
 
+func (c *baseRPCClient) PendingEvidence(
+	ctx context.Context,
+	limit *int,
+) (*ctypes.ResultPendingEvidence, error) {
+	result := new(ctypes.ResultPendingEvidence)
+	params := make(map[string]any)
+	if limit != nil {
+		params["limit"] = limit
+	}
+	_, err := c.caller.Call(ctx, "pending_evidence", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) CommittedEvidence(
+	ctx context.Context,
+	hash []byte,
+) (*ctypes.ResultCommittedEvidence, error) {
+	result := new(ctypes.ResultCommittedEvidence)
+	_, err := c.caller.Call(ctx, "committed_evidence", map[string]any{"hash": hash}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) EvidenceSearch(
+	ctx context.Context,
+	validator []byte,
+	minHeight,
+	maxHeight *int64,
+	page,
+	perPage *int,
+) (*ctypes.ResultEvidenceSearch, error) {
+	result := new(ctypes.ResultEvidenceSearch)
+	params := map[string]any{
+		"validator": validator,
+	}
+	if minHeight != nil {
+		params["min_height"] = minHeight
+	}
+	if maxHeight != nil {
+		params["max_height"] = maxHeight
+	}
+	if page != nil {
+		params["page"] = page
+	}
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+	_, err := c.caller.Call(ctx, "evidence_search", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // -----------------------------------------------------------------------------
 // WSEvents
 
@@ -111,7 +111,9 @@ type NetworkClient interface {
 	NetInfo(ctx context.Context) (*ctypes.ResultNetInfo, error)
 	DumpConsensusState(ctx context.Context) (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState(ctx context.Context) (*ctypes.ResultConsensusState, error)
+	ConsensusTrace(ctx context.Context, height *int64) (*ctypes.ResultConsensusTrace, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
+	StateSyncStatus(ctx context.Context) (*ctypes.ResultStateSyncStatus, error)
 	Health(ctx context.Context) (*ctypes.ResultHealth, error)
 }
 
@@ -138,12 +140,21 @@ type MempoolClient interface {
 	UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error)
 	NumUnconfirmedTxs(ctx context.Context) (*ctypes.ResultUnconfirmedTxs, error)
 	CheckTx(ctx context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error)
+	SimulateTx(ctx context.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error)
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
-// behavior.
+// behavior, and for querying the pending and committed evidence.
 type EvidenceClient interface {
 	BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error)
+	PendingEvidence(ctx context.Context, limit *int) (*ctypes.ResultPendingEvidence, error)
+	CommittedEvidence(ctx context.Context, hash []byte) (*ctypes.ResultCommittedEvidence, error)
+	EvidenceSearch(
+		ctx context.Context,
+		validator []byte,
+		minHeight, maxHeight *int64,
+		page, perPage *int,
+	) (*ctypes.ResultEvidenceSearch, error)
 }
 
 // RemoteClient is a Client, which can also return the remote network address.
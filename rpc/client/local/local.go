@@ -126,6 +126,10 @@ func (c *Local) CheckTx(_ context.Context, tx types.Tx) (*ctypes.ResultCheckTx,
 	return c.env.CheckTx(c.ctx, tx)
 }
 
+func (c *Local) SimulateTx(_ context.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error) {
+	return c.env.SimulateTx(c.ctx, tx)
+}
+
 func (c *Local) NetInfo(context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.env.NetInfo(c.ctx)
 }
@@ -138,6 +142,14 @@ func (c *Local) ConsensusState(context.Context) (*ctypes.ResultConsensusState, e
 	return c.env.GetConsensusState(c.ctx)
 }
 
+func (c *Local) ConsensusTrace(_ context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.env.ConsensusTrace(c.ctx, height)
+}
+
+func (c *Local) StateSyncStatus(context.Context) (*ctypes.ResultStateSyncStatus, error) {
+	return c.env.StateSyncStatus(c.ctx)
+}
+
 func (c *Local) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(c.ctx, height)
 }
@@ -228,6 +240,23 @@ func (c *Local) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes
 	return c.env.BroadcastEvidence(c.ctx, ev)
 }
 
+func (c *Local) PendingEvidence(_ context.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return c.env.PendingEvidence(c.ctx, limit)
+}
+
+func (c *Local) CommittedEvidence(_ context.Context, hash []byte) (*ctypes.ResultCommittedEvidence, error) {
+	return c.env.CommittedEvidence(c.ctx, hash)
+}
+
+func (c *Local) EvidenceSearch(
+	_ context.Context,
+	validator []byte,
+	minHeight, maxHeight *int64,
+	page, perPage *int,
+) (*ctypes.ResultEvidenceSearch, error) {
+	return c.env.EvidenceSearch(c.ctx, validator, minHeight, maxHeight, page, perPage)
+}
+
 func (c *Local) Subscribe(
 	ctx context.Context,
 	subscriber,
@@ -120,6 +120,10 @@ func (c Client) CheckTx(_ context.Context, tx types.Tx) (*ctypes.ResultCheckTx,
 	return c.env.CheckTx(&rpctypes.Context{}, tx)
 }
 
+func (c Client) SimulateTx(_ context.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error) {
+	return c.env.SimulateTx(&rpctypes.Context{}, tx)
+}
+
 func (c Client) NetInfo(_ context.Context) (*ctypes.ResultNetInfo, error) {
 	return c.env.NetInfo(&rpctypes.Context{})
 }
@@ -132,6 +136,14 @@ func (c Client) DumpConsensusState(_ context.Context) (*ctypes.ResultDumpConsens
 	return c.env.DumpConsensusState(&rpctypes.Context{})
 }
 
+func (c Client) ConsensusTrace(_ context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.env.ConsensusTrace(&rpctypes.Context{}, height)
+}
+
+func (c Client) StateSyncStatus(_ context.Context) (*ctypes.ResultStateSyncStatus, error) {
+	return c.env.StateSyncStatus(&rpctypes.Context{})
+}
+
 func (c Client) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(&rpctypes.Context{}, height)
 }
@@ -181,3 +193,20 @@ func (c Client) Validators(_ context.Context, height *int64, page, perPage *int)
 func (c Client) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return c.env.BroadcastEvidence(&rpctypes.Context{}, ev)
 }
+
+func (c Client) PendingEvidence(_ context.Context, limit *int) (*ctypes.ResultPendingEvidence, error) {
+	return c.env.PendingEvidence(&rpctypes.Context{}, limit)
+}
+
+func (c Client) CommittedEvidence(_ context.Context, hash []byte) (*ctypes.ResultCommittedEvidence, error) {
+	return c.env.CommittedEvidence(&rpctypes.Context{}, hash)
+}
+
+func (c Client) EvidenceSearch(
+	_ context.Context,
+	validator []byte,
+	minHeight, maxHeight *int64,
+	page, perPage *int,
+) (*ctypes.ResultEvidenceSearch, error) {
+	return c.env.EvidenceSearch(&rpctypes.Context{}, validator, minHeight, maxHeight, page, perPage)
+}
@@ -344,6 +344,29 @@ func (_m *Client) Commit(ctx context.Context, height *int64) (*coretypes.ResultC
 	return r0, r1
 }
 
+// CommittedEvidence provides a mock function with given fields: ctx, hash
+func (_m *Client) CommittedEvidence(ctx context.Context, hash []byte) (*coretypes.ResultCommittedEvidence, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *coretypes.ResultCommittedEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *coretypes.ResultCommittedEvidence); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultCommittedEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConsensusParams provides a mock function with given fields: ctx, height
 func (_m *Client) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
 	ret := _m.Called(ctx, height)
@@ -367,6 +390,29 @@ func (_m *Client) ConsensusParams(ctx context.Context, height *int64) (*coretype
 	return r0, r1
 }
 
+// ConsensusTrace provides a mock function with given fields: ctx, height
+func (_m *Client) ConsensusTrace(ctx context.Context, height *int64) (*coretypes.ResultConsensusTrace, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultConsensusTrace
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultConsensusTrace); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultConsensusTrace)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConsensusState provides a mock function with given fields: _a0
 func (_m *Client) ConsensusState(_a0 context.Context) (*coretypes.ResultConsensusState, error) {
 	ret := _m.Called(_a0)
@@ -413,6 +459,29 @@ func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDump
 	return r0, r1
 }
 
+// EvidenceSearch provides a mock function with given fields: ctx, validator, minHeight, maxHeight, page, perPage
+func (_m *Client) EvidenceSearch(ctx context.Context, validator []byte, minHeight *int64, maxHeight *int64, page *int, perPage *int) (*coretypes.ResultEvidenceSearch, error) {
+	ret := _m.Called(ctx, validator, minHeight, maxHeight, page, perPage)
+
+	var r0 *coretypes.ResultEvidenceSearch
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, *int64, *int64, *int, *int) *coretypes.ResultEvidenceSearch); ok {
+		r0 = rf(ctx, validator, minHeight, maxHeight, page, perPage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultEvidenceSearch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, *int64, *int64, *int, *int) error); ok {
+		r1 = rf(ctx, validator, minHeight, maxHeight, page, perPage)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Genesis provides a mock function with given fields: _a0
 func (_m *Client) Genesis(_a0 context.Context) (*coretypes.ResultGenesis, error) {
 	ret := _m.Called(_a0)
@@ -621,6 +690,29 @@ func (_m *Client) OnStop() {
 	_m.Called()
 }
 
+// PendingEvidence provides a mock function with given fields: ctx, limit
+func (_m *Client) PendingEvidence(ctx context.Context, limit *int) (*coretypes.ResultPendingEvidence, error) {
+	ret := _m.Called(ctx, limit)
+
+	var r0 *coretypes.ResultPendingEvidence
+	if rf, ok := ret.Get(0).(func(context.Context, *int) *coretypes.ResultPendingEvidence); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultPendingEvidence)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Quit provides a mock function with given fields:
 func (_m *Client) Quit() <-chan struct{} {
 	ret := _m.Called()
@@ -670,6 +762,52 @@ func (_m *Client) Start() error {
 	return r0
 }
 
+// SimulateTx provides a mock function with given fields: _a0, _a1
+func (_m *Client) SimulateTx(_a0 context.Context, _a1 types.Tx) (*coretypes.ResultSimulateTx, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *coretypes.ResultSimulateTx
+	if rf, ok := ret.Get(0).(func(context.Context, types.Tx) *coretypes.ResultSimulateTx); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultSimulateTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.Tx) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateSyncStatus provides a mock function with given fields: _a0
+func (_m *Client) StateSyncStatus(_a0 context.Context) (*coretypes.ResultStateSyncStatus, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *coretypes.ResultStateSyncStatus
+	if rf, ok := ret.Get(0).(func(context.Context) *coretypes.ResultStateSyncStatus); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultStateSyncStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Status provides a mock function with given fields: _a0
 func (_m *Client) Status(_a0 context.Context) (*coretypes.ResultStatus, error) {
 	ret := _m.Called(_a0)
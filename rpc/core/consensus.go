@@ -101,6 +101,30 @@ func (env *Environment) GetConsensusState(*rpctypes.Context) (*ctypes.ResultCons
 	return &ctypes.ResultConsensusState{RoundState: bz}, err
 }
 
+// ConsensusTrace returns the step transitions, timeouts, proposals and votes
+// recorded by the consensus state for the given height, along with the peer
+// each message was received from and the local receive time. If no height is
+// provided, it returns the trace of the current height. The recorder is
+// disabled unless consensus.round_trace_heights is set.
+// UNSTABLE
+// More: https://docs.cometbft.com/main/rpc/#/Info/consensus_trace
+func (env *Environment) ConsensusTrace(
+	_ *rpctypes.Context,
+	heightPtr *int64,
+) (*ctypes.ResultConsensusTrace, error) {
+	// The current height is the last one recorded.
+	height, err := env.getHeight(env.ConsensusState.GetLastHeight()+1, heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := env.ConsensusState.GetRoundTraceJSON(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultConsensusTrace{Height: height, Events: events}, nil
+}
+
 // ConsensusParams gets the consensus parameters at the given block height.
 // If no height is provided, it will fetch the latest consensus params.
 // More: https://docs.cometbft.com/main/rpc/#/Info/consensus_params
@@ -17,10 +17,12 @@ import (
 	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
 	ni "github.com/cometbft/cometbft/p2p/nodeinfo"
+	"github.com/cometbft/cometbft/p2p/nodekey"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/statesync"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -48,6 +50,13 @@ type Consensus interface {
 	GetLastHeight() int64
 	GetRoundStateJSON() ([]byte, error)
 	GetRoundStateSimpleJSON() ([]byte, error)
+	GetRoundTraceJSON(height int64) ([]byte, error)
+}
+
+type evidenceIndex interface {
+	PendingEvidence(maxBytes int64) ([]types.Evidence, int64)
+	CommittedEvidenceHeight(hash []byte) (int64, bool, error)
+	SearchCommittedEvidence(address types.Address, minHeight, maxHeight int64) ([]types.Evidence, error)
 }
 
 type transport interface {
@@ -62,6 +71,7 @@ type peers interface {
 	AddPrivatePeerIDs(peerIDs []string) error
 	DialPeersAsync(peers []string) error
 	Peers() p2p.IPeerSet
+	PeerScore(id nodekey.ID) float64
 }
 
 // A reactor that transitions from block sync or state sync to consensus mode.
@@ -69,6 +79,10 @@ type syncReactor interface {
 	WaitSync() bool
 }
 
+type stateSyncReactor interface {
+	SyncStatus() statesync.SyncStatus
+}
+
 type mempoolReactor interface {
 	syncReactor
 	TryAddTx(tx types.Tx, sender p2p.Peer) (*abcicli.ReqRes, error)
@@ -92,9 +106,11 @@ type Environment struct {
 	StateStore       sm.Store
 	BlockStore       sm.BlockStore
 	EvidencePool     sm.EvidencePool
+	EvidenceIndex    evidenceIndex
 	ConsensusState   Consensus
 	ConsensusReactor syncReactor
 	MempoolReactor   mempoolReactor
+	StateSyncReactor stateSyncReactor
 	P2PPeers         peers
 	P2PTransport     transport
 
@@ -10,6 +10,8 @@ var (
 	ErrBlockIndexing           = errors.New("block indexing is disabled")
 	ErrTxIndexingDisabled      = errors.New("transaction indexing is disabled")
 	ErrNoEvidence              = errors.New("no evidence was provided")
+	ErrNoEvidenceHash          = errors.New("no evidence hash was provided")
+	ErrNoValidatorAddress      = errors.New("no validator address was provided")
 	ErrSlowClient              = errors.New("slow client")
 	ErrCometBFTExited          = errors.New("cometBFT exited")
 	ErrConfirmationNotReceived = errors.New("broadcast confirmation not received")
@@ -3,6 +3,7 @@ package core
 import (
 	"reflect"
 
+	cmtmath "github.com/cometbft/cometbft/libs/math"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/cometbft/cometbft/types"
@@ -31,3 +32,87 @@ func (env *Environment) BroadcastEvidence(
 
 	return &ctypes.ResultBroadcastEvidence{Hash: ev.Hash()}, nil
 }
+
+// PendingEvidence gets the evidence that has been verified but not yet
+// committed, up to limit (30 by default, 100 at most).
+// More: https://docs.cometbft.com/main/rpc/#/Evidence/pending_evidence
+func (env *Environment) PendingEvidence(_ *rpctypes.Context, limitPtr *int) (*ctypes.ResultPendingEvidence, error) {
+	// reuse per_page validator
+	limit := env.validatePerPage(limitPtr)
+
+	evidence, _ := env.EvidenceIndex.PendingEvidence(-1)
+	total := len(evidence)
+	if len(evidence) > limit {
+		evidence = evidence[:limit]
+	}
+	return &ctypes.ResultPendingEvidence{
+		Count:    len(evidence),
+		Total:    total,
+		Evidence: evidence,
+	}, nil
+}
+
+// CommittedEvidence checks whether the evidence with the given hash has been
+// committed, and if so, at which height.
+// More: https://docs.cometbft.com/main/rpc/#/Evidence/committed_evidence
+func (env *Environment) CommittedEvidence(_ *rpctypes.Context, hash []byte) (*ctypes.ResultCommittedEvidence, error) {
+	if len(hash) == 0 {
+		return nil, ErrNoEvidenceHash
+	}
+	height, committed, err := env.EvidenceIndex.CommittedEvidenceHeight(hash)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultCommittedEvidence{
+		Hash:      hash,
+		Committed: committed,
+		Height:    height,
+	}, nil
+}
+
+// EvidenceSearch searches the committed evidence of misbehavior of a
+// validator, optionally between minHeight and maxHeight (inclusive). Evidence
+// is ordered by the height of the misbehavior.
+// More: https://docs.cometbft.com/main/rpc/#/Evidence/evidence_search
+func (env *Environment) EvidenceSearch(
+	_ *rpctypes.Context,
+	validator []byte,
+	minHeightPtr, maxHeightPtr *int64,
+	pagePtr, perPagePtr *int,
+) (*ctypes.ResultEvidenceSearch, error) {
+	if len(validator) == 0 {
+		return nil, ErrNoValidatorAddress
+	}
+
+	minHeight, maxHeight := int64(1), env.BlockStore.Height()
+	if minHeightPtr != nil {
+		minHeight = *minHeightPtr
+	}
+	if maxHeightPtr != nil {
+		maxHeight = *maxHeightPtr
+	}
+	if minHeight < 0 || maxHeight < 0 {
+		return nil, ErrNegativeHeight
+	}
+	if minHeight > maxHeight {
+		return nil, ErrHeightMinGTMax{Min: minHeight, Max: maxHeight}
+	}
+
+	evidence, err := env.EvidenceIndex.SearchCommittedEvidence(validator, minHeight, maxHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount := len(evidence)
+	perPage := env.validatePerPage(perPagePtr)
+	page, err := validatePage(pagePtr, perPage, totalCount)
+	if err != nil {
+		return nil, err
+	}
+	skipCount := validateSkipCount(page, perPage)
+
+	return &ctypes.ResultEvidenceSearch{
+		Evidence:   evidence[skipCount : skipCount+cmtmath.MinInt(perPage, totalCount-skipCount)],
+		TotalCount: totalCount,
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proxy"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 	"github.com/cometbft/cometbft/types"
@@ -232,3 +233,15 @@ func (env *Environment) CheckTx(_ *rpctypes.Context, tx types.Tx) (*ctypes.Resul
 	}
 	return &ctypes.ResultCheckTx{CheckTxResponse: *res}, nil
 }
+
+// SimulateTx executes the transaction against a throwaway copy of the
+// application's state, and returns the result, including the gas used and the
+// events. The transaction is neither added to the mempool nor gossiped.
+// More: https://docs.cometbft.com/main/rpc/#/Tx/simulate_tx
+func (env *Environment) SimulateTx(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error) {
+	res, err := proxy.SimulateTx(ctx.Context(), env.ProxyAppQuery, tx)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultSimulateTx{ExecTxResult: *res}, nil
+}
@@ -36,6 +36,7 @@ func (env *Environment) NetInfo(*rpctypes.Context) (*ctypes.ResultNetInfo, error
 			IsOutbound:       peer.IsOutbound(),
 			ConnectionStatus: peer.Status(),
 			RemoteIP:         peer.RemoteIP().String(),
+			Score:            env.P2PPeers.PeerScore(peer.ID()),
 		})
 	})
 	if err != nil {
@@ -30,13 +30,16 @@ func (env *Environment) GetRoutes() RoutesMap {
 		"header":               rpc.NewRPCFunc(env.Header, "height", rpc.Cacheable("height")),
 		"header_by_hash":       rpc.NewRPCFunc(env.HeaderByHash, "hash", rpc.Cacheable()),
 		"check_tx":             rpc.NewRPCFunc(env.CheckTx, "tx"),
+		"simulate_tx":          rpc.NewRPCFunc(env.SimulateTx, "tx"),
 		"tx":                   rpc.NewRPCFunc(env.Tx, "hash,prove", rpc.Cacheable()),
 		"tx_search":            rpc.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by"),
 		"block_search":         rpc.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by"),
 		"validators":           rpc.NewRPCFunc(env.Validators, "height,page,per_page", rpc.Cacheable("height")),
 		"dump_consensus_state": rpc.NewRPCFunc(env.DumpConsensusState, ""),
 		"consensus_state":      rpc.NewRPCFunc(env.GetConsensusState, ""),
+		"consensus_trace":      rpc.NewRPCFunc(env.ConsensusTrace, "height"),
 		"consensus_params":     rpc.NewRPCFunc(env.ConsensusParams, "height", rpc.Cacheable("height")),
+		"state_sync_status":    rpc.NewRPCFunc(env.StateSyncStatus, ""),
 		"unconfirmed_tx":       rpc.NewRPCFunc(env.UnconfirmedTx, "hash"),
 		"unconfirmed_txs":      rpc.NewRPCFunc(env.UnconfirmedTxs, "limit"),
 		"num_unconfirmed_txs":  rpc.NewRPCFunc(env.NumUnconfirmedTxs, ""),
@@ -52,6 +55,9 @@ func (env *Environment) GetRoutes() RoutesMap {
 
 		// evidence API
 		"broadcast_evidence": rpc.NewRPCFunc(env.BroadcastEvidence, "evidence"),
+		"pending_evidence":   rpc.NewRPCFunc(env.PendingEvidence, "limit"),
+		"committed_evidence": rpc.NewRPCFunc(env.CommittedEvidence, "hash"),
+		"evidence_search":    rpc.NewRPCFunc(env.EvidenceSearch, "validator,min_height,max_height,page,per_page"),
 	}
 }
 
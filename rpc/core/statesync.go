@@ -0,0 +1,43 @@
+package core
+
+import (
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// StateSyncStatus returns the progress of the state sync of the node: the
+// snapshot being restored, the number of chunks fetched and applied, the
+// estimated time left, and the chunk providers along with their scores.
+// More: https://docs.cometbft.com/main/rpc/#/Info/state_sync_status
+func (env *Environment) StateSyncStatus(*rpctypes.Context) (*ctypes.ResultStateSyncStatus, error) {
+	if env.StateSyncReactor == nil {
+		return &ctypes.ResultStateSyncStatus{}, nil
+	}
+
+	status := env.StateSyncReactor.SyncStatus()
+	peers := make([]ctypes.StateSyncPeer, 0, len(status.Peers))
+	for _, peer := range status.Peers {
+		peers = append(peers, ctypes.StateSyncPeer{
+			ID:             string(peer.ID),
+			Score:          peer.Score,
+			ChunksReceived: peer.ChunksReceived,
+			Failures:       peer.Failures,
+			Outstanding:    peer.Outstanding,
+			Latency:        peer.Latency,
+			Bandwidth:      peer.Bandwidth,
+			Rejected:       peer.Rejected,
+		})
+	}
+	return &ctypes.ResultStateSyncStatus{
+		Syncing:        status.Syncing,
+		SnapshotHeight: status.SnapshotHeight,
+		SnapshotFormat: status.SnapshotFormat,
+		SnapshotHash:   status.SnapshotHash,
+		ChunksTotal:    status.ChunksTotal,
+		ChunksFetched:  status.ChunksFetched,
+		ChunksApplied:  status.ChunksApplied,
+		StartTime:      status.StartTime,
+		ETA:            status.ETA,
+		Peers:          peers,
+	}, nil
+}
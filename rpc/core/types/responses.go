@@ -136,6 +136,7 @@ type Peer struct {
 	IsOutbound       bool                 `json:"is_outbound"`
 	ConnectionStatus p2p.ConnectionStatus `json:"connection_status"`
 	RemoteIP         string               `json:"remote_ip"`
+	Score            float64              `json:"score"`
 }
 
 // Validators for a height.
@@ -172,6 +173,40 @@ type ResultConsensusState struct {
 	RoundState json.RawMessage `json:"round_state"`
 }
 
+// Step transitions, timeouts, proposals and votes recorded by the consensus
+// state for a height.
+type ResultConsensusTrace struct {
+	Height int64           `json:"height"`
+	Events json.RawMessage `json:"events"`
+}
+
+// Progress of the state sync of the node. The snapshot and chunk fields are
+// only set while a snapshot is being restored. ETA is zero if unknown.
+type ResultStateSyncStatus struct {
+	Syncing        bool            `json:"syncing"`
+	SnapshotHeight uint64          `json:"snapshot_height"`
+	SnapshotFormat uint32          `json:"snapshot_format"`
+	SnapshotHash   bytes.HexBytes  `json:"snapshot_hash"`
+	ChunksTotal    uint32          `json:"chunks_total"`
+	ChunksFetched  uint32          `json:"chunks_fetched"`
+	ChunksApplied  uint32          `json:"chunks_applied"`
+	StartTime      time.Time       `json:"start_time"`
+	ETA            time.Duration   `json:"eta"`
+	Peers          []StateSyncPeer `json:"peers"`
+}
+
+// A state sync chunk provider, with its score.
+type StateSyncPeer struct {
+	ID             string        `json:"id"`
+	Score          float64       `json:"score"`
+	ChunksReceived uint64        `json:"chunks_received"`
+	Failures       uint64        `json:"failures"`
+	Outstanding    int           `json:"outstanding"`
+	Latency        time.Duration `json:"latency"`
+	Bandwidth      float64       `json:"bandwidth"`
+	Rejected       bool          `json:"rejected"`
+}
+
 // CheckTx result.
 type ResultBroadcastTx struct {
 	Code      uint32         `json:"code"`
@@ -195,6 +230,11 @@ type ResultCheckTx struct {
 	abcitypes.CheckTxResponse
 }
 
+// ResultSimulateTx wraps the abci.ExecTxResult of a simulated tx.
+type ResultSimulateTx struct {
+	abcitypes.ExecTxResult
+}
+
 // Result of querying for a tx.
 type ResultTx struct {
 	Hash     bytes.HexBytes         `json:"hash"`
@@ -245,6 +285,27 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// List of pending evidence.
+type ResultPendingEvidence struct {
+	Count    int              `json:"n_evidence"`
+	Total    int              `json:"total"`
+	Evidence []types.Evidence `json:"evidence"`
+}
+
+// Whether evidence was committed, and at which height.
+type ResultCommittedEvidence struct {
+	Hash      bytes.HexBytes `json:"hash"`
+	Committed bool           `json:"committed"`
+	Height    int64          `json:"height,omitempty"`
+}
+
+// ResultEvidenceSearch defines the RPC response type for a search of the
+// committed evidence of a validator.
+type ResultEvidenceSearch struct {
+	Evidence   []types.Evidence `json:"evidence"`
+	TotalCount int              `json:"total_count"`
+}
+
 // empty results.
 type (
 	ResultUnsafeFlushMempool struct{}
@@ -47,3 +47,27 @@ func (e ErrDial) Error() string {
 func (e ErrDial) Unwrap() error {
 	return e.Source
 }
+
+type ErrMempoolStreamSetup struct {
+	Source error
+}
+
+func (e ErrMempoolStreamSetup) Error() string {
+	return "error getting a mempool stream: " + e.Source.Error()
+}
+
+func (e ErrMempoolStreamSetup) Unwrap() error {
+	return e.Source
+}
+
+type ErrMempoolStreamReceive struct {
+	Source error
+}
+
+func (e ErrMempoolStreamReceive) Error() string {
+	return "error receiving from a mempool stream: " + e.Source.Error()
+}
+
+func (e ErrMempoolStreamReceive) Unwrap() error {
+	return e.Source
+}
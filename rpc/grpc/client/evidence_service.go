@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	evidencesvc "github.com/cometbft/cometbft/api/cometbft/services/evidence/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/types"
+)
+
+// EvidencePage is a page of pending or committed evidence.
+type EvidencePage struct {
+	Evidence []types.Evidence `json:"evidence"`
+	// Total is the number of evidence pending or matching the search.
+	Total uint64 `json:"total"`
+}
+
+// CommittedEvidence is the commit status of evidence.
+type CommittedEvidence struct {
+	Committed bool `json:"committed"`
+	// Height is the height of the block in which the evidence was committed.
+	Height int64 `json:"height"`
+}
+
+// EvidenceServiceClient provides access to the pending and committed evidence
+// of a node.
+type EvidenceServiceClient interface {
+	// GetPendingEvidence returns up to limit evidence verified but not yet
+	// committed. If limit is 0, the server's default is used.
+	GetPendingEvidence(ctx context.Context, limit uint32) (*EvidencePage, error)
+
+	// GetCommittedEvidence returns whether the evidence with the given hash
+	// has been committed, and if so, at which height.
+	GetCommittedEvidence(ctx context.Context, hash []byte) (*CommittedEvidence, error)
+
+	// SearchCommittedEvidence returns up to limit committed evidence of
+	// misbehavior of the given validator between minHeight and maxHeight
+	// (inclusive), skipping the first offset ones. A zero height leaves the
+	// range open on that side, and if limit is 0, the server's default is
+	// used.
+	SearchCommittedEvidence(
+		ctx context.Context,
+		validator types.Address,
+		minHeight, maxHeight int64,
+		offset, limit uint32,
+	) (*EvidencePage, error)
+}
+
+type evidenceServiceClient struct {
+	client evidencesvc.EvidenceServiceClient
+}
+
+func newEvidenceServiceClient(conn grpc.ClientConn) EvidenceServiceClient {
+	return &evidenceServiceClient{
+		client: evidencesvc.NewEvidenceServiceClient(conn),
+	}
+}
+
+// GetPendingEvidence implements EvidenceServiceClient GetPendingEvidence.
+func (c *evidenceServiceClient) GetPendingEvidence(ctx context.Context, limit uint32) (*EvidencePage, error) {
+	res, err := c.client.GetPendingEvidence(ctx, &evidencesvc.GetPendingEvidenceRequest{Limit: limit})
+	if err != nil {
+		return nil, err
+	}
+	return evidencePageFromProto(res.Evidence, res.Total)
+}
+
+// GetCommittedEvidence implements EvidenceServiceClient GetCommittedEvidence.
+func (c *evidenceServiceClient) GetCommittedEvidence(ctx context.Context, hash []byte) (*CommittedEvidence, error) {
+	res, err := c.client.GetCommittedEvidence(ctx, &evidencesvc.GetCommittedEvidenceRequest{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	return &CommittedEvidence{
+		Committed: res.Committed,
+		Height:    res.Height,
+	}, nil
+}
+
+// SearchCommittedEvidence implements EvidenceServiceClient
+// SearchCommittedEvidence.
+func (c *evidenceServiceClient) SearchCommittedEvidence(
+	ctx context.Context,
+	validator types.Address,
+	minHeight, maxHeight int64,
+	offset, limit uint32,
+) (*EvidencePage, error) {
+	res, err := c.client.SearchCommittedEvidence(ctx, &evidencesvc.SearchCommittedEvidenceRequest{
+		ValidatorAddress: validator,
+		MinHeight:        minHeight,
+		MaxHeight:        maxHeight,
+		Offset:           offset,
+		Limit:            limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return evidencePageFromProto(res.Evidence, res.Total)
+}
+
+func evidencePageFromProto(pbs []*cmtproto.Evidence, total uint64) (*EvidencePage, error) {
+	evidence := make([]types.Evidence, 0, len(pbs))
+	for _, pb := range pbs {
+		ev, err := types.EvidenceFromProto(pb)
+		if err != nil {
+			return nil, err
+		}
+		evidence = append(evidence, ev)
+	}
+	return &EvidencePage{
+		Evidence: evidence,
+		Total:    total,
+	}, nil
+}
+
+type disabledEvidenceServiceClient struct{}
+
+func newDisabledEvidenceServiceClient() EvidenceServiceClient {
+	return &disabledEvidenceServiceClient{}
+}
+
+// GetPendingEvidence implements EvidenceServiceClient GetPendingEvidence - disabled client.
+func (*disabledEvidenceServiceClient) GetPendingEvidence(context.Context, uint32) (*EvidencePage, error) {
+	panic("evidence service client is disabled")
+}
+
+// GetCommittedEvidence implements EvidenceServiceClient GetCommittedEvidence - disabled client.
+func (*disabledEvidenceServiceClient) GetCommittedEvidence(context.Context, []byte) (*CommittedEvidence, error) {
+	panic("evidence service client is disabled")
+}
+
+// SearchCommittedEvidence implements EvidenceServiceClient SearchCommittedEvidence - disabled client.
+func (*disabledEvidenceServiceClient) SearchCommittedEvidence(context.Context, types.Address, int64, int64, uint32, uint32) (*EvidencePage, error) {
+	panic("evidence service client is disabled")
+}
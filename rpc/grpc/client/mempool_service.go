@@ -0,0 +1,338 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/cosmos/gogoproto/grpc"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	mempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/types"
+)
+
+// BroadcastTxResult is the result of checking a transaction with CheckTx.
+type BroadcastTxResult struct {
+	Hash    cmtbytes.HexBytes     `json:"hash"`
+	CheckTx *abci.CheckTxResponse `json:"check_tx"`
+	// Error is set if the transaction could not be checked, or, in results
+	// sent by a TxStream, if the stream failed.
+	Error error `json:"-"`
+}
+
+// LaneSize is the number of transactions in a lane and their total size.
+type LaneSize struct {
+	Lane     string `json:"lane"`
+	NumTxs   uint64 `json:"num_txs"`
+	NumBytes uint64 `json:"num_bytes"`
+}
+
+// TxsPage is a page of the transactions in a lane.
+type TxsPage struct {
+	Txs types.Txs `json:"txs"`
+	// Total is the number of transactions in the lane.
+	Total uint64 `json:"total"`
+}
+
+// NewTxResult type used in SubscribeNewTxs and sent to the client via a
+// channel.
+type NewTxResult struct {
+	Tx    types.Tx
+	Error error
+}
+
+// TxStream submits transactions to the mempool without waiting for the result
+// of previous ones.
+type TxStream interface {
+	// Send submits a transaction.
+	Send(tx types.Tx) error
+
+	// CloseSend signals that no more transactions will be sent. The results
+	// channel is closed once the results of all transactions sent have been
+	// received.
+	CloseSend() error
+
+	// Results returns the channel where the result of each transaction is
+	// sent, not necessarily in the order the transactions were sent.
+	Results() <-chan BroadcastTxResult
+}
+
+type mempoolStreamConfig struct {
+	chSize uint
+}
+
+type MempoolStreamOption func(*mempoolStreamConfig)
+
+// MempoolStreamChannelSize allows control over the size of the channel of
+// the results of a mempool stream. If not used or the channel size is set to
+// 0, an unbuffered channel will be created.
+func MempoolStreamChannelSize(sz uint) MempoolStreamOption {
+	return func(opts *mempoolStreamConfig) {
+		opts.chSize = sz
+	}
+}
+
+// MempoolServiceClient provides access to the mempool of a node.
+type MempoolServiceClient interface {
+	// BroadcastTx submits a transaction and waits for the result of checking
+	// it with CheckTx.
+	BroadcastTx(ctx context.Context, tx types.Tx) (*BroadcastTxResult, error)
+
+	// BroadcastTxStream opens a stream to submit transactions without waiting
+	// for the result of previous ones.
+	BroadcastTxStream(ctx context.Context, opts ...MempoolStreamOption) (TxStream, error)
+
+	// SimulateTx asks the application to execute a transaction against its
+	// latest committed state and returns the result, without adding the
+	// transaction to the mempool.
+	SimulateTx(ctx context.Context, tx types.Tx) (*abci.ExecTxResult, error)
+
+	// GetUnconfirmedTx returns the transaction in the mempool with the given
+	// hash.
+	GetUnconfirmedTx(ctx context.Context, hash []byte) (types.Tx, error)
+
+	// ListTxs returns up to limit transactions in the given lane, skipping
+	// the first offset ones. If limit is 0, the server's default is used.
+	ListTxs(ctx context.Context, lane string, offset, limit uint32) (*TxsPage, error)
+
+	// GetLaneSizes returns the size of each lane, from the highest to the
+	// lowest priority.
+	GetLaneSizes(ctx context.Context) ([]LaneSize, error)
+
+	// SubscribeNewTxs sends the transactions added to the mempool to the
+	// resulting output channel.
+	SubscribeNewTxs(ctx context.Context, opts ...MempoolStreamOption) (<-chan NewTxResult, error)
+}
+
+type mempoolServiceClient struct {
+	client mempoolsvc.MempoolServiceClient
+}
+
+func newMempoolServiceClient(conn grpc.ClientConn) MempoolServiceClient {
+	return &mempoolServiceClient{
+		client: mempoolsvc.NewMempoolServiceClient(conn),
+	}
+}
+
+// BroadcastTx implements MempoolServiceClient BroadcastTx.
+func (c *mempoolServiceClient) BroadcastTx(ctx context.Context, tx types.Tx) (*BroadcastTxResult, error) {
+	res, err := c.client.BroadcastTx(ctx, &mempoolsvc.BroadcastTxRequest{Tx: tx})
+	if err != nil {
+		return nil, err
+	}
+	return broadcastTxResultFromProto(res), nil
+}
+
+// BroadcastTxStream implements MempoolServiceClient BroadcastTxStream.
+func (c *mempoolServiceClient) BroadcastTxStream(ctx context.Context, opts ...MempoolStreamOption) (TxStream, error) {
+	stream, err := c.client.BroadcastTxStream(ctx)
+	if err != nil {
+		return nil, ErrMempoolStreamSetup{Source: err}
+	}
+
+	cfg := &mempoolStreamConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	s := &txStream{
+		stream:   stream,
+		resultCh: make(chan BroadcastTxResult, cfg.chSize),
+	}
+	go s.receive(ctx)
+	return s, nil
+}
+
+// SimulateTx implements MempoolServiceClient SimulateTx.
+func (c *mempoolServiceClient) SimulateTx(ctx context.Context, tx types.Tx) (*abci.ExecTxResult, error) {
+	res, err := c.client.SimulateTx(ctx, &mempoolsvc.SimulateTxRequest{Tx: tx})
+	if err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+// GetUnconfirmedTx implements MempoolServiceClient GetUnconfirmedTx.
+func (c *mempoolServiceClient) GetUnconfirmedTx(ctx context.Context, hash []byte) (types.Tx, error) {
+	res, err := c.client.GetUnconfirmedTx(ctx, &mempoolsvc.GetUnconfirmedTxRequest{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	return res.Tx, nil
+}
+
+// ListTxs implements MempoolServiceClient ListTxs.
+func (c *mempoolServiceClient) ListTxs(ctx context.Context, lane string, offset, limit uint32) (*TxsPage, error) {
+	res, err := c.client.ListTxs(ctx, &mempoolsvc.ListTxsRequest{
+		Lane:   lane,
+		Offset: offset,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make(types.Txs, 0, len(res.Txs))
+	for _, tx := range res.Txs {
+		txs = append(txs, tx)
+	}
+	return &TxsPage{
+		Txs:   txs,
+		Total: res.Total,
+	}, nil
+}
+
+// GetLaneSizes implements MempoolServiceClient GetLaneSizes.
+func (c *mempoolServiceClient) GetLaneSizes(ctx context.Context) ([]LaneSize, error) {
+	res, err := c.client.GetLaneSizes(ctx, &mempoolsvc.GetLaneSizesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := make([]LaneSize, 0, len(res.Lanes))
+	for _, lane := range res.Lanes {
+		sizes = append(sizes, LaneSize{
+			Lane:     lane.Lane,
+			NumTxs:   lane.NumTxs,
+			NumBytes: lane.NumBytes,
+		})
+	}
+	return sizes, nil
+}
+
+// SubscribeNewTxs implements MempoolServiceClient SubscribeNewTxs.
+func (c *mempoolServiceClient) SubscribeNewTxs(ctx context.Context, opts ...MempoolStreamOption) (<-chan NewTxResult, error) {
+	newTxsClient, err := c.client.SubscribeNewTxs(ctx, &mempoolsvc.SubscribeNewTxsRequest{})
+	if err != nil {
+		return nil, ErrMempoolStreamSetup{Source: err}
+	}
+
+	cfg := &mempoolStreamConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	resultCh := make(chan NewTxResult, cfg.chSize)
+
+	go func(client mempoolsvc.MempoolService_SubscribeNewTxsClient) {
+		defer close(resultCh)
+		for {
+			var res NewTxResult
+			response, err := client.Recv()
+			if err != nil {
+				res.Error = ErrMempoolStreamReceive{Source: err}
+			} else {
+				res.Tx = response.Tx
+			}
+			// Unlike block heights, new txs are not skipped if the channel
+			// is full: the server terminates the stream instead if the
+			// client does not keep up.
+			select {
+			case <-ctx.Done():
+				return
+			case resultCh <- res:
+			}
+			if err != nil {
+				return
+			}
+		}
+	}(newTxsClient)
+
+	return resultCh, nil
+}
+
+func broadcastTxResultFromProto(res *mempoolsvc.BroadcastTxResponse) *BroadcastTxResult {
+	result := &BroadcastTxResult{
+		Hash:    res.Hash,
+		CheckTx: res.CheckTx,
+	}
+	if res.Error != "" {
+		result.Error = errors.New(res.Error)
+	}
+	return result
+}
+
+type txStream struct {
+	stream   mempoolsvc.MempoolService_BroadcastTxStreamClient
+	resultCh chan BroadcastTxResult
+}
+
+// Send implements TxStream.
+func (s *txStream) Send(tx types.Tx) error {
+	return s.stream.Send(&mempoolsvc.BroadcastTxRequest{Tx: tx})
+}
+
+// CloseSend implements TxStream.
+func (s *txStream) CloseSend() error {
+	return s.stream.CloseSend()
+}
+
+// Results implements TxStream.
+func (s *txStream) Results() <-chan BroadcastTxResult {
+	return s.resultCh
+}
+
+func (s *txStream) receive(ctx context.Context) {
+	defer close(s.resultCh)
+	for {
+		var res BroadcastTxResult
+		response, err := s.stream.Recv()
+		switch {
+		case errors.Is(err, io.EOF):
+			return
+		case err != nil:
+			res.Error = ErrMempoolStreamReceive{Source: err}
+		default:
+			res = *broadcastTxResultFromProto(response)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case s.resultCh <- res:
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+type disabledMempoolServiceClient struct{}
+
+func newDisabledMempoolServiceClient() MempoolServiceClient {
+	return &disabledMempoolServiceClient{}
+}
+
+// BroadcastTx implements MempoolServiceClient BroadcastTx - disabled client.
+func (*disabledMempoolServiceClient) BroadcastTx(context.Context, types.Tx) (*BroadcastTxResult, error) {
+	panic("mempool service client is disabled")
+}
+
+// BroadcastTxStream implements MempoolServiceClient BroadcastTxStream - disabled client.
+func (*disabledMempoolServiceClient) BroadcastTxStream(context.Context, ...MempoolStreamOption) (TxStream, error) {
+	panic("mempool service client is disabled")
+}
+
+// SimulateTx implements MempoolServiceClient SimulateTx - disabled client.
+func (*disabledMempoolServiceClient) SimulateTx(context.Context, types.Tx) (*abci.ExecTxResult, error) {
+	panic("mempool service client is disabled")
+}
+
+// GetUnconfirmedTx implements MempoolServiceClient GetUnconfirmedTx - disabled client.
+func (*disabledMempoolServiceClient) GetUnconfirmedTx(context.Context, []byte) (types.Tx, error) {
+	panic("mempool service client is disabled")
+}
+
+// ListTxs implements MempoolServiceClient ListTxs - disabled client.
+func (*disabledMempoolServiceClient) ListTxs(context.Context, string, uint32, uint32) (*TxsPage, error) {
+	panic("mempool service client is disabled")
+}
+
+// GetLaneSizes implements MempoolServiceClient GetLaneSizes - disabled client.
+func (*disabledMempoolServiceClient) GetLaneSizes(context.Context) ([]LaneSize, error) {
+	panic("mempool service client is disabled")
+}
+
+// SubscribeNewTxs implements MempoolServiceClient SubscribeNewTxs - disabled client.
+func (*disabledMempoolServiceClient) SubscribeNewTxs(context.Context, ...MempoolStreamOption) (<-chan NewTxResult, error) {
+	panic("mempool service client is disabled")
+}
@@ -9,11 +9,17 @@ import (
 
 	pbblocksvc "github.com/cometbft/cometbft/api/cometbft/services/block/v1"
 	brs "github.com/cometbft/cometbft/api/cometbft/services/block_results/v1"
+	pbevidencesvc "github.com/cometbft/cometbft/api/cometbft/services/evidence/v1"
+	pbmempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
 	pbversionsvc "github.com/cometbft/cometbft/api/cometbft/services/version/v1"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/proxy"
 	grpcerr "github.com/cometbft/cometbft/rpc/grpc/errors"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockresultservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/evidenceservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/mempoolservice"
 	"github.com/cometbft/cometbft/rpc/grpc/server/services/versionservice"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/store"
@@ -29,6 +35,8 @@ type serverBuilder struct {
 	versionService      pbversionsvc.VersionServiceServer
 	blockService        pbblocksvc.BlockServiceServer
 	blockResultsService brs.BlockResultsServiceServer
+	mempoolService      pbmempoolsvc.MempoolServiceServer
+	evidenceService     pbevidencesvc.EvidenceServiceServer
 	logger              log.Logger
 	grpcOpts            []grpc.ServerOption
 }
@@ -74,6 +82,26 @@ func WithBlockResultsService(bs *store.BlockStore, ss sm.Store, logger log.Logge
 	}
 }
 
+// WithMempoolService enables the mempool service on the CometBFT server.
+func WithMempoolService(
+	reactor mempoolservice.Reactor,
+	mp mempool.Mempool,
+	appConn proxy.AppConnQuery,
+	eventBus *types.EventBus,
+	logger log.Logger,
+) Option {
+	return func(b *serverBuilder) {
+		b.mempoolService = mempoolservice.New(reactor, mp, appConn, eventBus, logger)
+	}
+}
+
+// WithEvidenceService enables the evidence service on the CometBFT server.
+func WithEvidenceService(pool evidenceservice.Pool, store *store.BlockStore, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.evidenceService = evidenceservice.New(pool, store, logger)
+	}
+}
+
 // WithLogger enables logging using the given logger. If not specified, the
 // gRPC server does not log anything.
 func WithLogger(logger log.Logger) Option {
@@ -113,6 +141,14 @@ func Serve(listener net.Listener, opts ...Option) error {
 		brs.RegisterBlockResultsServiceServer(server, b.blockResultsService)
 		b.logger.Debug("Registered block results service")
 	}
+	if b.mempoolService != nil {
+		pbmempoolsvc.RegisterMempoolServiceServer(server, b.mempoolService)
+		b.logger.Debug("Registered mempool service")
+	}
+	if b.evidenceService != nil {
+		pbevidencesvc.RegisterEvidenceServiceServer(server, b.evidenceService)
+		b.logger.Debug("Registered evidence service")
+	}
 	b.logger.Info("serve", "msg", fmt.Sprintf("Starting gRPC server on %s", listener.Addr()))
 	return server.Serve(b.listener)
 }
@@ -0,0 +1,151 @@
+package evidenceservice
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	evidencesvc "github.com/cometbft/cometbft/api/cometbft/services/evidence/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// defaultLimit is the number of evidence returned if no limit is given.
+	defaultLimit = 30
+	// maxLimit is the maximum number of evidence returned per request.
+	maxLimit = 100
+)
+
+// Pool is the part of the evidence pool used to query evidence.
+type Pool interface {
+	// PendingEvidence returns up to maxBytes of the pending evidence, or all
+	// of it if maxBytes is -1.
+	PendingEvidence(maxBytes int64) ([]types.Evidence, int64)
+	// CommittedEvidenceHeight returns the height of the block in which the
+	// evidence with the given hash was committed.
+	CommittedEvidenceHeight(hash []byte) (int64, bool, error)
+	// SearchCommittedEvidence returns the committed evidence of misbehavior
+	// of a validator between two heights (inclusive).
+	SearchCommittedEvidence(address types.Address, minHeight, maxHeight int64) ([]types.Evidence, error)
+}
+
+type evidenceServiceServer struct {
+	pool   Pool
+	store  *store.BlockStore
+	logger log.Logger
+}
+
+// New creates a new CometBFT evidence service server.
+func New(pool Pool, store *store.BlockStore, logger log.Logger) evidencesvc.EvidenceServiceServer {
+	return &evidenceServiceServer{
+		pool:   pool,
+		store:  store,
+		logger: logger.With("service", "EvidenceService"),
+	}
+}
+
+// GetPendingEvidence implements v1.EvidenceServiceServer GetPendingEvidence
+// method.
+func (s *evidenceServiceServer) GetPendingEvidence(_ context.Context, req *evidencesvc.GetPendingEvidenceRequest) (*evidencesvc.GetPendingEvidenceResponse, error) {
+	evidence, _ := s.pool.PendingEvidence(-1)
+	res := &evidencesvc.GetPendingEvidenceResponse{
+		Total: uint64(len(evidence)),
+	}
+	if limit := limitOrDefault(req.Limit); len(evidence) > limit {
+		evidence = evidence[:limit]
+	}
+
+	var err error
+	res.Evidence, err = s.evidenceToProto(evidence, "GetPendingEvidence")
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetCommittedEvidence implements v1.EvidenceServiceServer
+// GetCommittedEvidence method.
+func (s *evidenceServiceServer) GetCommittedEvidence(_ context.Context, req *evidencesvc.GetCommittedEvidenceRequest) (*evidencesvc.GetCommittedEvidenceResponse, error) {
+	if len(req.Hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Evidence hash cannot be empty")
+	}
+	height, committed, err := s.pool.CommittedEvidenceHeight(req.Hash)
+	if err != nil {
+		s.logger.Error("Error fetching committed evidence", "endpoint", "GetCommittedEvidence", "err", err)
+		return nil, status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	return &evidencesvc.GetCommittedEvidenceResponse{
+		Committed: committed,
+		Height:    height,
+	}, nil
+}
+
+// SearchCommittedEvidence implements v1.EvidenceServiceServer
+// SearchCommittedEvidence method.
+func (s *evidenceServiceServer) SearchCommittedEvidence(_ context.Context, req *evidencesvc.SearchCommittedEvidenceRequest) (*evidencesvc.SearchCommittedEvidenceResponse, error) {
+	if len(req.ValidatorAddress) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Validator address cannot be empty")
+	}
+	minHeight, maxHeight := req.MinHeight, req.MaxHeight
+	if minHeight == 0 {
+		minHeight = 1
+	}
+	if maxHeight == 0 {
+		maxHeight = s.store.Height()
+	}
+	if minHeight < 0 || maxHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Height cannot be negative")
+	}
+	if minHeight > maxHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "Minimum height %d is greater than maximum height %d", minHeight, maxHeight)
+	}
+
+	evidence, err := s.pool.SearchCommittedEvidence(req.ValidatorAddress, minHeight, maxHeight)
+	if err != nil {
+		s.logger.Error("Error searching committed evidence", "endpoint", "SearchCommittedEvidence", "err", err)
+		return nil, status.Error(codes.Internal, "Internal server error - see logs for details")
+	}
+	res := &evidencesvc.SearchCommittedEvidenceResponse{
+		Total: uint64(len(evidence)),
+	}
+
+	offset := min(int(req.Offset), len(evidence))
+	evidence = evidence[offset:]
+	if limit := limitOrDefault(req.Limit); len(evidence) > limit {
+		evidence = evidence[:limit]
+	}
+
+	res.Evidence, err = s.evidenceToProto(evidence, "SearchCommittedEvidence")
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *evidenceServiceServer) evidenceToProto(evidence []types.Evidence, endpoint string) ([]*cmtproto.Evidence, error) {
+	pbs := make([]*cmtproto.Evidence, 0, len(evidence))
+	for _, ev := range evidence {
+		pb, err := types.EvidenceToProto(ev)
+		if err != nil {
+			s.logger.Error("Error converting evidence", "endpoint", endpoint, "err", err)
+			return nil, status.Error(codes.Internal, "Internal server error - see logs for details")
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, nil
+}
+
+func limitOrDefault(limit uint32) int {
+	switch {
+	case limit == 0:
+		return defaultLimit
+	case limit > maxLimit:
+		return maxLimit
+	default:
+		return int(limit)
+	}
+}
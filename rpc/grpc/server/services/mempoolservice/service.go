@@ -0,0 +1,338 @@
+package mempoolservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	mempoolsvc "github.com/cometbft/cometbft/api/cometbft/services/mempool/v1"
+	"github.com/cometbft/cometbft/internal/rpctrace"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// defaultListLimit is the number of txs returned by ListTxs if no limit
+	// is given.
+	defaultListLimit = 100
+	// maxListLimit is the maximum number of txs returned by ListTxs.
+	maxListLimit = 1000
+	// newTxsCapacity is the number of new txs buffered for a subscriber
+	// before its subscription is terminated.
+	newTxsCapacity = 1000
+)
+
+// Reactor is the part of the mempool reactor used to submit txs.
+type Reactor interface {
+	// WaitSync returns true while the node is catching up.
+	WaitSync() bool
+	// TryAddTx checks tx with CheckTx and, if valid, adds it to the mempool.
+	TryAddTx(tx types.Tx, sender p2p.Peer) (*abcicli.ReqRes, error)
+}
+
+// laneMempool is implemented by the mempools that expose their lanes.
+type laneMempool interface {
+	Lanes() []mempool.LaneID
+	LaneSizes(lane mempool.LaneID) (numTxs int, bytes int64)
+	LaneTxs(lane mempool.LaneID, offset, limit int) (types.Txs, error)
+}
+
+type mempoolServiceServer struct {
+	reactor  Reactor
+	mempool  mempool.Mempool
+	appConn  proxy.AppConnQuery
+	eventBus *types.EventBus
+	logger   log.Logger
+}
+
+// New creates a new CometBFT mempool service server.
+func New(
+	reactor Reactor,
+	mp mempool.Mempool,
+	appConn proxy.AppConnQuery,
+	eventBus *types.EventBus,
+	logger log.Logger,
+) mempoolsvc.MempoolServiceServer {
+	return &mempoolServiceServer{
+		reactor:  reactor,
+		mempool:  mp,
+		appConn:  appConn,
+		eventBus: eventBus,
+		logger:   logger.With("service", "MempoolService"),
+	}
+}
+
+// BroadcastTx implements v1.MempoolServiceServer BroadcastTx method.
+func (s *mempoolServiceServer) BroadcastTx(ctx context.Context, req *mempoolsvc.BroadcastTxRequest) (*mempoolsvc.BroadcastTxResponse, error) {
+	if s.reactor.WaitSync() {
+		return nil, status.Error(codes.Unavailable, "Node is catching up")
+	}
+
+	tx := types.Tx(req.Tx)
+	reqRes, err := s.reactor.TryAddTx(tx, nil)
+	if err != nil {
+		return nil, status.Error(errorCode(err), err.Error())
+	}
+
+	done := make(chan struct{})
+	go func() {
+		// The ABCI client guarantees that it will eventually call
+		// reqRes.Done(), even in the case of error.
+		reqRes.Wait()
+		close(done)
+	}()
+	select {
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	case <-done:
+	}
+
+	if err := reqRes.Error(); err != nil {
+		return nil, status.Error(errorCode(err), err.Error())
+	}
+	return &mempoolsvc.BroadcastTxResponse{
+		Hash:    tx.Hash(),
+		CheckTx: reqRes.Response.GetCheckTx(),
+	}, nil
+}
+
+// BroadcastTxStream implements v1.MempoolServiceServer BroadcastTxStream
+// method.
+func (s *mempoolServiceServer) BroadcastTxStream(stream mempoolsvc.MempoolService_BroadcastTxStreamServer) error {
+	logger := s.logger.With("endpoint", "BroadcastTxStream")
+
+	var (
+		// gRPC streams do not support concurrent calls to Send.
+		sendMtx sync.Mutex
+		sendErr error
+		pending sync.WaitGroup
+	)
+	send := func(res *mempoolsvc.BroadcastTxResponse) {
+		sendMtx.Lock()
+		defer sendMtx.Unlock()
+		if sendErr != nil {
+			return
+		}
+		if err := stream.Send(res); err != nil {
+			logger.Debug("Failed to send response", "err", err)
+			sendErr = err
+		}
+	}
+	// Results cannot be sent once this method returns.
+	defer pending.Wait()
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		tx := types.Tx(req.Tx)
+		if s.reactor.WaitSync() {
+			send(&mempoolsvc.BroadcastTxResponse{Hash: tx.Hash(), Error: "node is catching up"})
+			continue
+		}
+		reqRes, err := s.reactor.TryAddTx(tx, nil)
+		if err != nil {
+			send(&mempoolsvc.BroadcastTxResponse{Hash: tx.Hash(), Error: err.Error()})
+			continue
+		}
+
+		pending.Add(1)
+		go func() {
+			defer pending.Done()
+			reqRes.Wait()
+			if err := reqRes.Error(); err != nil {
+				send(&mempoolsvc.BroadcastTxResponse{Hash: tx.Hash(), Error: err.Error()})
+				return
+			}
+			send(&mempoolsvc.BroadcastTxResponse{Hash: tx.Hash(), CheckTx: reqRes.Response.GetCheckTx()})
+		}()
+	}
+}
+
+// SimulateTx implements v1.MempoolServiceServer SimulateTx method.
+func (s *mempoolServiceServer) SimulateTx(ctx context.Context, req *mempoolsvc.SimulateTxRequest) (*mempoolsvc.SimulateTxResponse, error) {
+	result, err := proxy.SimulateTx(ctx, s.appConn, req.Tx)
+	if err != nil {
+		var errSimulate proxy.ErrSimulateTx
+		if errors.As(err, &errSimulate) || errors.Is(err, proxy.ErrSimulateTxEmptyResult) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		s.logger.Error("Failed to simulate tx", "endpoint", "SimulateTx", "err", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &mempoolsvc.SimulateTxResponse{Result: result}, nil
+}
+
+// GetUnconfirmedTx implements v1.MempoolServiceServer GetUnconfirmedTx method.
+func (s *mempoolServiceServer) GetUnconfirmedTx(_ context.Context, req *mempoolsvc.GetUnconfirmedTxRequest) (*mempoolsvc.GetUnconfirmedTxResponse, error) {
+	if len(req.Hash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Transaction hash cannot be empty")
+	}
+	tx := s.mempool.GetTxByHash(req.Hash)
+	if tx == nil {
+		return nil, status.Errorf(codes.NotFound, "Transaction %X not found in the mempool", req.Hash)
+	}
+	return &mempoolsvc.GetUnconfirmedTxResponse{Tx: tx}, nil
+}
+
+// ListTxs implements v1.MempoolServiceServer ListTxs method.
+func (s *mempoolServiceServer) ListTxs(_ context.Context, req *mempoolsvc.ListTxsRequest) (*mempoolsvc.ListTxsResponse, error) {
+	mp, err := s.laneMempool()
+	if err != nil {
+		return nil, err
+	}
+	lane := mempool.LaneID(req.Lane)
+	if !hasLane(mp, lane) {
+		return nil, status.Errorf(codes.NotFound, "Lane %q not found", req.Lane)
+	}
+
+	limit := int(req.Limit)
+	switch {
+	case limit == 0:
+		limit = defaultListLimit
+	case limit > maxListLimit:
+		limit = maxListLimit
+	}
+	txs, err := mp.LaneTxs(lane, int(req.Offset), limit)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	total, _ := mp.LaneSizes(lane)
+
+	res := &mempoolsvc.ListTxsResponse{
+		Txs:   make([][]byte, 0, len(txs)),
+		Total: uint64(total),
+	}
+	for _, tx := range txs {
+		res.Txs = append(res.Txs, tx)
+	}
+	return res, nil
+}
+
+// GetLaneSizes implements v1.MempoolServiceServer GetLaneSizes method.
+func (s *mempoolServiceServer) GetLaneSizes(context.Context, *mempoolsvc.GetLaneSizesRequest) (*mempoolsvc.GetLaneSizesResponse, error) {
+	mp, err := s.laneMempool()
+	if err != nil {
+		return nil, err
+	}
+
+	lanes := mp.Lanes()
+	res := &mempoolsvc.GetLaneSizesResponse{
+		Lanes: make([]*mempoolsvc.LaneSize, 0, len(lanes)),
+	}
+	for _, lane := range lanes {
+		numTxs, bytes := mp.LaneSizes(lane)
+		res.Lanes = append(res.Lanes, &mempoolsvc.LaneSize{
+			Lane:     string(lane),
+			NumTxs:   uint64(numTxs),
+			NumBytes: uint64(bytes),
+		})
+	}
+	return res, nil
+}
+
+// SubscribeNewTxs implements v1.MempoolServiceServer SubscribeNewTxs method.
+func (s *mempoolServiceServer) SubscribeNewTxs(_ *mempoolsvc.SubscribeNewTxsRequest, stream mempoolsvc.MempoolService_SubscribeNewTxsServer) error {
+	logger := s.logger.With("endpoint", "SubscribeNewTxs")
+
+	traceID, err := rpctrace.New()
+	if err != nil {
+		logger.Error("Error generating RPC trace ID", "err", err)
+		return status.Error(codes.Internal, "Internal server error")
+	}
+
+	// The trace ID is reused as a unique subscriber ID
+	query := types.QueryForEvent(types.EventPendingTx)
+	sub, err := s.eventBus.Subscribe(stream.Context(), traceID, query, newTxsCapacity)
+	if err != nil {
+		logger.Error("Cannot subscribe to new tx events", "err", err, "traceID", traceID)
+		return status.Errorf(codes.Internal, "Cannot subscribe to new tx events (see logs for trace ID: %s)", traceID)
+	}
+	defer func() {
+		if err := s.eventBus.Unsubscribe(context.Background(), traceID, query); err != nil && !errors.Is(err, cmtpubsub.ErrSubscriptionNotFound) {
+			logger.Error("Failed to unsubscribe from new tx events", "err", err, "traceID", traceID)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case msg := <-sub.Out():
+			data, ok := msg.Data().(types.EventDataPendingTx)
+			if !ok {
+				logger.Error("Unexpected event type", "type", msg.Data(), "traceID", traceID)
+				return status.Errorf(codes.Internal, "Internal server error (see logs for trace ID: %s)", traceID)
+			}
+			tx := types.Tx(data.Tx)
+			if err := stream.Send(&mempoolsvc.SubscribeNewTxsResponse{Tx: tx, Hash: tx.Hash()}); err != nil {
+				logger.Error("Failed to stream new tx", "err", err, "traceID", traceID)
+				return status.Errorf(codes.Unavailable, "Cannot send stream response (see logs for trace ID: %s)", traceID)
+			}
+		case <-sub.Canceled():
+			switch err := sub.Err(); {
+			case errors.Is(err, cmtpubsub.ErrOutOfCapacity):
+				return status.Error(codes.ResourceExhausted, "Subscription terminated: client is too slow")
+			case errors.Is(err, cmtpubsub.ErrUnsubscribed), err == nil:
+				return status.Error(codes.Canceled, "Subscription terminated")
+			default:
+				logger.Info("Subscription canceled with errors", "err", err, "traceID", traceID)
+				return status.Errorf(codes.Canceled, "Subscription canceled with errors (see logs for trace ID: %s)", traceID)
+			}
+		}
+	}
+}
+
+func (s *mempoolServiceServer) laneMempool() (laneMempool, error) {
+	mp, ok := s.mempool.(laneMempool)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "Lanes are not supported by the mempool")
+	}
+	return mp, nil
+}
+
+func hasLane(mp laneMempool, lane mempool.LaneID) bool {
+	for _, l := range mp.Lanes() {
+		if l == lane {
+			return true
+		}
+	}
+	return false
+}
+
+// errorCode returns the gRPC status code corresponding to an error returned
+// when adding a tx to the mempool.
+func errorCode(err error) codes.Code {
+	var (
+		errTooLarge    mempool.ErrTxTooLarge
+		errFull        mempool.ErrMempoolIsFull
+		errLaneFull    mempool.ErrLaneIsFull
+		errPeerQuota   mempool.ErrPeerQuotaExceeded
+		errSenderQuota mempool.ErrSenderQuotaExceeded
+	)
+	switch {
+	case errors.Is(err, mempool.ErrTxInCache), errors.Is(err, mempool.ErrTxInMempool):
+		return codes.AlreadyExists
+	case errors.As(err, &errTooLarge):
+		return codes.InvalidArgument
+	case errors.Is(err, mempool.ErrRecheckFull), errors.As(err, &errFull), errors.As(err, &errLaneFull),
+		errors.As(err, &errPeerQuota), errors.As(err, &errSenderQuota):
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
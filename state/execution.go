@@ -296,6 +296,11 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 		return state, fmt.Errorf("error in next block delay: %w", err)
 	}
 
+	err = validateLanesUpdate(abciResponse.LanesUpdate)
+	if err != nil {
+		return state, fmt.Errorf("error in lanes update: %w", err)
+	}
+
 	// Update the state with the block and responses.
 	state, err = updateState(state, blockID, &block.Header, abciResponse, validatorUpdates)
 	if err != nil {
@@ -447,6 +452,12 @@ func (blockExec *BlockExecutor) asyncUpdateMempool(
 ) {
 	defer unlockMempool()
 
+	// Replace the mempool lanes before rechecking txs, so that new txs are
+	// assigned to the new lanes.
+	if abciResponse.LanesUpdate != nil {
+		blockExec.updateMempoolLanes(abciResponse.LanesUpdate)
+	}
+
 	err := blockExec.mempool.Update(
 		block.Height,
 		block.Txs,
@@ -465,6 +476,23 @@ func (blockExec *BlockExecutor) asyncUpdateMempool(
 	}
 }
 
+// updateMempoolLanes replaces the mempool lanes with the ones requested by the
+// application, if the mempool supports it. The mempool must be locked.
+func (blockExec *BlockExecutor) updateMempoolLanes(update *abci.LanesUpdate) {
+	updater, ok := blockExec.mempool.(mempool.LanesUpdater)
+	if !ok {
+		blockExec.logger.Debug("Mempool does not support updating lanes; ignoring lanes update")
+		return
+	}
+	lanesInfo, err := mempool.BuildLanesInfo(update.LanePriorities, update.DefaultLane)
+	if err != nil {
+		// Should not happen, as the update has already been validated.
+		blockExec.logger.Error("Invalid lanes update", "err", err)
+		return
+	}
+	updater.UpdateLanes(lanesInfo)
+}
+
 // ---------------------------------------------------------
 // Helper functions for executing blocks and updating state
 
@@ -629,6 +657,14 @@ func validateNextBlockDelay(nextBlockDelay time.Duration) error {
 	return nil
 }
 
+func validateLanesUpdate(update *abci.LanesUpdate) error {
+	if update == nil {
+		return nil
+	}
+	_, err := mempool.BuildLanesInfo(update.LanePriorities, update.DefaultLane)
+	return err
+}
+
 // updateState returns a new State updated according to the header and responses.
 func updateState(
 	state State,
@@ -16,11 +16,13 @@ import (
 	abcimocks "github.com/cometbft/cometbft/abci/types/mocks"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
+	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/internal/test"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/mempool"
 	mpmocks "github.com/cometbft/cometbft/mempool/mocks"
 	"github.com/cometbft/cometbft/proxy"
 	pmocks "github.com/cometbft/cometbft/proxy/mocks"
@@ -692,6 +694,51 @@ func TestFinalizeBlockValidatorUpdatesResultingInEmptySet(t *testing.T) {
 	assert.NotEmpty(t, state.NextValidators.Validators)
 }
 
+// TestFinalizeBlockLanesUpdate ensures that the mempool lanes are replaced
+// when requested by the application, and that invalid updates are rejected.
+func TestFinalizeBlockLanesUpdate(t *testing.T) {
+	app := &testApp{}
+	cc := proxy.NewLocalClientCreator(app)
+	proxyApp := proxy.NewAppConns(cc, proxy.NopMetrics())
+	err := proxyApp.Start()
+	require.NoError(t, err)
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, _ := makeState(1, 1, chainID)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	mp := mempool.NewCListMempool(config.TestMempoolConfig(), proxyApp.Mempool(), nil, 0)
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyApp.Consensus(),
+		mp, sm.EmptyEvidencePool{}, blockStore)
+
+	// The default lane must be one of the lanes.
+	app.LanesUpdate = &abci.LanesUpdate{
+		LanePriorities: map[string]uint32{"foo": 1},
+		DefaultLane:    "bar",
+	}
+	block := makeBlock(state, 1, new(types.Commit))
+	bps, err := block.MakePartSet(testPartSize)
+	require.NoError(t, err)
+	blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: bps.Header()}
+	_, err = blockExec.ApplyBlock(state, blockID, block, block.Height)
+	require.ErrorContains(t, err, "lanes update")
+
+	app.LanesUpdate = &abci.LanesUpdate{
+		LanePriorities: map[string]uint32{"foo": 1, "bar": 2},
+		DefaultLane:    "foo",
+	}
+	state, err = blockExec.ApplyBlock(state, blockID, block, block.Height)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		mp.Lock()
+		defer mp.Unlock()
+		return assert.ObjectsAreEqual([]mempool.LaneID{"bar", "foo"}, mp.Lanes())
+	}, time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, 1, state.LastBlockHeight)
+}
+
 func TestEmptyPrepareProposal(t *testing.T) {
 	const height = 2
 	ctx, cancel := context.WithCancel(context.Background())
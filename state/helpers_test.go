@@ -196,6 +196,7 @@ type testApp struct {
 	Misbehavior      []abci.Misbehavior
 	LastTime         time.Time
 	ValidatorUpdates []abci.ValidatorUpdate
+	LanesUpdate      *abci.LanesUpdate
 	AppHash          []byte
 }
 
@@ -219,8 +220,9 @@ func (app *testApp) FinalizeBlock(_ context.Context, req *abci.FinalizeBlockRequ
 				App: 1,
 			},
 		},
-		TxResults: txResults,
-		AppHash:   app.AppHash,
+		TxResults:   txResults,
+		AppHash:     app.AppHash,
+		LanesUpdate: app.LanesUpdate,
 	}, nil
 }
 
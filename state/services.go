@@ -62,7 +62,8 @@ type EmptyEvidencePool struct{}
 func (EmptyEvidencePool) PendingEvidence(int64) (ev []types.Evidence, size int64) {
 	return nil, 0
 }
-func (EmptyEvidencePool) AddEvidence(types.Evidence) error                { return nil }
-func (EmptyEvidencePool) Update(State, types.EvidenceList)                {}
-func (EmptyEvidencePool) CheckEvidence(types.EvidenceList) error          { return nil }
-func (EmptyEvidencePool) ReportConflictingVotes(*types.Vote, *types.Vote) {}
+func (EmptyEvidencePool) AddEvidence(types.Evidence) error                            { return nil }
+func (EmptyEvidencePool) Update(State, types.EvidenceList)                            {}
+func (EmptyEvidencePool) CheckEvidence(types.EvidenceList) error                      { return nil }
+func (EmptyEvidencePool) ReportConflictingVotes(*types.Vote, *types.Vote)             {}
+func (EmptyEvidencePool) ReportConflictingProposals(*types.Proposal, *types.Proposal) {}
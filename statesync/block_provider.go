@@ -0,0 +1,94 @@
+package statesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/internal/evidence"
+	lightprovider "github.com/cometbft/cometbft/light/provider"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/nodekey"
+	"github.com/cometbft/cometbft/types"
+)
+
+// BlockProvider is a light client provider which fetches light blocks from a
+// peer over the state sync LightBlockChannel, instead of from an RPC server.
+type BlockProvider struct {
+	chainID string
+	peer    nodekey.ID
+	reactor *Reactor
+}
+
+var _ lightprovider.Provider = (*BlockProvider)(nil)
+
+// NewBlockProvider creates a light client provider which fetches light blocks
+// from the given peer, using the state sync reactor. The peer doesn't need to
+// be connected yet, requests wait for it to connect.
+func NewBlockProvider(chainID string, peer nodekey.ID, r *Reactor) *BlockProvider {
+	return &BlockProvider{
+		chainID: chainID,
+		peer:    peer,
+		reactor: r,
+	}
+}
+
+// ChainID implements provider.Provider.
+func (p *BlockProvider) ChainID() string {
+	return p.chainID
+}
+
+// String implements fmt.Stringer.
+func (p *BlockProvider) String() string {
+	return fmt.Sprintf("p2p{%s}", p.peer)
+}
+
+// LightBlock implements provider.Provider. It fetches the light block at the
+// given height from the peer and checks the chain ID matches.
+func (p *BlockProvider) LightBlock(ctx context.Context, height int64) (*types.LightBlock, error) {
+	if height < 0 {
+		return nil, lightprovider.ErrBadLightBlock{Reason: lightprovider.ErrNegativeHeight{Height: height}}
+	}
+
+	lb, err := p.reactor.requestLightBlock(ctx, p.peer, uint64(height))
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return nil, lightprovider.ErrNoResponse
+	case err != nil:
+		return nil, err
+	case lb == nil:
+		return nil, lightprovider.ErrLightBlockNotFound
+	}
+
+	if height != 0 && lb.Height != height {
+		return nil, lightprovider.ErrBadLightBlock{
+			Reason: fmt.Errorf("height %d responded doesn't match height %d requested", lb.Height, height),
+		}
+	}
+	if err := lb.ValidateBasic(p.chainID); err != nil {
+		return nil, lightprovider.ErrBadLightBlock{Reason: err}
+	}
+	return lb, nil
+}
+
+// ReportEvidence implements provider.Provider. It sends the evidence to the
+// peer's evidence reactor.
+func (p *BlockProvider) ReportEvidence(ctx context.Context, ev types.Evidence) error {
+	pbev, err := types.EvidenceToProto(ev)
+	if err != nil {
+		return err
+	}
+
+	peer, err := p.reactor.waitForPeer(ctx, p.peer)
+	if err != nil {
+		return err
+	}
+	if !peer.Send(p2p.Envelope{
+		ChannelID: evidence.EvidenceChannel,
+		Message:   &cmtproto.EvidenceList{Evidence: []cmtproto.Evidence{*pbev}},
+	}) {
+		return fmt.Errorf("failed to send evidence to peer %v", p.peer)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/nodekey"
 )
 
@@ -36,6 +37,8 @@ type chunkQueue struct {
 	chunkAllocated map[uint32]bool            // chunks that have been allocated via Allocate()
 	chunkReturned  map[uint32]bool            // chunks returned via Next()
 	waiters        map[uint32][]chan<- uint32 // signals WaitFor() waiters about chunk arrival
+	// outstanding requests of each chunk, with the time they were sent
+	chunkRequests map[uint32]map[nodekey.ID]time.Time
 }
 
 // newChunkQueue creates a new chunk queue for a snapshot, using a temp dir for storage.
@@ -56,6 +59,7 @@ func newChunkQueue(snapshot *snapshot, tempDir string) (*chunkQueue, error) {
 		chunkAllocated: make(map[uint32]bool, snapshot.Chunks),
 		chunkReturned:  make(map[uint32]bool, snapshot.Chunks),
 		waiters:        make(map[uint32][]chan<- uint32),
+		chunkRequests:  make(map[uint32]map[nodekey.ID]time.Time),
 	}, nil
 }
 
@@ -120,6 +124,60 @@ func (q *chunkQueue) Allocate() (uint32, error) {
 	return 0, errDone
 }
 
+// AllocatePeer picks the peer to request a chunk from, among the given peers,
+// and records the request. It picks the peer expected to deliver the chunk the
+// soonest, given its bandwidth, reliability and outstanding requests, skipping
+// the peers the chunk was already requested from. It returns nil if there is
+// no suitable peer. The caller must send the request.
+func (q *chunkQueue) AllocatePeer(index uint32, peers []p2p.Peer, scores *peerScores) p2p.Peer {
+	q.Lock()
+	defer q.Unlock()
+	if q.snapshot == nil {
+		return nil
+	}
+
+	exclude := make(map[nodekey.ID]bool, len(q.chunkRequests[index]))
+	for peerID := range q.chunkRequests[index] {
+		exclude[peerID] = true
+	}
+	peer := scores.pick(peers, exclude)
+	if peer == nil {
+		return nil
+	}
+	if q.chunkRequests[index] == nil {
+		q.chunkRequests[index] = make(map[nodekey.ID]time.Time)
+	}
+	q.chunkRequests[index][peer.ID()] = time.Now()
+	scores.requested(peer.ID())
+	return peer
+}
+
+// Received removes the request of a chunk to the given peer, returning how
+// long the peer took to respond. It returns false if there was no such request.
+func (q *chunkQueue) Received(index uint32, peerID nodekey.ID) (time.Duration, bool) {
+	q.Lock()
+	defer q.Unlock()
+	sent, ok := q.chunkRequests[index][peerID]
+	if !ok {
+		return 0, false
+	}
+	delete(q.chunkRequests[index], peerID)
+	return time.Since(sent), true
+}
+
+// CancelRequests removes the outstanding requests of a chunk, returning the
+// peers they were sent to.
+func (q *chunkQueue) CancelRequests(index uint32) []nodekey.ID {
+	q.Lock()
+	defer q.Unlock()
+	peerIDs := make([]nodekey.ID, 0, len(q.chunkRequests[index]))
+	for peerID := range q.chunkRequests[index] {
+		peerIDs = append(peerIDs, peerID)
+	}
+	delete(q.chunkRequests, index)
+	return peerIDs
+}
+
 // Close closes the chunk queue, cleaning up all temporary files.
 func (q *chunkQueue) Close() error {
 	q.Lock()
@@ -285,6 +343,13 @@ func (q *chunkQueue) RetryAll() {
 	q.chunkReturned = make(map[uint32]bool)
 }
 
+// Fetched returns the number of chunks in the queue.
+func (q *chunkQueue) Fetched() uint32 {
+	q.Lock()
+	defer q.Unlock()
+	return uint32(len(q.chunkFiles))
+}
+
 // Size returns the total number of chunks for the snapshot and queue, or 0 when closed.
 func (q *chunkQueue) Size() uint32 {
 	q.Lock()
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/nodekey"
 )
 
@@ -548,3 +549,38 @@ func TestChunkQueue_WaitFor(t *testing.T) {
 	_, ok = <-w
 	assert.False(t, ok)
 }
+
+func TestChunkQueue_AllocatePeer(t *testing.T) {
+	queue, teardown := setupChunkQueue(t)
+	defer teardown()
+
+	scores := newPeerScores(NopMetrics())
+	peers := []p2p.Peer{simplePeer("a"), simplePeer("b")}
+
+	// The chunk is requested from each peer once.
+	first := queue.AllocatePeer(2, peers, scores)
+	require.NotNil(t, first)
+	second := queue.AllocatePeer(2, peers, scores)
+	require.NotNil(t, second)
+	assert.NotEqual(t, first.ID(), second.ID())
+	assert.Nil(t, queue.AllocatePeer(2, peers, scores))
+
+	// Other chunks can still be requested from both.
+	assert.NotNil(t, queue.AllocatePeer(3, peers, scores))
+
+	_, ok := queue.Received(2, "c")
+	assert.False(t, ok)
+	_, ok = queue.Received(2, first.ID())
+	assert.True(t, ok)
+	_, ok = queue.Received(2, first.ID())
+	assert.False(t, ok)
+
+	assert.Equal(t, []nodekey.ID{second.ID()}, queue.CancelRequests(2))
+	assert.Empty(t, queue.CancelRequests(2))
+
+	// Once its requests are canceled, the chunk can be requested again.
+	assert.NotNil(t, queue.AllocatePeer(2, peers, scores))
+
+	require.NoError(t, queue.Close())
+	assert.Nil(t, queue.AllocatePeer(4, peers, scores))
+}
@@ -0,0 +1,109 @@
+package statesync
+
+import (
+	"errors"
+	"fmt"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p/nodekey"
+	"github.com/cometbft/cometbft/types"
+)
+
+var errRequestInProgress = errors.New("a request for this height is already in progress")
+
+// requestKey identifies a light block or consensus params request sent to a
+// peer. Peers echo the requested height back, so it's enough to match a
+// response to its request.
+type requestKey struct {
+	peer   nodekey.ID
+	height uint64
+}
+
+// dispatcher keeps track of the light block and consensus params requests
+// sent to peers, and hands the responses over to the callers waiting for them.
+type dispatcher struct {
+	mtx         cmtsync.Mutex
+	lightBlocks map[requestKey]chan *types.LightBlock
+	params      map[requestKey]chan types.ConsensusParams
+}
+
+func newDispatcher() *dispatcher {
+	return &dispatcher{
+		lightBlocks: make(map[requestKey]chan *types.LightBlock),
+		params:      make(map[requestKey]chan types.ConsensusParams),
+	}
+}
+
+// addLightBlockRequest registers a light block request, returning the
+// channel the response is delivered on. The caller must call
+// removeLightBlockRequest once it stops waiting.
+func (d *dispatcher) addLightBlockRequest(peer nodekey.ID, height uint64) (<-chan *types.LightBlock, error) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	key := requestKey{peer: peer, height: height}
+	if _, ok := d.lightBlocks[key]; ok {
+		return nil, errRequestInProgress
+	}
+	ch := make(chan *types.LightBlock, 1)
+	d.lightBlocks[key] = ch
+	return ch, nil
+}
+
+func (d *dispatcher) removeLightBlockRequest(peer nodekey.ID, height uint64) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	delete(d.lightBlocks, requestKey{peer: peer, height: height})
+}
+
+// respondLightBlock delivers a light block response. It errors if there is no
+// pending request matching the response, i.e. the peer sent it unsolicited or
+// the caller stopped waiting.
+func (d *dispatcher) respondLightBlock(peer nodekey.ID, height uint64, lb *types.LightBlock) error {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	key := requestKey{peer: peer, height: height}
+	ch, ok := d.lightBlocks[key]
+	if !ok {
+		return fmt.Errorf("unsolicited light block response for height %d", height)
+	}
+	// The channel is buffered and removed once written to, so this never blocks.
+	ch <- lb
+	delete(d.lightBlocks, key)
+	return nil
+}
+
+// addParamsRequest registers a consensus params request, returning the
+// channel the response is delivered on. The caller must call
+// removeParamsRequest once it stops waiting.
+func (d *dispatcher) addParamsRequest(peer nodekey.ID, height uint64) (<-chan types.ConsensusParams, error) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	key := requestKey{peer: peer, height: height}
+	if _, ok := d.params[key]; ok {
+		return nil, errRequestInProgress
+	}
+	ch := make(chan types.ConsensusParams, 1)
+	d.params[key] = ch
+	return ch, nil
+}
+
+func (d *dispatcher) removeParamsRequest(peer nodekey.ID, height uint64) {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	delete(d.params, requestKey{peer: peer, height: height})
+}
+
+// respondParams delivers a consensus params response. It errors if there is
+// no pending request matching the response.
+func (d *dispatcher) respondParams(peer nodekey.ID, height uint64, params types.ConsensusParams) error {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	key := requestKey{peer: peer, height: height}
+	ch, ok := d.params[key]
+	if !ok {
+		return fmt.Errorf("unsolicited consensus params response for height %d", height)
+	}
+	ch <- params
+	delete(d.params, key)
+	return nil
+}
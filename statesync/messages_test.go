@@ -13,6 +13,10 @@ import (
 )
 
 func TestValidateMsg(t *testing.T) {
+	params := types.DefaultConsensusParams().ToProto()
+	withoutFeatureParams := types.DefaultConsensusParams().ToProto()
+	withoutFeatureParams.Feature = nil
+
 	testcases := map[string]struct {
 		msg   proto.Message
 		valid bool
@@ -84,6 +88,30 @@ func TestValidateMsg(t *testing.T) {
 			&ssproto.SnapshotsResponse{Height: 1, Format: 1, Chunks: 2, Hash: []byte{}},
 			false,
 		},
+
+		"LightBlockRequest valid":    {&ssproto.LightBlockRequest{Height: 1}, true},
+		"LightBlockRequest 0 height": {&ssproto.LightBlockRequest{Height: 0}, true},
+
+		"LightBlockResponse valid": {
+			&ssproto.LightBlockResponse{Height: 1, LightBlock: &cmtproto.LightBlock{SignedHeader: &cmtproto.SignedHeader{}}},
+			true,
+		},
+		"LightBlockResponse missing": {&ssproto.LightBlockResponse{Height: 1}, true},
+		"LightBlockResponse no signed header": {
+			&ssproto.LightBlockResponse{Height: 1, LightBlock: &cmtproto.LightBlock{}},
+			false,
+		},
+
+		"ParamsRequest valid":    {&ssproto.ParamsRequest{Height: 1}, true},
+		"ParamsRequest 0 height": {&ssproto.ParamsRequest{Height: 0}, false},
+
+		"ParamsResponse valid":     {&ssproto.ParamsResponse{Height: 1, ConsensusParams: params}, true},
+		"ParamsResponse 0 height":  {&ssproto.ParamsResponse{Height: 0, ConsensusParams: params}, false},
+		"ParamsResponse no params": {&ssproto.ParamsResponse{Height: 1}, false},
+		"ParamsResponse no feature params": {
+			&ssproto.ParamsResponse{Height: 1, ConsensusParams: withoutFeatureParams},
+			false,
+		},
 	}
 	for name, tc := range testcases {
 		t.Run(name, func(t *testing.T) {
@@ -108,6 +136,8 @@ func TestStateSyncVectors(t *testing.T) {
 		{"SnapshotsResponse", &ssproto.SnapshotsResponse{Height: 1, Format: 2, Chunks: 3, Hash: []byte("chuck hash"), Metadata: []byte("snapshot metadata")}, "1225080110021803220a636875636b20686173682a11736e617073686f74206d65746164617461"},
 		{"ChunkRequest", &ssproto.ChunkRequest{Height: 1, Format: 2, Index: 3}, "1a06080110021803"},
 		{"ChunkResponse", &ssproto.ChunkResponse{Height: 1, Format: 2, Index: 3, Chunk: []byte("it's a chunk")}, "2214080110021803220c697427732061206368756e6b"},
+		{"LightBlockRequest", &ssproto.LightBlockRequest{Height: 1}, "2a020801"},
+		{"ParamsRequest", &ssproto.ParamsRequest{Height: 1}, "3a020801"},
 	}
 
 	for _, tc := range testCases {
@@ -20,11 +20,69 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "syncing",
 			Help:      "Whether or not a node is state syncing. 1 if yes, 0 if no.",
 		}, labels).With(labelsAndValues...),
+		SnapshotHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "snapshot_height",
+			Help:      "The height of the snapshot being restored.",
+		}, labels).With(labelsAndValues...),
+		SnapshotChunkTotal: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "snapshot_chunk_total",
+			Help:      "The number of chunks of the snapshot being restored.",
+		}, labels).With(labelsAndValues...),
+		SnapshotChunksApplied: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "snapshot_chunks_applied",
+			Help:      "The number of chunks of the snapshot applied so far.",
+		}, labels).With(labelsAndValues...),
+		PeerChunksReceived: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_chunks_received",
+			Help:      "Number of chunks received from a peer.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerChunkFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_chunk_failures",
+			Help:      "Number of chunk requests a peer failed to respond to, because they timed out or the peer didn't have the chunk.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerChunkLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_chunk_latency_seconds",
+			Help:      "Time for a peer to respond to a chunk request, in seconds.",
+
+			Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120},
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		PeerScore: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_score",
+			Help:      "Score of a peer as a chunk provider: its bandwidth in bytes/s, weighted by the share of requests it responded to.",
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		HedgedChunkRequests: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "hedged_chunk_requests",
+			Help:      "Number of chunk requests sent to another peer because the first peer was slow to respond.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
 func NopMetrics() *Metrics {
 	return &Metrics{
-		Syncing: discard.NewGauge(),
+		Syncing:                 discard.NewGauge(),
+		SnapshotHeight:          discard.NewGauge(),
+		SnapshotChunkTotal:      discard.NewGauge(),
+		SnapshotChunksApplied:   discard.NewGauge(),
+		PeerChunksReceived:      discard.NewCounter(),
+		PeerChunkFailures:       discard.NewCounter(),
+		PeerChunkLatencySeconds: discard.NewHistogram(),
+		PeerScore:               discard.NewGauge(),
+		HedgedChunkRequests:     discard.NewCounter(),
 	}
 }
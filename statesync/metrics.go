@@ -16,4 +16,23 @@ const (
 type Metrics struct {
 	// Whether or not a node is state syncing. 1 if yes, 0 if no.
 	Syncing metrics.Gauge
+	// The height of the snapshot being restored.
+	SnapshotHeight metrics.Gauge
+	// The number of chunks of the snapshot being restored.
+	SnapshotChunkTotal metrics.Gauge
+	// The number of chunks of the snapshot applied so far.
+	SnapshotChunksApplied metrics.Gauge
+	// Number of chunks received from a peer.
+	PeerChunksReceived metrics.Counter `metrics_labels:"peer_id"`
+	// Number of chunk requests a peer failed to respond to, because they timed
+	// out or the peer didn't have the chunk.
+	PeerChunkFailures metrics.Counter `metrics_labels:"peer_id"`
+	// Time for a peer to respond to a chunk request, in seconds.
+	PeerChunkLatencySeconds metrics.Histogram `metrics_bucketsizes:"0.1,0.5,1,5,10,30,60,120" metrics_labels:"peer_id"`
+	// Score of a peer as a chunk provider: its bandwidth in bytes/s, weighted
+	// by the share of requests it responded to.
+	PeerScore metrics.Gauge `metrics_labels:"peer_id"`
+	// Number of chunk requests sent to another peer because the first peer was
+	// slow to respond.
+	HedgedChunkRequests metrics.Counter
 }
@@ -0,0 +1,250 @@
+package statesync
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/nodekey"
+)
+
+const (
+	// peerStatsWeight is the weight of the latest sample in the moving
+	// averages of the latency and bandwidth of a peer.
+	peerStatsWeight = 0.3
+
+	// minHedgeDelay and hedgeLatencyFactor define how long to wait for a chunk
+	// before requesting it from another peer as well: hedgeLatencyFactor times
+	// the expected latency of the peer, but no less than minHedgeDelay.
+	minHedgeDelay      = 5 * time.Second
+	hedgeLatencyFactor = 3
+)
+
+// peerStats are the statistics of a peer as a chunk provider.
+type peerStats struct {
+	chunksReceived uint64
+	failures       uint64
+	rejected       bool
+	outstanding    int           // chunk requests awaiting a response
+	latency        time.Duration // moving average of the chunk response latency
+	bandwidth      float64       // moving average of the bandwidth, in bytes/s
+}
+
+// reliability is the share of the chunk requests the peer responded to,
+// counting one optimistic success so that new peers aren't penalized.
+func (ps *peerStats) reliability() float64 {
+	return float64(ps.chunksReceived+1) / float64(ps.chunksReceived+ps.failures+1)
+}
+
+// PeerStatus is the status of a peer as a chunk provider.
+type PeerStatus struct {
+	ID             nodekey.ID
+	Score          float64
+	ChunksReceived uint64
+	Failures       uint64
+	Outstanding    int
+	Latency        time.Duration
+	Bandwidth      float64
+	Rejected       bool
+}
+
+// peerScores scores peers as chunk providers, based on the latency and the
+// bandwidth of their chunk responses, the requests they failed to respond to,
+// and whether the app rejected them as senders. The score of a peer is its
+// bandwidth, weighted by its reliability.
+type peerScores struct {
+	mtx     cmtsync.Mutex
+	peers   map[nodekey.ID]*peerStats
+	metrics *Metrics
+}
+
+func newPeerScores(metrics *Metrics) *peerScores {
+	return &peerScores{
+		peers:   make(map[nodekey.ID]*peerStats),
+		metrics: metrics,
+	}
+}
+
+// get returns the statistics of a peer. The caller must hold the mutex lock.
+func (s *peerScores) get(peerID nodekey.ID) *peerStats {
+	ps, ok := s.peers[peerID]
+	if !ok {
+		ps = &peerStats{}
+		s.peers[peerID] = ps
+	}
+	return ps
+}
+
+// score returns the score of a peer. The caller must hold the mutex lock.
+func (*peerScores) score(ps *peerStats) float64 {
+	if ps.rejected {
+		return 0
+	}
+	return ps.bandwidth * ps.reliability()
+}
+
+func (s *peerScores) updateScoreMetric(peerID nodekey.ID, ps *peerStats) {
+	s.metrics.PeerScore.With("peer_id", string(peerID)).Set(s.score(ps))
+}
+
+// requested records a chunk request sent to a peer.
+func (s *peerScores) requested(peerID nodekey.ID) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.get(peerID).outstanding++
+}
+
+// received records the response of a peer to a chunk request, holding a chunk
+// of the given size.
+func (s *peerScores) received(peerID nodekey.ID, latency time.Duration, size int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	ps := s.get(peerID)
+	if ps.outstanding > 0 {
+		ps.outstanding--
+	}
+	ps.chunksReceived++
+	bandwidth := float64(size) / math.Max(latency.Seconds(), 1e-3)
+	if ps.chunksReceived == 1 {
+		ps.latency, ps.bandwidth = latency, bandwidth
+	} else {
+		ps.latency = time.Duration(peerStatsWeight*float64(latency) + (1-peerStatsWeight)*float64(ps.latency))
+		ps.bandwidth = peerStatsWeight*bandwidth + (1-peerStatsWeight)*ps.bandwidth
+	}
+
+	s.metrics.PeerChunksReceived.With("peer_id", string(peerID)).Add(1)
+	s.metrics.PeerChunkLatencySeconds.With("peer_id", string(peerID)).Observe(latency.Seconds())
+	s.updateScoreMetric(peerID, ps)
+}
+
+// failed records a chunk request that a peer failed to respond to, either
+// because it timed out or because the peer didn't have the chunk.
+func (s *peerScores) failed(peerID nodekey.ID) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	ps := s.get(peerID)
+	if ps.outstanding > 0 {
+		ps.outstanding--
+	}
+	ps.failures++
+
+	s.metrics.PeerChunkFailures.With("peer_id", string(peerID)).Add(1)
+	s.updateScoreMetric(peerID, ps)
+}
+
+// canceled records a chunk request to a peer that is no longer needed,
+// because another peer responded first.
+func (s *peerScores) canceled(peerID nodekey.ID) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	ps := s.get(peerID)
+	if ps.outstanding > 0 {
+		ps.outstanding--
+	}
+}
+
+// reject records a peer rejected by the app as a chunk sender. It is never
+// picked again.
+func (s *peerScores) reject(peerID nodekey.ID) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	ps := s.get(peerID)
+	ps.rejected = true
+	s.updateScoreMetric(peerID, ps)
+}
+
+// hedgeDelay returns how long to wait for a chunk requested from a peer
+// before requesting it from another peer as well.
+func (s *peerScores) hedgeDelay(peerID nodekey.ID) time.Duration {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	ps, ok := s.peers[peerID]
+	if !ok {
+		return minHedgeDelay
+	}
+	return max(minHedgeDelay, hedgeLatencyFactor*ps.latency)
+}
+
+// pick returns the peer expected to deliver a new chunk the soonest, given
+// its bandwidth, reliability and outstanding requests, or nil if there is no
+// suitable peer. Peers without statistics are assumed to be as good as the
+// best known peer, so that they get tried. Rejected and excluded peers are
+// never picked.
+func (s *peerScores) pick(peers []p2p.Peer, exclude map[nodekey.ID]bool) p2p.Peer {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	bestScore := 0.0
+	for _, ps := range s.peers {
+		bestScore = math.Max(bestScore, s.score(ps))
+	}
+
+	var (
+		best     p2p.Peer
+		bestCost = math.Inf(1)
+	)
+	for _, peer := range peers {
+		if exclude[peer.ID()] {
+			continue
+		}
+		ps, ok := s.peers[peer.ID()]
+		if ok && ps.rejected {
+			continue
+		}
+
+		score, outstanding := bestScore, 0
+		if ok {
+			outstanding = ps.outstanding
+			if ps.chunksReceived > 0 {
+				score = s.score(ps)
+			} else {
+				score *= ps.reliability()
+			}
+		}
+		// The cost is proportional to the expected time to receive the new
+		// chunk after the outstanding ones. If no peer sent a chunk yet,
+		// peers are only balanced by their outstanding requests.
+		cost := float64(outstanding + 1)
+		if score > 0 {
+			cost /= score
+		}
+		if cost < bestCost {
+			best, bestCost = peer, cost
+		}
+	}
+	return best
+}
+
+// status returns the status of all the peers, sorted by decreasing score.
+func (s *peerScores) status() []PeerStatus {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	statuses := make([]PeerStatus, 0, len(s.peers))
+	for id, ps := range s.peers {
+		statuses = append(statuses, PeerStatus{
+			ID:             id,
+			Score:          s.score(ps),
+			ChunksReceived: ps.chunksReceived,
+			Failures:       ps.failures,
+			Outstanding:    ps.outstanding,
+			Latency:        ps.latency,
+			Bandwidth:      ps.bandwidth,
+			Rejected:       ps.rejected,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if statuses[i].Score != statuses[j].Score {
+			return statuses[i].Score > statuses[j].Score
+		}
+		return statuses[i].ID < statuses[j].ID
+	})
+	return statuses
+}
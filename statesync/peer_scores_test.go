@@ -0,0 +1,61 @@
+package statesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/nodekey"
+)
+
+func TestPeerScores_Pick(t *testing.T) {
+	scores := newPeerScores(NopMetrics())
+	peerA, peerB, peerC := simplePeer("a"), simplePeer("b"), simplePeer("c")
+	peers := []p2p.Peer{peerA, peerB, peerC}
+
+	// Without statistics, peers are balanced by their outstanding requests.
+	scores.requested("a")
+	scores.requested("b")
+	assert.Equal(t, peerC, scores.pick(peers, nil))
+	scores.received("a", time.Second, 1000)
+	scores.received("b", 100*time.Millisecond, 1000)
+
+	// b has 10 times the bandwidth of a, so it gets picked until it has 10
+	// times more outstanding requests. c is as good as the best known peer.
+	assert.Equal(t, peerB, scores.pick([]p2p.Peer{peerA, peerB}, nil))
+	for i := 0; i < 10; i++ {
+		scores.requested("b")
+	}
+	assert.Equal(t, peerA, scores.pick([]p2p.Peer{peerA, peerB}, nil))
+	assert.Equal(t, peerC, scores.pick(peers, nil))
+
+	// Excluded and rejected peers are never picked.
+	assert.Equal(t, peerB, scores.pick(peers, map[nodekey.ID]bool{"a": true, "c": true}))
+	scores.reject("b")
+	assert.Nil(t, scores.pick([]p2p.Peer{peerB}, nil))
+}
+
+func TestPeerScores_Failures(t *testing.T) {
+	scores := newPeerScores(NopMetrics())
+	for _, id := range []nodekey.ID{"a", "b"} {
+		scores.requested(id)
+		scores.received(id, time.Second, 1000)
+	}
+	scores.requested("a")
+	scores.failed("a")
+
+	status := scores.status()
+	require.Len(t, status, 2)
+	assert.EqualValues(t, "b", status[0].ID)
+	assert.EqualValues(t, "a", status[1].ID)
+	assert.Less(t, status[1].Score, status[0].Score)
+	assert.EqualValues(t, 1, status[1].Failures)
+	assert.Zero(t, status[1].Outstanding)
+
+	assert.Equal(t, minHedgeDelay, scores.hedgeDelay("c"))
+	scores.received("b", 10*time.Second, 1000)
+	assert.Greater(t, scores.hedgeDelay("b"), minHedgeDelay)
+}
@@ -5,14 +5,17 @@ package statesync
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
 	"github.com/cometbft/cometbft/config"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/nodekey"
 	tcpconn "github.com/cometbft/cometbft/p2p/transport/tcp/conn"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
@@ -24,8 +27,19 @@ const (
 	SnapshotChannel = byte(0x60)
 	// ChunkChannel exchanges chunk contents.
 	ChunkChannel = byte(0x61)
+	// LightBlockChannel exchanges light blocks, for light clients using peers
+	// as providers.
+	LightBlockChannel = byte(0x62)
+	// ParamsChannel exchanges consensus params.
+	ParamsChannel = byte(0x63)
 	// recentSnapshots is the number of recent snapshots to send and receive per peer.
 	recentSnapshots = 10
+	// requestTimeout is how long to wait for a peer to connect and respond to a
+	// light block or consensus params request.
+	requestTimeout = 10 * time.Second
+	// peerPollInterval is how often to check whether a peer has connected,
+	// before sending it a request.
+	peerPollInterval = 100 * time.Millisecond
 )
 
 // Reactor handles state sync, both restoring snapshots for the local node and serving snapshots
@@ -39,6 +53,13 @@ type Reactor struct {
 	tempDir   string
 	metrics   *Metrics
 
+	// Used to serve light blocks and consensus params to peers.
+	stateStore sm.Store
+	blockStore sm.BlockStore
+
+	// Matches light block and consensus params responses to their requests.
+	dispatcher *dispatcher
+
 	// This will only be set when a state sync is in progress. It is used to feed received
 	// snapshots and chunks into the sync.
 	mtx    cmtsync.RWMutex
@@ -50,13 +71,18 @@ func NewReactor(
 	cfg config.StateSyncConfig,
 	conn proxy.AppConnSnapshot,
 	connQuery proxy.AppConnQuery,
+	stateStore sm.Store,
+	blockStore sm.BlockStore,
 	metrics *Metrics,
 ) *Reactor {
 	r := &Reactor{
-		cfg:       cfg,
-		conn:      conn,
-		connQuery: connQuery,
-		metrics:   metrics,
+		cfg:        cfg,
+		conn:       conn,
+		connQuery:  connQuery,
+		stateStore: stateStore,
+		blockStore: blockStore,
+		metrics:    metrics,
+		dispatcher: newDispatcher(),
 	}
 	r.BaseReactor = *p2p.NewBaseReactor("StateSync", r)
 
@@ -80,6 +106,20 @@ func (*Reactor) StreamDescriptors() []p2p.StreamDescriptor {
 			RecvMessageCapacity: chunkMsgSize,
 			MessageTypeI:        &ssproto.Message{},
 		},
+		&tcpconn.ChannelDescriptor{
+			ID:                  LightBlockChannel,
+			Priority:            5,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: lightBlockMsgSize,
+			MessageTypeI:        &ssproto.Message{},
+		},
+		&tcpconn.ChannelDescriptor{
+			ID:                  ParamsChannel,
+			Priority:            2,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: paramsMsgSize,
+			MessageTypeI:        &ssproto.Message{},
+		},
 	}
 }
 
@@ -223,6 +263,70 @@ func (r *Reactor) Receive(e p2p.Envelope) {
 			r.Logger.Error("Received unknown message %T", msg)
 		}
 
+	case LightBlockChannel:
+		switch msg := e.Message.(type) {
+		case *ssproto.LightBlockRequest:
+			r.Logger.Debug("Received light block request", "height", msg.Height, "peer", e.Src.ID())
+			lb, err := r.fetchLightBlock(msg.Height)
+			if err != nil {
+				r.Logger.Error("Failed to fetch light block", "height", msg.Height, "err", err)
+				return
+			}
+			e.Src.Send(p2p.Envelope{
+				ChannelID: LightBlockChannel,
+				Message: &ssproto.LightBlockResponse{
+					Height:     msg.Height,
+					LightBlock: lb,
+				},
+			})
+
+		case *ssproto.LightBlockResponse:
+			var lb *types.LightBlock
+			if msg.LightBlock != nil {
+				lb, err = types.LightBlockFromProto(msg.LightBlock)
+				if err != nil {
+					r.Logger.Error("Invalid light block", "peer", e.Src, "err", err)
+					r.Switch.StopPeerForError(e.Src, err)
+					return
+				}
+			}
+			if err := r.dispatcher.respondLightBlock(e.Src.ID(), msg.Height, lb); err != nil {
+				// Most likely a late response to a request that timed out.
+				r.Logger.Debug("Failed to deliver light block", "height", msg.Height, "peer", e.Src.ID(), "err", err)
+			}
+
+		default:
+			r.Logger.Error("Received unknown message %T", msg)
+		}
+
+	case ParamsChannel:
+		switch msg := e.Message.(type) {
+		case *ssproto.ParamsRequest:
+			r.Logger.Debug("Received consensus params request", "height", msg.Height, "peer", e.Src.ID())
+			params, err := r.stateStore.LoadConsensusParams(int64(msg.Height))
+			if err != nil {
+				// The requester will time out and try another peer.
+				r.Logger.Error("Failed to load consensus params", "height", msg.Height, "err", err)
+				return
+			}
+			e.Src.Send(p2p.Envelope{
+				ChannelID: ParamsChannel,
+				Message: &ssproto.ParamsResponse{
+					Height:          msg.Height,
+					ConsensusParams: params.ToProto(),
+				},
+			})
+
+		case *ssproto.ParamsResponse:
+			params := types.ConsensusParamsFromProto(msg.ConsensusParams)
+			if err := r.dispatcher.respondParams(e.Src.ID(), msg.Height, params); err != nil {
+				r.Logger.Debug("Failed to deliver consensus params", "height", msg.Height, "peer", e.Src.ID(), "err", err)
+			}
+
+		default:
+			r.Logger.Error("Received unknown message %T", msg)
+		}
+
 	default:
 		r.Logger.Error("Received message on invalid channel %x", e.ChannelID)
 	}
@@ -262,6 +366,140 @@ func (r *Reactor) recentSnapshots(n uint32) ([]*snapshot, error) {
 	return snapshots, nil
 }
 
+// fetchLightBlock builds the light block at the given height from the block
+// and state stores, or the latest one if height is 0. It returns nil if the
+// light block is not available, e.g. because it's been pruned.
+func (r *Reactor) fetchLightBlock(height uint64) (*cmtproto.LightBlock, error) {
+	h := int64(height)
+	if h == 0 {
+		h = r.blockStore.Height()
+	}
+	if h < r.blockStore.Base() || h > r.blockStore.Height() {
+		return nil, nil
+	}
+
+	meta := r.blockStore.LoadBlockMeta(h)
+	if meta == nil {
+		return nil, nil
+	}
+	// The commit for the latest block is only available as the seen commit.
+	commit := r.blockStore.LoadBlockCommit(h)
+	if commit == nil {
+		commit = r.blockStore.LoadSeenCommit(h)
+		if commit == nil {
+			return nil, nil
+		}
+	}
+	vals, err := r.stateStore.LoadValidators(h)
+	if err != nil {
+		return nil, err
+	}
+
+	lb := &types.LightBlock{
+		SignedHeader: &types.SignedHeader{
+			Header: &meta.Header,
+			Commit: commit,
+		},
+		ValidatorSet: vals,
+	}
+	return lb.ToProto()
+}
+
+// waitForPeer waits for the peer with the given ID to connect.
+func (r *Reactor) waitForPeer(ctx context.Context, id nodekey.ID) (p2p.Peer, error) {
+	ticker := time.NewTicker(peerPollInterval)
+	defer ticker.Stop()
+	for {
+		if peer := r.Switch.Peers().Get(id); peer != nil {
+			return peer, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// requestLightBlock requests the light block at the given height from a peer,
+// waiting up to requestTimeout for the peer to connect and respond. It returns
+// nil if the peer doesn't have the light block.
+func (r *Reactor) requestLightBlock(ctx context.Context, id nodekey.ID, height uint64) (*types.LightBlock, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	peer, err := r.waitForPeer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := r.dispatcher.addLightBlockRequest(id, height)
+	if err != nil {
+		return nil, err
+	}
+	defer r.dispatcher.removeLightBlockRequest(id, height)
+
+	if !peer.Send(p2p.Envelope{
+		ChannelID: LightBlockChannel,
+		Message:   &ssproto.LightBlockRequest{Height: height},
+	}) {
+		return nil, fmt.Errorf("failed to send light block request to peer %v", id)
+	}
+	select {
+	case lb := <-ch:
+		return lb, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// requestParams requests the consensus params at the given height from a
+// peer, waiting up to requestTimeout for the peer to connect and respond.
+func (r *Reactor) requestParams(ctx context.Context, id nodekey.ID, height uint64) (types.ConsensusParams, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	peer, err := r.waitForPeer(ctx, id)
+	if err != nil {
+		return types.ConsensusParams{}, err
+	}
+	ch, err := r.dispatcher.addParamsRequest(id, height)
+	if err != nil {
+		return types.ConsensusParams{}, err
+	}
+	defer r.dispatcher.removeParamsRequest(id, height)
+
+	if !peer.Send(p2p.Envelope{
+		ChannelID: ParamsChannel,
+		Message:   &ssproto.ParamsRequest{Height: height},
+	}) {
+		return types.ConsensusParams{}, fmt.Errorf("failed to send consensus params request to peer %v", id)
+	}
+	select {
+	case params := <-ch:
+		return params, nil
+	case <-ctx.Done():
+		return types.ConsensusParams{}, ctx.Err()
+	}
+}
+
+// SyncStatus returns the progress of the state sync in progress, if any.
+func (r *Reactor) SyncStatus() SyncStatus {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	if r.syncer == nil {
+		return SyncStatus{}
+	}
+	return r.syncer.Status()
+}
+
+// reportPeer reports a behaviour of a peer to the switch, e.g. a peer that
+// didn't send a requested chunk in time.
+func (r *Reactor) reportPeer(id nodekey.ID, behaviour p2p.PeerBehaviour) {
+	if peer := r.Switch.Peers().Get(id); peer != nil {
+		r.Switch.ReportBehaviour(peer, behaviour)
+	}
+}
+
 // Sync runs a state sync, returning the new state and last commit at the snapshot height.
 // The caller must store the state and commit in the state database and block store.
 func (r *Reactor) Sync(stateProvider StateProvider, maxDiscoveryTime time.Duration) (sm.State, *types.Commit, error) {
@@ -271,7 +509,8 @@ func (r *Reactor) Sync(stateProvider StateProvider, maxDiscoveryTime time.Durati
 		return sm.State{}, nil, errors.New("a state sync is already in progress")
 	}
 	r.metrics.Syncing.Set(1)
-	r.syncer = newSyncer(r.cfg, r.Logger, r.conn, r.connQuery, stateProvider, r.tempDir)
+	r.syncer = newSyncer(r.cfg, r.Logger, r.conn, r.connQuery, stateProvider, r.tempDir, r.metrics)
+	r.syncer.onSlowPeer = func(id nodekey.ID) { r.reportPeer(id, p2p.BehaviourSlowResponse) }
 	r.mtx.Unlock()
 
 	hook := func() {
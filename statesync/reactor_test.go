@@ -1,6 +1,7 @@
 package statesync
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -12,10 +13,15 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	ssproto "github.com/cometbft/cometbft/api/cometbft/statesync/v1"
 	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/test"
+	lightprovider "github.com/cometbft/cometbft/light/provider"
 	"github.com/cometbft/cometbft/p2p"
 	p2pmocks "github.com/cometbft/cometbft/p2p/mocks"
 	"github.com/cometbft/cometbft/p2p/nodekey"
 	proxymocks "github.com/cometbft/cometbft/proxy/mocks"
+	smmocks "github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
 )
 
 func TestReactor_Receive_ChunkRequest(t *testing.T) {
@@ -73,7 +79,7 @@ func TestReactor_Receive_ChunkRequest(t *testing.T) {
 
 			// Start a reactor and send a ssproto.ChunkRequest, then wait for and check response
 			cfg := config.DefaultStateSyncConfig()
-			r := NewReactor(*cfg, conn, nil, NopMetrics())
+			r := NewReactor(*cfg, conn, nil, nil, nil, NopMetrics())
 			err := r.Start()
 			require.NoError(t, err)
 			t.Cleanup(func() {
@@ -162,7 +168,7 @@ func TestReactor_Receive_SnapshotsRequest(t *testing.T) {
 
 			// Start a reactor and send a SnapshotsRequestMessage, then wait for and check responses
 			cfg := config.DefaultStateSyncConfig()
-			r := NewReactor(*cfg, conn, nil, NopMetrics())
+			r := NewReactor(*cfg, conn, nil, nil, nil, NopMetrics())
 			err := r.Start()
 			require.NoError(t, err)
 			t.Cleanup(func() {
@@ -184,3 +190,153 @@ func TestReactor_Receive_SnapshotsRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestReactor_Receive_LightBlockRequest(t *testing.T) {
+	lb, _ := makeLightBlock(t, 2)
+
+	blockStore := &smmocks.BlockStore{}
+	blockStore.On("Base").Return(int64(2))
+	blockStore.On("Height").Return(int64(2))
+	blockStore.On("LoadBlockMeta", int64(2)).Return(&types.BlockMeta{Header: *lb.Header})
+	blockStore.On("LoadBlockCommit", int64(2)).Return(nil)
+	blockStore.On("LoadSeenCommit", int64(2)).Return(lb.Commit)
+	stateStore := &smmocks.Store{}
+	stateStore.On("LoadValidators", int64(2)).Return(lb.ValidatorSet, nil)
+
+	testcases := map[string]struct {
+		height      uint64
+		expectBlock *types.LightBlock
+	}{
+		"light block is returned":         {2, lb},
+		"latest light block is returned":  {0, lb},
+		"missing light block returns nil": {3, nil},
+		"pruned light block returns nil":  {1, nil},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			// Mock peer to store response
+			peer := &p2pmocks.Peer{}
+			peer.On("ID").Return(nodekey.ID("id"))
+			var response *ssproto.LightBlockResponse
+			peer.On("Send", mock.MatchedBy(func(i any) bool {
+				e, ok := i.(p2p.Envelope)
+				return ok && e.ChannelID == LightBlockChannel
+			})).Run(func(args mock.Arguments) {
+				e := args[0].(p2p.Envelope)
+
+				// Marshal to simulate a wire roundtrip.
+				bz, err := proto.Marshal(e.Message)
+				require.NoError(t, err)
+				response = &ssproto.LightBlockResponse{}
+				err = proto.Unmarshal(bz, response)
+				require.NoError(t, err)
+			}).Return(true)
+
+			cfg := config.DefaultStateSyncConfig()
+			r := NewReactor(*cfg, nil, nil, stateStore, blockStore, NopMetrics())
+			err := r.Start()
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				if err := r.Stop(); err != nil {
+					t.Error(err)
+				}
+			})
+
+			r.Receive(p2p.Envelope{
+				ChannelID: LightBlockChannel,
+				Src:       peer,
+				Message:   &ssproto.LightBlockRequest{Height: tc.height},
+			})
+			require.NotNil(t, response)
+			assert.Equal(t, tc.height, response.Height)
+			if tc.expectBlock == nil {
+				assert.Nil(t, response.LightBlock)
+				return
+			}
+			got, err := types.LightBlockFromProto(response.LightBlock)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectBlock.Hash(), got.Hash())
+			assert.Equal(t, tc.expectBlock.ValidatorSet.Hash(), got.ValidatorSet.Hash())
+		})
+	}
+}
+
+func TestBlockProvider(t *testing.T) {
+	lb, params := makeLightBlock(t, 2)
+
+	blockStore := &smmocks.BlockStore{}
+	blockStore.On("Base").Return(int64(1))
+	blockStore.On("Height").Return(int64(2))
+	blockStore.On("LoadBlockMeta", int64(2)).Return(&types.BlockMeta{Header: *lb.Header})
+	blockStore.On("LoadBlockCommit", int64(2)).Return(nil)
+	blockStore.On("LoadSeenCommit", int64(2)).Return(lb.Commit)
+	stateStore := &smmocks.Store{}
+	stateStore.On("LoadValidators", int64(2)).Return(lb.ValidatorSet, nil)
+	stateStore.On("LoadConsensusParams", int64(2)).Return(params, nil)
+
+	// The first switch serves light blocks, the second fetches them.
+	cfg := config.DefaultStateSyncConfig()
+	reactors := []*Reactor{
+		NewReactor(*cfg, nil, nil, stateStore, blockStore, NopMetrics()),
+		NewReactor(*cfg, nil, nil, nil, nil, NopMetrics()),
+	}
+	switches := p2p.MakeConnectedSwitches(config.DefaultP2PConfig(), 2, func(i int, sw *p2p.Switch) *p2p.Switch {
+		sw.AddReactor("STATESYNC", reactors[i])
+		return sw
+	}, p2p.Connect2Switches)
+	t.Cleanup(func() {
+		for _, sw := range switches {
+			if err := sw.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+
+	ctx := context.Background()
+	provider := NewBlockProvider(lb.ChainID, switches[0].NodeInfo().ID(), reactors[1])
+
+	got, err := provider.LightBlock(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, lb.Hash(), got.Hash())
+
+	got, err = provider.LightBlock(ctx, 0)
+	require.NoError(t, err)
+	assert.Equal(t, lb.Hash(), got.Hash())
+
+	_, err = provider.LightBlock(ctx, 3)
+	require.ErrorIs(t, err, lightprovider.ErrLightBlockNotFound)
+
+	// Light blocks for another chain are rejected.
+	_, err = NewBlockProvider("other-chain", switches[0].NodeInfo().ID(), reactors[1]).LightBlock(ctx, 2)
+	require.ErrorAs(t, err, &lightprovider.ErrBadLightBlock{})
+
+	gotParams, err := reactors[1].requestParams(ctx, switches[0].NodeInfo().ID(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, params.Hash(), gotParams.Hash())
+}
+
+// makeLightBlock makes a valid light block at the given height, along with the
+// consensus params it commits to.
+func makeLightBlock(t *testing.T, height int64) (*types.LightBlock, types.ConsensusParams) {
+	t.Helper()
+
+	params := *test.ConsensusParams()
+	vals, privVals := test.ValidatorSet(context.Background(), t, 4, 10)
+	header := test.MakeHeader(t, &types.Header{
+		Height:             height,
+		ValidatorsHash:     vals.Hash(),
+		NextValidatorsHash: vals.Hash(),
+		ConsensusHash:      params.Hash(),
+	})
+	commit, err := test.MakeCommit(test.MakeBlockIDWithHash(header.Hash()), height, 0,
+		vals, privVals, header.ChainID, cmttime.Now())
+	require.NoError(t, err)
+
+	lb := &types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: header, Commit: commit},
+		ValidatorSet: vals,
+	}
+	require.NoError(t, lb.ValidateBasic(header.ChainID))
+	return lb, params
+}
@@ -1,6 +1,7 @@
 package statesync
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -15,6 +16,8 @@ import (
 	lighthttp "github.com/cometbft/cometbft/light/provider/http"
 	lightrpc "github.com/cometbft/cometbft/light/rpc"
 	lightdb "github.com/cometbft/cometbft/light/store/db"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/nodekey"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/types"
@@ -41,7 +44,10 @@ type lightClientStateProvider struct {
 	lc            *light.Client
 	version       cmtstate.Version
 	initialHeight int64
-	providers     map[lightprovider.Provider]string
+
+	// consensusParams fetches the consensus params at the height of the given
+	// light block, verified by the light client.
+	consensusParams func(ctx context.Context, lb *types.LightBlock) (types.ConsensusParams, error)
 }
 
 func NewLightClientStateProviderWithDBKeyVersion(ctx context.Context,
@@ -80,7 +86,22 @@ func NewLightClientStateProviderWithDBKeyVersion(ctx context.Context,
 		lc:            lc,
 		version:       version,
 		initialHeight: initialHeight,
-		providers:     providerRemotes,
+		consensusParams: func(ctx context.Context, lb *types.LightBlock) (types.ConsensusParams, error) {
+			// We fetch consensus params via RPC, using light client verification.
+			primaryURL, ok := providerRemotes[lc.Primary()]
+			if !ok || primaryURL == "" {
+				return types.ConsensusParams{}, errors.New("could not find address for primary light client provider")
+			}
+			primaryRPC, err := rpcClient(primaryURL)
+			if err != nil {
+				return types.ConsensusParams{}, fmt.Errorf("unable to create RPC client: %w", err)
+			}
+			result, err := lightrpc.NewClient(primaryRPC, lc).ConsensusParams(ctx, &lb.Height)
+			if err != nil {
+				return types.ConsensusParams{}, err
+			}
+			return result.ConsensusParams, nil
+		},
 	}, nil
 }
 
@@ -99,6 +120,60 @@ func NewLightClientStateProvider(
 		chainID, version, initialHeight, servers, trustOptions, logger, "")
 }
 
+// NewP2PStateProvider creates a new StateProvider using a light client which
+// fetches light blocks and consensus params from the given peers, over the
+// state sync reactor, instead of from RPC servers. The first peer is used as
+// the light client's primary, and the others as witnesses.
+func NewP2PStateProvider(
+	ctx context.Context,
+	chainID string,
+	version cmtstate.Version,
+	initialHeight int64,
+	peers []nodekey.ID,
+	r *Reactor,
+	trustOptions light.TrustOptions,
+	logger log.Logger,
+	dbKeyLayoutVersion string,
+) (StateProvider, error) {
+	if len(peers) < 2 {
+		return nil, fmt.Errorf("at least 2 provider peers are required, got %v", len(peers))
+	}
+
+	providers := make([]lightprovider.Provider, 0, len(peers))
+	for _, peer := range peers {
+		providers = append(providers, NewBlockProvider(chainID, peer, r))
+	}
+
+	lc, err := light.NewClient(ctx, chainID, trustOptions, providers[0], providers[1:],
+		lightdb.NewWithDBVersion(dbm.NewMemDB(), "", dbKeyLayoutVersion), light.Logger(logger), light.MaxRetryAttempts(5))
+	if err != nil {
+		return nil, err
+	}
+	return &lightClientStateProvider{
+		lc:            lc,
+		version:       version,
+		initialHeight: initialHeight,
+		consensusParams: func(ctx context.Context, lb *types.LightBlock) (types.ConsensusParams, error) {
+			// Any peer will do, since the params are checked against the
+			// consensus hash of the verified header.
+			for _, peer := range peers {
+				params, err := r.requestParams(ctx, peer, uint64(lb.Height))
+				if err != nil {
+					logger.Debug("Failed to fetch consensus params", "height", lb.Height, "peer", peer, "err", err)
+					continue
+				}
+				if !bytes.Equal(params.Hash(), lb.ConsensusHash) {
+					logger.Info("Peer sent consensus params not matching the header", "height", lb.Height, "peer", peer)
+					r.reportPeer(peer, p2p.BehaviourInvalidMessage)
+					continue
+				}
+				return params, nil
+			}
+			return types.ConsensusParams{}, errors.New("no provider peer sent valid consensus params")
+		},
+	}, nil
+}
+
 // AppHash implements StateProvider.
 func (s *lightClientStateProvider) AppHash(ctx context.Context, height uint64) ([]byte, error) {
 	s.Lock()
@@ -184,22 +259,13 @@ func (s *lightClientStateProvider) State(ctx context.Context, height uint64) (sm
 	state.NextValidators = nextLightBlock.ValidatorSet
 	state.LastHeightValidatorsChanged = nextLightBlock.Height
 
-	// We'll also need to fetch consensus params via RPC, using light client verification.
-	primaryURL, ok := s.providers[s.lc.Primary()]
-	if !ok || primaryURL == "" {
-		return sm.State{}, errors.New("could not find address for primary light client provider")
-	}
-	primaryRPC, err := rpcClient(primaryURL)
-	if err != nil {
-		return sm.State{}, fmt.Errorf("unable to create RPC client: %w", err)
-	}
-	rpcclient := lightrpc.NewClient(primaryRPC, s.lc)
-	result, err := rpcclient.ConsensusParams(ctx, &currentLightBlock.Height)
+	// We'll also need to fetch consensus params, verified against the header.
+	params, err := s.consensusParams(ctx, currentLightBlock)
 	if err != nil {
 		return sm.State{}, fmt.Errorf("unable to fetch consensus parameters for height %v: %w",
-			nextLightBlock.Height, err)
+			currentLightBlock.Height, err)
 	}
-	state.ConsensusParams = result.ConsensusParams
+	state.ConsensusParams = params
 	state.LastHeightConsensusParamsChanged = currentLightBlock.Height
 
 	return state, nil
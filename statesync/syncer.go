@@ -44,6 +44,24 @@ var (
 	errNoSnapshots = errors.New("no suitable snapshots found")
 )
 
+// SyncStatus is the progress of a state sync.
+type SyncStatus struct {
+	Syncing bool
+	// The snapshot being restored, if any.
+	SnapshotHeight uint64
+	SnapshotFormat uint32
+	SnapshotHash   []byte
+	ChunksTotal    uint32
+	ChunksFetched  uint32
+	ChunksApplied  uint32
+	StartTime      time.Time
+	// ETA is the estimated time left to restore the snapshot, from the rate at
+	// which chunks were applied so far, or 0 if unknown.
+	ETA time.Duration
+	// Peers are the chunk providers, sorted by decreasing score.
+	Peers []PeerStatus
+}
+
 // syncer runs a state sync against an ABCI app. Use either SyncAny() to automatically attempt to
 // sync all snapshots in the pool (pausing to discover new ones), or Sync() to sync a specific
 // snapshot. Snapshots and chunks are fed via AddSnapshot() and AddChunk() as appropriate.
@@ -56,9 +74,15 @@ type syncer struct {
 	tempDir       string
 	chunkFetchers int32
 	retryTimeout  time.Duration
-
-	mtx    cmtsync.RWMutex
-	chunks *chunkQueue
+	scores        *peerScores
+	metrics       *Metrics
+	onSlowPeer    func(nodekey.ID) // called for peers that didn't send a requested chunk in time, may be nil
+
+	mtx       cmtsync.RWMutex
+	chunks    *chunkQueue
+	snapshot  *snapshot // snapshot being restored
+	startTime time.Time // time the restoration of the snapshot started
+	applied   uint32    // number of chunks applied to the app
 }
 
 // newSyncer creates a new syncer.
@@ -69,6 +93,7 @@ func newSyncer(
 	connQuery proxy.AppConnQuery,
 	stateProvider StateProvider,
 	tempDir string,
+	metrics *Metrics,
 ) *syncer {
 	return &syncer{
 		logger:        logger,
@@ -79,6 +104,8 @@ func newSyncer(
 		tempDir:       tempDir,
 		chunkFetchers: cfg.ChunkFetchers,
 		retryTimeout:  cfg.ChunkRequestTimeout,
+		scores:        newPeerScores(metrics),
+		metrics:       metrics,
 	}
 }
 
@@ -90,10 +117,19 @@ func (s *syncer) AddChunk(chunk *chunk) (bool, error) {
 	if s.chunks == nil {
 		return false, errors.New("no state sync in progress")
 	}
+	if chunk.Chunk == nil {
+		// The peer doesn't have the chunk, so it failed the request.
+		if _, ok := s.chunks.Received(chunk.Index, chunk.Sender); ok {
+			s.scores.failed(chunk.Sender)
+		}
+	}
 	added, err := s.chunks.Add(chunk)
 	if err != nil {
 		return false, err
 	}
+	if latency, ok := s.chunks.Received(chunk.Index, chunk.Sender); ok {
+		s.scores.received(chunk.Sender, latency, len(chunk.Chunk))
+	}
 	if added {
 		s.logger.Debug("Added chunk to queue", "height", chunk.Height, "format", chunk.Format,
 			"chunk", chunk.Index)
@@ -104,6 +140,32 @@ func (s *syncer) AddChunk(chunk *chunk) (bool, error) {
 	return added, nil
 }
 
+// Status returns the progress of the sync.
+func (s *syncer) Status() SyncStatus {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	status := SyncStatus{
+		Syncing: true,
+		Peers:   s.scores.status(),
+	}
+	if s.snapshot == nil {
+		return status
+	}
+	status.SnapshotHeight = s.snapshot.Height
+	status.SnapshotFormat = s.snapshot.Format
+	status.SnapshotHash = s.snapshot.Hash
+	status.ChunksTotal = s.snapshot.Chunks
+	status.ChunksFetched = s.chunks.Fetched()
+	status.ChunksApplied = s.applied
+	status.StartTime = s.startTime
+	if s.applied > 0 && s.applied < s.snapshot.Chunks {
+		perChunk := time.Since(s.startTime) / time.Duration(s.applied)
+		status.ETA = perChunk * time.Duration(s.snapshot.Chunks-s.applied)
+	}
+	return status
+}
+
 // AddSnapshot adds a snapshot to the snapshot pool. It returns true if a new, previously unseen
 // snapshot was accepted and added.
 func (s *syncer) AddSnapshot(peer p2p.Peer, snapshot *snapshot) (bool, error) {
@@ -241,12 +303,19 @@ func (s *syncer) Sync(snapshot *snapshot, chunks *chunkQueue) (sm.State, *types.
 		return sm.State{}, nil, errors.New("a state sync is already in progress")
 	}
 	s.chunks = chunks
+	s.snapshot = snapshot
+	s.startTime = time.Now()
+	s.applied = 0
 	s.mtx.Unlock()
 	defer func() {
 		s.mtx.Lock()
 		s.chunks = nil
+		s.snapshot = nil
 		s.mtx.Unlock()
 	}()
+	s.metrics.SnapshotHeight.Set(float64(snapshot.Height))
+	s.metrics.SnapshotChunkTotal.Set(float64(snapshot.Chunks))
+	s.metrics.SnapshotChunksApplied.Set(0)
 
 	hctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
 	defer cancel()
@@ -370,6 +439,12 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 		}
 		s.logger.Info("Applied snapshot chunk to ABCI app", "height", chunk.Height,
 			"format", chunk.Format, "chunk", chunk.Index, "total", chunks.Size())
+		if resp.Result == abci.APPLY_SNAPSHOT_CHUNK_RESULT_ACCEPT {
+			s.mtx.Lock()
+			s.applied++
+			s.mtx.Unlock()
+			s.metrics.SnapshotChunksApplied.Add(1)
+		}
 
 		// Discard and refetch any chunks as requested by the app
 		for _, index := range resp.RefetchChunks {
@@ -383,6 +458,7 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 		for _, sender := range resp.RejectSenders {
 			if sender != "" {
 				s.snapshots.RejectPeer(nodekey.ID(sender))
+				s.scores.reject(nodekey.ID(sender))
 				err := chunks.DiscardSender(nodekey.ID(sender))
 				if err != nil {
 					return fmt.Errorf("failed to reject sender: %w", err)
@@ -407,7 +483,9 @@ func (s *syncer) applyChunks(chunks *chunkQueue) error {
 }
 
 // fetchChunks requests chunks from peers, receiving allocations from the chunk queue. Chunks
-// will be received from the reactor via syncer.AddChunks() to chunkQueue.Add().
+// will be received from the reactor via syncer.AddChunks() to chunkQueue.Add(). If a chunk
+// takes much longer than expected from the peer it was requested from, it is requested from
+// another peer as well, and the first response wins.
 func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *chunkQueue) {
 	var (
 		next  = true
@@ -437,28 +515,67 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 		s.logger.Info("Fetching snapshot chunk", "height", snapshot.Height,
 			"format", snapshot.Format, "chunk", index, "total", chunks.Size())
 
-		s.requestChunk(snapshot, index)
-
-		select {
-		case <-chunks.WaitFor(index):
-			next = true
+		peer := s.requestChunk(snapshot, chunks, index)
+
+		arrived := chunks.WaitFor(index)
+		retryTimer := time.NewTimer(s.retryTimeout)
+		hedgeTimer := time.NewTimer(s.hedgeDelay(peer))
+	wait:
+		for {
+			select {
+			case <-arrived:
+				for _, peerID := range chunks.CancelRequests(index) {
+					s.scores.canceled(peerID)
+				}
+				next = true
+				break wait
+
+			case <-hedgeTimer.C:
+				s.logger.Info("Chunk is slow to arrive, requesting it from another peer",
+					"height", snapshot.Height, "format", snapshot.Format, "chunk", index)
+				if peer := s.requestChunk(snapshot, chunks, index); peer != nil {
+					s.metrics.HedgedChunkRequests.Add(1)
+					hedgeTimer.Reset(s.hedgeDelay(peer))
+				}
 
-		case <-time.After(s.retryTimeout):
-			next = false
+			case <-retryTimer.C:
+				for _, peerID := range chunks.CancelRequests(index) {
+					s.scores.failed(peerID)
+					if s.onSlowPeer != nil {
+						s.onSlowPeer(peerID)
+					}
+				}
+				next = false
+				break wait
 
-		case <-ctx.Done():
-			return
+			case <-ctx.Done():
+				retryTimer.Stop()
+				hedgeTimer.Stop()
+				return
+			}
 		}
+		retryTimer.Stop()
+		hedgeTimer.Stop()
+	}
+}
+
+// hedgeDelay returns how long to wait for a chunk requested from the given peer, which may be
+// nil, before requesting it from another peer as well.
+func (s *syncer) hedgeDelay(peer p2p.Peer) time.Duration {
+	if peer == nil {
+		return minHedgeDelay
 	}
+	return s.scores.hedgeDelay(peer.ID())
 }
 
-// requestChunk requests a chunk from a peer.
-func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
-	peer := s.snapshots.GetPeer(snapshot)
+// requestChunk requests a chunk from the best peer it hasn't been requested from yet, returning
+// the peer or nil if there is none.
+func (s *syncer) requestChunk(snapshot *snapshot, chunks *chunkQueue, chunk uint32) p2p.Peer {
+	peer := chunks.AllocatePeer(chunk, s.snapshots.GetPeers(snapshot), s.scores)
 	if peer == nil {
 		s.logger.Error("No valid peers found for snapshot", "height", snapshot.Height,
 			"format", snapshot.Format, "hash", log.NewLazySprintf("%X", snapshot.Hash))
-		return
+		return nil
 	}
 	s.logger.Debug("Requesting snapshot chunk", "height", snapshot.Height,
 		"format", snapshot.Format, "chunk", chunk, "peer", peer.ID())
@@ -470,6 +587,7 @@ func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
 			Index:  chunk,
 		},
 	})
+	return peer
 }
 
 // verifyApp verifies the sync, checking the app hash, last block height and app version.
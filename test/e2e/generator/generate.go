@@ -43,6 +43,7 @@ var (
 	// FIXME: grpc disabled due to https://github.com/tendermint/tendermint/issues/5439
 	nodeABCIProtocols     = uniformChoice{"unix", "tcp", "builtin", "builtin_connsync"} // "grpc"
 	nodePrivvalProtocols  = uniformChoice{"file", "unix", "tcp"}
+	nodeP2PTransports     = uniformChoice{"tcp", "quic"}
 	nodeBlockSyncs        = uniformChoice{"v0"} // "v2"
 	nodeStateSyncs        = uniformChoice{false, true}
 	nodePersistIntervals  = uniformChoice{0, 1, 5}
@@ -341,6 +342,7 @@ func generateNode(
 		StartAt:                startAt,
 		Database:               nodeDatabases.Choose(r).(string),
 		PrivvalProtocolStr:     nodePrivvalProtocols.Choose(r).(string),
+		P2PTransport:           nodeP2PTransports.Choose(r).(string),
 		BlockSyncVersion:       nodeBlockSyncs.Choose(r).(string),
 		StateSync:              nodeStateSyncs.Choose(r).(bool) && startAt > 0,
 		PersistIntervalPtr:     ptrUint64(uint64(nodePersistIntervals.Choose(r).(int))),
@@ -274,6 +274,11 @@ type ManifestNode struct {
 	// Simulated clock skew for this node
 	ClockSkew time.Duration `toml:"clock_skew"`
 
+	// P2PTransport specifies the transport used to connect to peers: "tcp" or
+	// "quic". Defaults to "tcp". QUIC nodes fall back to TCP to connect to TCP
+	// nodes, so that nodes using either transport can be mixed in a testnet.
+	P2PTransport string `toml:"p2p_transport"`
+
 	// Config is a set of key-value config entries to write to CometBFT's
 	// configuration files for this node. The format is "key = value".
 	// Example: "p2p.send_rate = 512000".
@@ -262,6 +262,9 @@ func NewTestnetFromManifest(manifest Manifest, file string, ifd InfrastructureDa
 		if node.Database == "" {
 			node.Database = "goleveldb"
 		}
+		if node.P2PTransport == "" {
+			node.P2PTransport = "tcp"
+		}
 		if nodeManifest.PrivvalProtocolStr != "" {
 			node.PrivvalProtocol = Protocol(nodeManifest.PrivvalProtocolStr)
 		}
@@ -568,6 +571,11 @@ func (n Node) Validate(testnet Testnet) error {
 	default:
 		return fmt.Errorf("invalid database setting %q", n.Database)
 	}
+	switch n.P2PTransport {
+	case "tcp", "quic":
+	default:
+		return fmt.Errorf("invalid p2p transport setting %q", n.P2PTransport)
+	}
 	switch n.ABCIProtocol {
 	case ProtocolBuiltin, ProtocolBuiltinConnSync, ProtocolUNIX, ProtocolTCP, ProtocolGRPC:
 	default:
@@ -248,6 +248,7 @@ func MakeConfig(node *e2e.Node) (*config.Config, error) {
 
 	cfg.P2P.ExternalAddress = fmt.Sprintf("tcp://%v", node.AddressP2P(false))
 	cfg.P2P.AddrBookStrict = false
+	cfg.P2P.Transport = node.P2PTransport
 
 	cfg.DBBackend = node.Database
 	cfg.BlockSync.Version = node.BlockSyncVersion
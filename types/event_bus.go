@@ -178,6 +178,37 @@ func (b *EventBus) PublishEventPendingTx(data EventDataPendingTx) error {
 	})
 }
 
+func (b *EventBus) PublishEventTxExpired(data EventDataTxExpired) error {
+	return b.publishMempoolTxEvent(EventTxExpired, data.Hash, data)
+}
+
+func (b *EventBus) PublishEventTxRejected(data EventDataTxRejected) error {
+	return b.publishMempoolTxEvent(EventTxRejected, data.Hash, data)
+}
+
+func (b *EventBus) PublishEventTxEvicted(data EventDataTxEvicted) error {
+	return b.publishMempoolTxEvent(EventTxEvicted, data.Hash, data)
+}
+
+func (b *EventBus) PublishEventTxRecheckFailed(data EventDataTxRecheckFailed) error {
+	return b.publishMempoolTxEvent(EventTxRecheckFailed, data.Hash, data)
+}
+
+func (b *EventBus) PublishEventTxReplaced(data EventDataTxReplaced) error {
+	return b.publishMempoolTxEvent(EventTxReplaced, data.Hash, data)
+}
+
+// publishMempoolTxEvent publishes an event about a tx removed from, or not
+// added to, the mempool, so that it can be queried by tx hash.
+func (b *EventBus) publishMempoolTxEvent(eventType string, hash []byte, data TMEventData) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+	return b.pubsub.PublishWithEvents(ctx, data, map[string][]string{
+		EventTypeKey: {eventType},
+		TxHashKey:    {fmt.Sprintf("%X", hash)},
+	})
+}
+
 // PublishEventTx publishes tx event with events from Result. Note it will add
 // predefined keys (EventTypeKey, TxHashKey). Existing events with the same keys
 // will be overwritten.
@@ -279,6 +310,26 @@ func (NopEventBus) PublishEventTx(EventDataTx) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventTxExpired(EventDataTxExpired) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventTxRejected(EventDataTxRejected) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventTxEvicted(EventDataTxEvicted) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventTxRecheckFailed(EventDataTxRecheckFailed) error {
+	return nil
+}
+
+func (NopEventBus) PublishEventTxReplaced(EventDataTxReplaced) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventNewRoundStep(EventDataRoundState) error {
 	return nil
 }
@@ -52,6 +52,105 @@ func TestEventBusPublishEventPendingTx(t *testing.T) {
 	}
 }
 
+func TestEventBusPublishEventTxExpired(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	tx := Tx("foo")
+	query := fmt.Sprintf("tm.event='TxExpired' AND tx.hash='%X'", tx.Hash())
+	txsSub, err := eventBus.Subscribe(context.Background(), "test", cmtquery.MustCompile(query))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	go func() {
+		msg := <-txsSub.Out()
+		edt := msg.Data().(EventDataTxExpired)
+		assert.EqualValues(t, tx.Hash(), edt.Hash)
+		assert.Equal(t, "default", edt.Lane)
+		assert.EqualValues(t, 5, edt.Height)
+		close(done)
+	}()
+
+	err = eventBus.PublishEventTxExpired(EventDataTxExpired{
+		Hash:   tx.Hash(),
+		Lane:   "default",
+		Height: 5,
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(1 * time.Second):
+		t.Fatal("did not receive an expired transaction after 1 sec.")
+	}
+}
+
+func TestEventBusPublishMempoolTxEvents(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	hash := Tx("foo").Hash()
+	testCases := []struct {
+		eventType string
+		publish   func() error
+		expected  TMEventData
+	}{
+		{
+			EventTxRejected,
+			func() error {
+				return eventBus.PublishEventTxRejected(EventDataTxRejected{Hash: hash, Lane: "default", Code: 1, Reason: "invalid"})
+			},
+			EventDataTxRejected{Hash: hash, Lane: "default", Code: 1, Reason: "invalid"},
+		},
+		{
+			EventTxEvicted,
+			func() error {
+				return eventBus.PublishEventTxEvicted(EventDataTxEvicted{Hash: hash, Lane: "default", Reason: "flushed"})
+			},
+			EventDataTxEvicted{Hash: hash, Lane: "default", Reason: "flushed"},
+		},
+		{
+			EventTxRecheckFailed,
+			func() error {
+				return eventBus.PublishEventTxRecheckFailed(EventDataTxRecheckFailed{Hash: hash, Lane: "default", Code: 2, Reason: "invalid"})
+			},
+			EventDataTxRecheckFailed{Hash: hash, Lane: "default", Code: 2, Reason: "invalid"},
+		},
+		{
+			EventTxReplaced,
+			func() error {
+				return eventBus.PublishEventTxReplaced(EventDataTxReplaced{Hash: hash, NewHash: Tx("bar").Hash(), Lane: "default"})
+			},
+			EventDataTxReplaced{Hash: hash, NewHash: Tx("bar").Hash(), Lane: "default"},
+		},
+	}
+	for _, tc := range testCases {
+		query := fmt.Sprintf("tm.event='%s' AND tx.hash='%X'", tc.eventType, hash)
+		sub, err := eventBus.Subscribe(context.Background(), tc.eventType, cmtquery.MustCompile(query))
+		require.NoError(t, err)
+
+		require.NoError(t, tc.publish())
+		select {
+		case msg := <-sub.Out():
+			assert.Equal(t, tc.expected, msg.Data())
+		case <-time.After(1 * time.Second):
+			t.Fatalf("did not receive a %s event after 1 sec.", tc.eventType)
+		}
+	}
+}
+
 func TestEventBusPublishEventTx(t *testing.T) {
 	eventBus := NewEventBus()
 	err := eventBus.Start()
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
@@ -22,6 +23,11 @@ const (
 	EventNewEvidence         = "NewEvidence"
 	EventPendingTx           = "PendingTx"
 	EventTx                  = "Tx"
+	EventTxEvicted           = "TxEvicted"
+	EventTxExpired           = "TxExpired"
+	EventTxRecheckFailed     = "TxRecheckFailed"
+	EventTxRejected          = "TxRejected"
+	EventTxReplaced          = "TxReplaced"
 	EventValidatorSetUpdates = "ValidatorSetUpdates"
 
 	// Internal consensus events.
@@ -53,6 +59,11 @@ func init() {
 	cmtjson.RegisterType(EventDataNewBlockEvents{}, "tendermint/event/NewBlockEvents")
 	cmtjson.RegisterType(EventDataNewEvidence{}, "tendermint/event/NewEvidence")
 	cmtjson.RegisterType(EventDataTx{}, "tendermint/event/Tx")
+	cmtjson.RegisterType(EventDataTxExpired{}, "tendermint/event/TxExpired")
+	cmtjson.RegisterType(EventDataTxRejected{}, "tendermint/event/TxRejected")
+	cmtjson.RegisterType(EventDataTxEvicted{}, "tendermint/event/TxEvicted")
+	cmtjson.RegisterType(EventDataTxRecheckFailed{}, "tendermint/event/TxRecheckFailed")
+	cmtjson.RegisterType(EventDataTxReplaced{}, "tendermint/event/TxReplaced")
 	cmtjson.RegisterType(EventDataRoundState{}, "tendermint/event/RoundState")
 	cmtjson.RegisterType(EventDataNewRound{}, "tendermint/event/NewRound")
 	cmtjson.RegisterType(EventDataCompleteProposal{}, "tendermint/event/CompleteProposal")
@@ -95,6 +106,49 @@ type EventDataTx struct {
 	abci.TxResult
 }
 
+// EventDataTxExpired is fired when a tx is removed from the mempool because it
+// stayed there longer than the configured TTL.
+type EventDataTxExpired struct {
+	Hash   cmtbytes.HexBytes `json:"hash"`
+	Lane   string            `json:"lane"`
+	Height int64             `json:"height"` // height of the block after which the tx expired
+}
+
+// EventDataTxRejected is fired when the mempool does not add a tx after calling
+// CheckTx on it, either because it is invalid or because there is no room for
+// it.
+type EventDataTxRejected struct {
+	Hash   cmtbytes.HexBytes `json:"hash"`
+	Lane   string            `json:"lane"`
+	Code   uint32            `json:"code"` // code returned by CheckTx
+	Reason string            `json:"reason"`
+}
+
+// EventDataTxEvicted is fired when a valid tx is removed from the mempool
+// before being committed, for example to make room for other txs.
+type EventDataTxEvicted struct {
+	Hash   cmtbytes.HexBytes `json:"hash"`
+	Lane   string            `json:"lane"`
+	Reason string            `json:"reason"`
+}
+
+// EventDataTxRecheckFailed is fired when a tx is removed from the mempool
+// because it became invalid when rechecked after a block was committed.
+type EventDataTxRecheckFailed struct {
+	Hash   cmtbytes.HexBytes `json:"hash"`
+	Lane   string            `json:"lane"`
+	Code   uint32            `json:"code"` // code returned by CheckTx
+	Reason string            `json:"reason"`
+}
+
+// EventDataTxReplaced is fired when a tx in the mempool is replaced by a new
+// tx, as requested by the application in CheckTx.
+type EventDataTxReplaced struct {
+	Hash    cmtbytes.HexBytes `json:"hash"` // hash of the replaced tx
+	NewHash cmtbytes.HexBytes `json:"new_hash"`
+	Lane    string            `json:"lane"` // lane of the new tx
+}
+
 // NOTE: This goes into the replay WAL.
 type EventDataRoundState struct {
 	Height int64  `json:"height"`
@@ -165,6 +219,11 @@ var (
 	EventQueryTimeoutPropose      = QueryForEvent(EventTimeoutPropose)
 	EventQueryTimeoutWait         = QueryForEvent(EventTimeoutWait)
 	EventQueryTx                  = QueryForEvent(EventTx)
+	EventQueryTxEvicted           = QueryForEvent(EventTxEvicted)
+	EventQueryTxExpired           = QueryForEvent(EventTxExpired)
+	EventQueryTxRecheckFailed     = QueryForEvent(EventTxRecheckFailed)
+	EventQueryTxRejected          = QueryForEvent(EventTxRejected)
+	EventQueryTxReplaced          = QueryForEvent(EventTxReplaced)
 	EventQueryValidatorSetUpdates = QueryForEvent(EventValidatorSetUpdates)
 	EventQueryValidBlock          = QueryForEvent(EventValidBlock)
 	EventQueryVote                = QueryForEvent(EventVote)
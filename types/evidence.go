@@ -11,6 +11,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtrand "github.com/cometbft/cometbft/internal/rand"
@@ -200,6 +201,186 @@ func DuplicateVoteEvidenceFromProto(pb *cmtproto.DuplicateVoteEvidence) (*Duplic
 	return dve, dve.ValidateBasic()
 }
 
+// --------------------------------------------------------------------------------------
+
+// DuplicateProposalEvidence contains evidence of a single proposer signing two conflicting
+// proposals for the same height and round.
+type DuplicateProposalEvidence struct {
+	ProposalA *Proposal `json:"proposal_a"`
+	ProposalB *Proposal `json:"proposal_b"`
+	// Proposals don't carry the address of their proposer.
+	ValidatorAddress Address `json:"validator_address"`
+
+	// abci specific information
+	TotalVotingPower int64     `json:"total_voting_power"`
+	ValidatorPower   int64     `json:"validator_power"`
+	Timestamp        time.Time `json:"timestamp"`
+}
+
+var _ Evidence = &DuplicateProposalEvidence{}
+
+// NewDuplicateProposalEvidence creates DuplicateProposalEvidence with right ordering given
+// two conflicting proposals. The proposer is the proposer of the round of the proposals
+// in valSet, which must be the validator set at their height. If either of the proposals
+// is nil or the val set is nil, an error is returned.
+func NewDuplicateProposalEvidence(proposal1, proposal2 *Proposal, blockTime time.Time, valSet *ValidatorSet,
+) (*DuplicateProposalEvidence, error) {
+	var proposalA, proposalB *Proposal
+	if proposal1 == nil || proposal2 == nil {
+		return nil, errors.New("missing proposal")
+	}
+	if valSet.IsNilOrEmpty() {
+		return nil, errors.New("missing validator set")
+	}
+	proposer := valSet.GetProposer()
+	if proposal1.Round > 0 {
+		proposer = valSet.CopyIncrementProposerPriority(proposal1.Round).GetProposer()
+	}
+
+	if strings.Compare(proposal1.BlockID.Key(), proposal2.BlockID.Key()) == -1 {
+		proposalA = proposal1
+		proposalB = proposal2
+	} else {
+		proposalA = proposal2
+		proposalB = proposal1
+	}
+	return &DuplicateProposalEvidence{
+		ProposalA:        proposalA,
+		ProposalB:        proposalB,
+		ValidatorAddress: proposer.Address,
+		TotalVotingPower: valSet.TotalVotingPower(),
+		ValidatorPower:   proposer.VotingPower,
+		Timestamp:        blockTime,
+	}, nil
+}
+
+// ABCI returns the application relevant representation of the evidence.
+func (dpe *DuplicateProposalEvidence) ABCI() []abci.Misbehavior {
+	return []abci.Misbehavior{{
+		Type: abci.MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL,
+		Validator: abci.Validator{
+			Address: dpe.ValidatorAddress,
+			Power:   dpe.ValidatorPower,
+		},
+		Height:           dpe.ProposalA.Height,
+		Time:             dpe.Timestamp,
+		TotalVotingPower: dpe.TotalVotingPower,
+	}}
+}
+
+// Bytes returns the proto-encoded evidence as a byte array.
+func (dpe *DuplicateProposalEvidence) Bytes() []byte {
+	pbe := dpe.ToProto()
+	bz, err := pbe.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// Hash returns the hash of the evidence.
+func (dpe *DuplicateProposalEvidence) Hash() []byte {
+	return tmhash.Sum(dpe.Bytes())
+}
+
+// Height returns the height of the infraction.
+func (dpe *DuplicateProposalEvidence) Height() int64 {
+	return dpe.ProposalA.Height
+}
+
+// String returns a string representation of the evidence.
+func (dpe *DuplicateProposalEvidence) String() string {
+	return fmt.Sprintf("DuplicateProposalEvidence{ProposalA: %v, ProposalB: %v, Proposer: %v}",
+		dpe.ProposalA, dpe.ProposalB, dpe.ValidatorAddress)
+}
+
+// Time returns the time of the infraction.
+func (dpe *DuplicateProposalEvidence) Time() time.Time {
+	return dpe.Timestamp
+}
+
+// ValidateBasic performs basic validation.
+func (dpe *DuplicateProposalEvidence) ValidateBasic() error {
+	if dpe == nil {
+		return cmterrors.ErrRequiredField{Field: "duplicate_proposal_evidence"}
+	}
+
+	if dpe.ProposalA == nil || dpe.ProposalB == nil {
+		return fmt.Errorf("one or both of the proposals are empty %v, %v", dpe.ProposalA, dpe.ProposalB)
+	}
+	if err := dpe.ProposalA.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid ProposalA: %w", err)
+	}
+	if err := dpe.ProposalB.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid ProposalB: %w", err)
+	}
+	if dpe.ProposalA.Height != dpe.ProposalB.Height || dpe.ProposalA.Round != dpe.ProposalB.Round {
+		return fmt.Errorf("proposals are for different heights or rounds: %d/%d vs %d/%d",
+			dpe.ProposalA.Height, dpe.ProposalA.Round, dpe.ProposalB.Height, dpe.ProposalB.Round)
+	}
+	if len(dpe.ValidatorAddress) != crypto.AddressSize {
+		return fmt.Errorf("expected ValidatorAddress size to be %d bytes, got %d bytes",
+			crypto.AddressSize,
+			len(dpe.ValidatorAddress),
+		)
+	}
+	// Enforce Proposals are lexicographically sorted on blockID
+	if strings.Compare(dpe.ProposalA.BlockID.Key(), dpe.ProposalB.BlockID.Key()) >= 0 {
+		return errors.New("duplicate proposals in invalid order")
+	}
+	return nil
+}
+
+// ToProto encodes DuplicateProposalEvidence to protobuf.
+func (dpe *DuplicateProposalEvidence) ToProto() *cmtproto.DuplicateProposalEvidence {
+	tp := cmtproto.DuplicateProposalEvidence{
+		ProposalA:        dpe.ProposalA.ToProto(),
+		ProposalB:        dpe.ProposalB.ToProto(),
+		ValidatorAddress: dpe.ValidatorAddress,
+		TotalVotingPower: dpe.TotalVotingPower,
+		ValidatorPower:   dpe.ValidatorPower,
+		Timestamp:        dpe.Timestamp,
+	}
+	return &tp
+}
+
+// DuplicateProposalEvidenceFromProto decodes protobuf into DuplicateProposalEvidence.
+func DuplicateProposalEvidenceFromProto(pb *cmtproto.DuplicateProposalEvidence) (*DuplicateProposalEvidence, error) {
+	if pb == nil {
+		return nil, errors.New("nil duplicate proposal evidence")
+	}
+
+	var pA *Proposal
+	if pb.ProposalA != nil {
+		var err error
+		pA, err = ProposalFromProto(pb.ProposalA)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var pB *Proposal
+	if pb.ProposalB != nil {
+		var err error
+		pB, err = ProposalFromProto(pb.ProposalB)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dpe := &DuplicateProposalEvidence{
+		ProposalA:        pA,
+		ProposalB:        pB,
+		ValidatorAddress: pb.ValidatorAddress,
+		TotalVotingPower: pb.TotalVotingPower,
+		ValidatorPower:   pb.ValidatorPower,
+		Timestamp:        pb.Timestamp,
+	}
+
+	return dpe, dpe.ValidateBasic()
+}
+
 // ------------------------------------ LIGHT EVIDENCE --------------------------------------
 
 // LightClientAttackEvidence is a generalized evidence that captures all forms of known attacks on
@@ -525,6 +706,14 @@ func EvidenceToProto(evidence Evidence) (*cmtproto.Evidence, error) {
 			},
 		}, nil
 
+	case *DuplicateProposalEvidence:
+		pbev := evi.ToProto()
+		return &cmtproto.Evidence{
+			Sum: &cmtproto.Evidence_DuplicateProposalEvidence{
+				DuplicateProposalEvidence: pbev,
+			},
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("toproto: evidence is not recognized: %T", evi)
 	}
@@ -542,6 +731,8 @@ func EvidenceFromProto(evidence *cmtproto.Evidence) (Evidence, error) {
 		return DuplicateVoteEvidenceFromProto(evi.DuplicateVoteEvidence)
 	case *cmtproto.Evidence_LightClientAttackEvidence:
 		return LightClientAttackEvidenceFromProto(evi.LightClientAttackEvidence)
+	case *cmtproto.Evidence_DuplicateProposalEvidence:
+		return DuplicateProposalEvidenceFromProto(evi.DuplicateProposalEvidence)
 	default:
 		return nil, errors.New("evidence is not recognized")
 	}
@@ -550,6 +741,7 @@ func EvidenceFromProto(evidence *cmtproto.Evidence) (Evidence, error) {
 func init() {
 	cmtjson.RegisterType(&DuplicateVoteEvidence{}, "tendermint/DuplicateVoteEvidence")
 	cmtjson.RegisterType(&LightClientAttackEvidence{}, "tendermint/LightClientAttackEvidence")
+	cmtjson.RegisterType(&DuplicateProposalEvidence{}, "tendermint/DuplicateProposalEvidence")
 }
 
 // -------------------------------------------- ERRORS --------------------------------------
@@ -624,6 +816,31 @@ func NewMockDuplicateVoteEvidenceWithValidator(height int64, time time.Time,
 	return NewDuplicateVoteEvidence(voteA, voteB, time, NewValidatorSet([]*Validator{val}))
 }
 
+// NewMockDuplicateProposalEvidenceWithValidator assumes the round to be 0,
+// voting power to be 10 and validator to be the only one in the set.
+func NewMockDuplicateProposalEvidenceWithValidator(height int64, time time.Time,
+	pv PrivValidator, chainID string,
+) (*DuplicateProposalEvidence, error) {
+	pubKey, err := pv.GetPubKey()
+	if err != nil {
+		return nil, err
+	}
+	val := NewValidator(pubKey, 10)
+	proposalA := NewProposal(height, 0, -1, randBlockID(), time)
+	pA := proposalA.ToProto()
+	if err := pv.SignProposal(chainID, pA); err != nil {
+		return nil, err
+	}
+	proposalA.Signature = pA.Signature
+	proposalB := NewProposal(height, 0, -1, randBlockID(), time)
+	pB := proposalB.ToProto()
+	if err := pv.SignProposal(chainID, pB); err != nil {
+		return nil, err
+	}
+	proposalB.Signature = pB.Signature
+	return NewDuplicateProposalEvidence(proposalA, proposalB, time, NewValidatorSet([]*Validator{val}))
+}
+
 func makeMockVote(height int64, round, index int32, addr Address,
 	blockID BlockID, time time.Time,
 ) *Vote {
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtversion "github.com/cometbft/cometbft/api/cometbft/version/v1"
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/tmhash"
@@ -92,6 +93,97 @@ func TestDuplicateVoteEvidenceValidation(t *testing.T) {
 	}
 }
 
+func TestDuplicateProposalEvidence(t *testing.T) {
+	const height = int64(13)
+	ev, err := NewMockDuplicateProposalEvidenceWithValidator(height, defaultVoteTime, NewMockPV(), "mock-chain-id")
+	require.NoError(t, err)
+	require.NoError(t, ev.ValidateBasic())
+	assert.Equal(t, ev.Hash(), tmhash.Sum(ev.Bytes()))
+	assert.NotNil(t, ev.String())
+	assert.Equal(t, height, ev.Height())
+
+	misbehavior := ev.ABCI()
+	require.Len(t, misbehavior, 1)
+	assert.Equal(t, abci.MISBEHAVIOR_TYPE_DUPLICATE_PROPOSAL, misbehavior[0].Type)
+	assert.Equal(t, []byte(ev.ValidatorAddress), misbehavior[0].Validator.Address)
+	assert.Equal(t, int64(10), misbehavior[0].Validator.Power)
+	assert.Equal(t, height, misbehavior[0].Height)
+}
+
+func TestNewDuplicateProposalEvidence(t *testing.T) {
+	const chainID = "mychain"
+	vals := []PrivValidator{NewMockPV(), NewMockPV(), NewMockPV()}
+	valSet := NewValidatorSet([]*Validator{
+		vals[0].(MockPV).ExtractIntoValidator(10),
+		vals[1].(MockPV).ExtractIntoValidator(20),
+		vals[2].(MockPV).ExtractIntoValidator(30),
+	})
+	blockID := makeBlockID(tmhash.Sum([]byte("blockhash")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+	blockID2 := makeBlockID(tmhash.Sum([]byte("blockhash2")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+
+	for _, round := range []int32{0, 1, 2} {
+		proposer := valSet.GetProposer()
+		if round > 0 {
+			proposer = valSet.CopyIncrementProposerPriority(round).GetProposer()
+		}
+		proposal1 := NewProposal(10, round, -1, blockID, defaultVoteTime)
+		proposal1.Signature = []byte("signature")
+		proposal2 := NewProposal(10, round, -1, blockID2, defaultVoteTime)
+		proposal2.Signature = []byte("signature")
+
+		// the proposals are ordered by block ID regardless of the order they are given in
+		ev, err := NewDuplicateProposalEvidence(proposal2, proposal1, defaultVoteTime, valSet)
+		require.NoError(t, err)
+		require.NoError(t, ev.ValidateBasic())
+		assert.Equal(t, proposer.Address, ev.ValidatorAddress)
+		assert.Equal(t, proposer.VotingPower, ev.ValidatorPower)
+		assert.Equal(t, valSet.TotalVotingPower(), ev.TotalVotingPower)
+	}
+
+	_, err := NewDuplicateProposalEvidence(nil, NewProposal(10, 0, -1, blockID, defaultVoteTime), defaultVoteTime, valSet)
+	require.Error(t, err)
+	_, err = NewDuplicateProposalEvidence(
+		NewProposal(10, 0, -1, blockID, defaultVoteTime), NewProposal(10, 0, -1, blockID2, defaultVoteTime), defaultVoteTime, nil)
+	require.Error(t, err)
+}
+
+func TestDuplicateProposalEvidenceValidation(t *testing.T) {
+	blockID3 := makeBlockID(tmhash.Sum([]byte("blockhash3")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
+
+	testCases := []struct {
+		testName         string
+		malleateEvidence func(*DuplicateProposalEvidence)
+		expectErr        bool
+	}{
+		{"Good DuplicateProposalEvidence", func(_ *DuplicateProposalEvidence) {}, false},
+		{"Nil proposal A", func(ev *DuplicateProposalEvidence) { ev.ProposalA = nil }, true},
+		{"Nil proposal B", func(ev *DuplicateProposalEvidence) { ev.ProposalB = nil }, true},
+		{"Unsigned proposal", func(ev *DuplicateProposalEvidence) { ev.ProposalA.Signature = nil }, true},
+		{"Different heights", func(ev *DuplicateProposalEvidence) { ev.ProposalB.Height++ }, true},
+		{"Different rounds", func(ev *DuplicateProposalEvidence) { ev.ProposalB.Round++ }, true},
+		{"Same block IDs", func(ev *DuplicateProposalEvidence) { ev.ProposalB.BlockID = ev.ProposalA.BlockID }, true},
+		{"Invalid proposal order", func(ev *DuplicateProposalEvidence) {
+			ev.ProposalA, ev.ProposalB = ev.ProposalB, ev.ProposalA
+		}, true},
+		{"Invalid validator address", func(ev *DuplicateProposalEvidence) { ev.ValidatorAddress = []byte("addr") }, true},
+		{"Other block ID", func(ev *DuplicateProposalEvidence) {
+			if blockID3.Key() > ev.ProposalA.BlockID.Key() {
+				ev.ProposalB.BlockID = blockID3
+			} else {
+				ev.ProposalA.BlockID = blockID3
+			}
+		}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			ev, err := NewMockDuplicateProposalEvidenceWithValidator(10, defaultVoteTime, NewMockPV(), "mychain")
+			require.NoError(t, err)
+			tc.malleateEvidence(ev)
+			assert.Equal(t, tc.expectErr, ev.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
 func TestLightClientAttackEvidenceBasic(t *testing.T) {
 	height := int64(5)
 	commonHeight := height - 1
@@ -262,6 +354,10 @@ func TestEvidenceProto(t *testing.T) {
 	v := MakeVoteNoError(t, val, chainID, math.MaxInt32, math.MaxInt64, 1, 0x01, blockID, defaultVoteTime)
 	v2 := MakeVoteNoError(t, val, chainID, math.MaxInt32, math.MaxInt64, 2, 0x01, blockID2, defaultVoteTime)
 
+	// -------- Proposals --------
+	dpe, err := NewMockDuplicateProposalEvidenceWithValidator(math.MaxInt32, defaultVoteTime, val, chainID)
+	require.NoError(t, err)
+
 	// -------- SignedHeaders --------
 	const height int64 = 37
 
@@ -289,6 +385,9 @@ func TestEvidenceProto(t *testing.T) {
 		{"DuplicateVoteEvidence nil voteB", &DuplicateVoteEvidence{VoteA: v, VoteB: nil}, false, true},
 		{"DuplicateVoteEvidence nil voteA", &DuplicateVoteEvidence{VoteA: nil, VoteB: v}, false, true},
 		{"DuplicateVoteEvidence success", &DuplicateVoteEvidence{VoteA: v2, VoteB: v}, false, false},
+		{"DuplicateProposalEvidence empty fail", &DuplicateProposalEvidence{}, false, true},
+		{"DuplicateProposalEvidence nil proposalA", &DuplicateProposalEvidence{ProposalA: nil, ProposalB: dpe.ProposalB}, false, true},
+		{"DuplicateProposalEvidence success", dpe, false, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -328,3 +427,12 @@ func TestLightClientAttackEvidenceJSON(t *testing.T) {
 	wantJSON := `{"type":"tendermint/LightClientAttackEvidence","value":{"conflicting_block":null,"common_height":"0","byzantine_validators":null,"total_voting_power":"0","timestamp":"0001-01-01T00:00:00Z"}}`
 	assert.Equal(t, wantJSON, string(js))
 }
+
+func TestDuplicateProposalEvidenceJSON(t *testing.T) {
+	var evidence DuplicateProposalEvidence
+	js, err := cmtjson.Marshal(evidence)
+	require.NoError(t, err)
+
+	wantJSON := `{"type":"tendermint/DuplicateProposalEvidence","value":{"proposal_a":null,"proposal_b":null,"validator_address":"","total_voting_power":"0","validator_power":"0","timestamp":"0001-01-01T00:00:00Z"}}`
+	assert.Equal(t, wantJSON, string(js))
+}
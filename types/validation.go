@@ -14,10 +14,52 @@ import (
 
 const batchVerifyThreshold = 2
 
-func shouldBatchVerify(vals *ValidatorSet, commit *Commit) bool {
-	return len(commit.Signatures) >= batchVerifyThreshold &&
-		batch.SupportsBatchVerifier(vals.GetProposer().PubKey) &&
-		vals.AllKeysHaveSameType()
+// batchVerifierFor returns a batch verifier for the signatures of the commit,
+// if there are enough of them and the validators that signed it all share a
+// key type supporting batch verification. Ignored signatures are not verified,
+// so the key types of their validators don't matter.
+func batchVerifierFor(
+	vals *ValidatorSet,
+	commit *Commit,
+	ignoreSig func(CommitSig) bool,
+	lookUpByIndex bool,
+) (crypto.BatchVerifier, bool) {
+	if len(commit.Signatures) < batchVerifyThreshold {
+		return nil, false
+	}
+
+	// If all the validators share a key type, so do the signers.
+	if vals.AllKeysHaveSameType() {
+		return batch.CreateBatchVerifier(vals.GetProposer().PubKey)
+	}
+
+	var key crypto.PubKey
+	for idx, commitSig := range commit.Signatures {
+		if ignoreSig(commitSig) {
+			continue
+		}
+
+		var val *Validator
+		if lookUpByIndex {
+			val = vals.Validators[idx]
+		} else {
+			_, val = vals.GetByAddressMut(commitSig.ValidatorAddress)
+			if val == nil {
+				continue
+			}
+		}
+
+		switch {
+		case key == nil:
+			key = val.PubKey
+		case key.Type() != val.PubKey.Type():
+			return nil, false
+		}
+	}
+	if key == nil {
+		return nil, false
+	}
+	return batch.CreateBatchVerifier(key)
 }
 
 // VerifyCommit verifies +2/3 of the set had signed the given commit.
@@ -46,9 +88,9 @@ func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	count := func(c CommitSig) bool { return c.BlockIDFlag == BlockIDFlagCommit }
 
 	// attempt to batch verify
-	if shouldBatchVerify(vals, commit) {
+	if bv, ok := batchVerifierFor(vals, commit, ignore, true); ok {
 		return verifyCommitBatch(chainID, vals, commit,
-			votingPowerNeeded, ignore, count, true, true, nil, nil)
+			votingPowerNeeded, ignore, count, true, true, bv, nil)
 	}
 
 	// if verification failed or is not supported then fallback to single verification
@@ -127,9 +169,9 @@ func verifyCommitLightInternal(
 	count := func(_ CommitSig) bool { return true }
 
 	// attempt to batch verify
-	if shouldBatchVerify(vals, commit) {
+	if bv, ok := batchVerifierFor(vals, commit, ignore, true); ok {
 		return verifyCommitBatch(chainID, vals, commit,
-			votingPowerNeeded, ignore, count, countAllSignatures, true, nil, verifiedSignatureCache)
+			votingPowerNeeded, ignore, count, countAllSignatures, true, bv, verifiedSignatureCache)
 	}
 
 	// if verification failed or is not supported then fallback to single verification
@@ -232,9 +274,9 @@ func verifyCommitLightTrustingInternal(
 	// attempt to batch verify commit. As the validator set doesn't necessarily
 	// correspond with the validator set that signed the block we need to look
 	// up by address rather than index.
-	if shouldBatchVerify(vals, commit) {
+	if bv, ok := batchVerifierFor(vals, commit, ignore, false); ok {
 		return verifyCommitBatch(chainID, vals, commit,
-			votingPowerNeeded, ignore, count, countAllSignatures, false, nil, verifiedSignatureCache)
+			votingPowerNeeded, ignore, count, countAllSignatures, false, bv, verifiedSignatureCache)
 	}
 
 	// attempt with single verification
@@ -261,7 +303,7 @@ func ValidateHash(h []byte) error {
 // batch is valid.
 //
 // Note: The caller is responsible for checking to see if this routine is
-// usable via `shouldVerifyBatch(vals, commit)`.
+// usable via `batchVerifierFor(vals, commit, ignoreSig, lookUpByIndex)`.
 func verifyCommitBatch(
 	chainID string,
 	vals *ValidatorSet,
@@ -1,13 +1,19 @@
 package types
 
 import (
+	"fmt"
+	"sort"
 	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	cryptomocks "github.com/cometbft/cometbft/crypto/mocks"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmttime "github.com/cometbft/cometbft/types/time"
 )
@@ -565,3 +571,138 @@ func TestValidation_verifyCommitSingle_UsesCache(t *testing.T) {
 	mockValPubkeys[3].AssertNotCalled(t, "VerifySignature")
 	mockValPubkeys[4].AssertNotCalled(t, "VerifySignature")
 }
+
+func TestValidatorSet_VerifyCommit_BatchKeyTypes(t *testing.T) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+	)
+
+	for _, kt := range batchKeyTypes(t) {
+		t.Run(kt.keyType, func(t *testing.T) {
+			voteSet, valSet, vals := voteSetWithKeys(h, 4, kt.genPrivKey)
+			extCommit, err := MakeExtCommit(blockID, h, 0, voteSet, vals, cmttime.Now(), false)
+			require.NoError(t, err)
+			commit := extCommit.ToCommit()
+
+			ignore := func(c CommitSig) bool { return c.BlockIDFlag == BlockIDFlagAbsent }
+			_, ok := batchVerifierFor(valSet, commit, ignore, true)
+			require.True(t, ok)
+			require.NoError(t, valSet.VerifyCommit(chainID, blockID, h, commit))
+
+			// malleate 4th signature
+			vote := voteSet.GetByIndex(3)
+			v := vote.ToProto()
+			err = vals[3].SignVote("CentaurusA", v, false)
+			require.NoError(t, err)
+			commit.Signatures[3].Signature = v.Signature
+
+			err = valSet.VerifyCommit(chainID, blockID, h, commit)
+			require.ErrorContains(t, err, "wrong signature (#3)")
+		})
+	}
+}
+
+func TestValidation_batchVerifierFor_MixedKeyTypes(t *testing.T) {
+	valSet := NewValidatorSet([]*Validator{
+		NewValidator(ed25519.GenPrivKey().PubKey(), 10),
+		NewValidator(ed25519.GenPrivKey().PubKey(), 10),
+		NewValidator(secp256k1.GenPrivKey().PubKey(), 10),
+	})
+	require.False(t, valSet.AllKeysHaveSameType())
+
+	// commitWithSigners makes a commit signed by the validators with the
+	// given key type, the others being absent.
+	commitWithSigners := func(keyType string) *Commit {
+		commit := &Commit{Signatures: make([]CommitSig, valSet.Size())}
+		for i, val := range valSet.Validators {
+			commit.Signatures[i] = NewCommitSigAbsent()
+			if keyType == "" || val.PubKey.Type() == keyType {
+				commit.Signatures[i] = CommitSig{BlockIDFlag: BlockIDFlagCommit, ValidatorAddress: val.Address}
+			}
+		}
+		return commit
+	}
+	ignore := func(c CommitSig) bool { return c.BlockIDFlag != BlockIDFlagCommit }
+
+	for _, lookUpByIndex := range []bool{true, false} {
+		bv, ok := batchVerifierFor(valSet, commitWithSigners(ed25519.KeyType), ignore, lookUpByIndex)
+		require.True(t, ok)
+		assert.IsType(t, &ed25519.BatchVerifier{}, bv)
+
+		bv, ok = batchVerifierFor(valSet, commitWithSigners(secp256k1.KeyType), ignore, lookUpByIndex)
+		require.True(t, ok)
+		assert.IsType(t, &secp256k1.BatchVerifier{}, bv)
+
+		_, ok = batchVerifierFor(valSet, commitWithSigners(""), ignore, lookUpByIndex)
+		require.False(t, ok)
+	}
+}
+
+func BenchmarkValidatorSet_VerifyCommit(b *testing.B) {
+	var (
+		chainID = "test_chain_id"
+		h       = int64(3)
+		blockID = makeBlockIDRandom()
+	)
+
+	for _, kt := range batchKeyTypes(b) {
+		for _, n := range []int{4, 32, 128} {
+			b.Run(fmt.Sprintf("%s/validators-%d", kt.keyType, n), func(b *testing.B) {
+				voteSet, valSet, vals := voteSetWithKeys(h, n, kt.genPrivKey)
+				extCommit, err := MakeExtCommit(blockID, h, 0, voteSet, vals, cmttime.Now(), false)
+				require.NoError(b, err)
+				commit := extCommit.ToCommit()
+				b.ResetTimer()
+
+				for i := 0; i < b.N; i++ {
+					if err := valSet.VerifyCommit(chainID, blockID, h, commit); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+type batchKeyType struct {
+	keyType    string
+	genPrivKey func() crypto.PrivKey
+}
+
+// batchKeyTypes returns private key generators for the key types supporting
+// batch verification.
+func batchKeyTypes(tb testing.TB) []batchKeyType {
+	tb.Helper()
+	keyTypes := []batchKeyType{
+		{ed25519.KeyType, func() crypto.PrivKey { return ed25519.GenPrivKey() }},
+		{secp256k1.KeyType, func() crypto.PrivKey { return secp256k1.GenPrivKey() }},
+	}
+	if bls12381.Enabled {
+		keyTypes = append(keyTypes, batchKeyType{bls12381.KeyType, func() crypto.PrivKey {
+			privKey, err := bls12381.GenPrivKey()
+			require.NoError(tb, err)
+			return privKey
+		}})
+	}
+	return keyTypes
+}
+
+// voteSetWithKeys is like randVoteSet, but with validators using the keys
+// generated by genPrivKey.
+func voteSetWithKeys(height int64, numValidators int, genPrivKey func() crypto.PrivKey) (*VoteSet, *ValidatorSet, []PrivValidator) {
+	var (
+		valz           = make([]*Validator, numValidators)
+		privValidators = make([]PrivValidator, numValidators)
+	)
+	for i := 0; i < numValidators; i++ {
+		privKey := genPrivKey()
+		valz[i] = NewValidator(privKey.PubKey(), 10)
+		privValidators[i] = NewMockPVWithParams(privKey, false, false)
+	}
+	sort.Sort(PrivValidatorsByAddress(privValidators))
+
+	valSet := NewValidatorSet(valz)
+	return NewVoteSet("test_chain_id", height, 0, PrecommitType, valSet), valSet, privValidators
+}